@@ -0,0 +1,74 @@
+// Code generated by scripts/gen_validate.go; DO NOT EDIT.
+
+package admi_002_001_01
+
+import (
+	"errors"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+// Validate checks Document and its children against the schema.
+func (v Document) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("admi.002.001.01", v.Admi00200101.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks MessageReference and its children against the schema.
+func (v MessageReference) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Ref", v.Ref.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks RejectionReason2 and its children against the schema.
+func (v RejectionReason2) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("RjctgPtyRsn", v.RjctgPtyRsn.Validate()))
+	if v.RjctnDtTm != nil {
+		errs = append(errs, common.PrefixError("RjctnDtTm", v.RjctnDtTm.Validate()))
+	}
+	if v.ErrLctn != nil {
+		errs = append(errs, common.PrefixError("ErrLctn", v.ErrLctn.Validate()))
+	}
+	if v.RsnDesc != nil {
+		errs = append(errs, common.PrefixError("RsnDesc", v.RsnDesc.Validate()))
+	}
+	if v.AddtlData != nil {
+		errs = append(errs, common.PrefixError("AddtlData", v.AddtlData.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks Admi00200101 and its children against the schema.
+func (v Admi00200101) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("RltdRef", v.RltdRef.Validate()))
+	errs = append(errs, common.PrefixError("Rsn", v.Rsn.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks the facets of Max20000Text.
+func (v Max20000Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 20000); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max350Text.
+func (v Max350Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 350); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max35Text.
+func (v Max35Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 35); err != nil {
+		return err
+	}
+	return nil
+}
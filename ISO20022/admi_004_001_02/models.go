@@ -27,16 +27,12 @@ type Event2 struct {
 	EvtDesc *Max1000Text `xml:"EvtDesc"`
 
 	EvtTm *common.ISODateTime `xml:"EvtTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type SystemEventNotificationV02 struct {
 	XMLName xml.Name
 
 	EvtInf Event2 `xml:",any"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 // XSD SimpleType declarations
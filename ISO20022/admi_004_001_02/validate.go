@@ -0,0 +1,73 @@
+// Code generated by scripts/gen_validate.go; DO NOT EDIT.
+
+package admi_004_001_02
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+var (
+	patternMax4AlphaNumericText = regexp.MustCompile(`^(?:[a-zA-Z0-9]{1,4})$`)
+)
+
+// Validate checks Document and its children against the schema.
+func (v Document) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("SysEvtNtfctn", v.SysEvtNtfctn.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks Event2 and its children against the schema.
+func (v Event2) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("EvtCd", v.EvtCd.Validate()))
+	errs = append(errs, common.PrefixError("EvtParam", common.CheckOccurs(len(v.EvtParam), 0, -1)))
+	for i := range v.EvtParam {
+		errs = append(errs, common.PrefixError("EvtParam"+"["+strconv.Itoa(i)+"]", v.EvtParam[i].Validate()))
+	}
+	if v.EvtDesc != nil {
+		errs = append(errs, common.PrefixError("EvtDesc", v.EvtDesc.Validate()))
+	}
+	if v.EvtTm != nil {
+		errs = append(errs, common.PrefixError("EvtTm", v.EvtTm.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks SystemEventNotificationV02 and its children against the schema.
+func (v SystemEventNotificationV02) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("EvtInf", v.EvtInf.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks the facets of Max1000Text.
+func (v Max1000Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 1000); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max35Text.
+func (v Max35Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 35); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max4AlphaNumericText.
+func (v Max4AlphaNumericText) Validate() error {
+	if err := common.CheckPattern(string(v), patternMax4AlphaNumericText); err != nil {
+		return err
+	}
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
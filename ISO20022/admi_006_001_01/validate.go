@@ -0,0 +1,326 @@
+// Code generated by scripts/gen_validate.go; DO NOT EDIT.
+
+package admi_006_001_01
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+var (
+	patternAnyBICDec2014Identifier = regexp.MustCompile(`^(?:[A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1})$`)
+	patternCountryCode             = regexp.MustCompile(`^(?:[A-Z]{2,2})$`)
+	patternLEIIdentifier           = regexp.MustCompile(`^(?:[A-Z0-9]{18,18}[0-9]{2,2})$`)
+)
+
+// Validate checks Document and its children against the schema.
+func (v Document) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("RsndReq", v.RsndReq.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks GenericIdentification1 and its children against the schema.
+func (v GenericIdentification1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.SchmeNm != nil {
+		errs = append(errs, common.PrefixError("SchmeNm", v.SchmeNm.Validate()))
+	}
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GenericIdentification36 and its children against the schema.
+func (v GenericIdentification36) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	if v.SchmeNm != nil {
+		errs = append(errs, common.PrefixError("SchmeNm", v.SchmeNm.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks MessageHeader7 and its children against the schema.
+func (v MessageHeader7) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("MsgId", v.MsgId.Validate()))
+	if v.CreDtTm != nil {
+		errs = append(errs, common.PrefixError("CreDtTm", v.CreDtTm.Validate()))
+	}
+	if v.ReqTp != nil {
+		errs = append(errs, common.PrefixError("ReqTp", v.ReqTp.Validate()))
+	}
+	if v.OrgnlBizQry != nil {
+		errs = append(errs, common.PrefixError("OrgnlBizQry", v.OrgnlBizQry.Validate()))
+	}
+	if v.QryNm != nil {
+		errs = append(errs, common.PrefixError("QryNm", v.QryNm.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks NameAndAddress5 and its children against the schema.
+func (v NameAndAddress5) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	if v.Adr != nil {
+		errs = append(errs, common.PrefixError("Adr", v.Adr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks OriginalBusinessQuery1 and its children against the schema.
+func (v OriginalBusinessQuery1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("MsgId", v.MsgId.Validate()))
+	if v.MsgNmId != nil {
+		errs = append(errs, common.PrefixError("MsgNmId", v.MsgNmId.Validate()))
+	}
+	if v.CreDtTm != nil {
+		errs = append(errs, common.PrefixError("CreDtTm", v.CreDtTm.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks PartyIdentification120Choice and its children against the schema.
+func (v PartyIdentification120Choice) Validate() error {
+	var errs []error
+	if v.AnyBIC != nil {
+		errs = append(errs, common.PrefixError("AnyBIC", v.AnyBIC.Validate()))
+	}
+	if v.PrtryId != nil {
+		errs = append(errs, common.PrefixError("PrtryId", v.PrtryId.Validate()))
+	}
+	if v.NmAndAdr != nil {
+		errs = append(errs, common.PrefixError("NmAndAdr", v.NmAndAdr.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"AnyBIC", "PrtryId", "NmAndAdr"}, []bool{v.AnyBIC != nil, v.PrtryId != nil, v.NmAndAdr != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks PartyIdentification136 and its children against the schema.
+func (v PartyIdentification136) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.LEI != nil {
+		errs = append(errs, common.PrefixError("LEI", v.LEI.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks PostalAddress1 and its children against the schema.
+func (v PostalAddress1) Validate() error {
+	var errs []error
+	if v.AdrTp != nil {
+		errs = append(errs, common.PrefixError("AdrTp", v.AdrTp.Validate()))
+	}
+	errs = append(errs, common.PrefixError("AdrLine", common.CheckOccurs(len(v.AdrLine), 0, 5)))
+	for i := range v.AdrLine {
+		errs = append(errs, common.PrefixError("AdrLine"+"["+strconv.Itoa(i)+"]", v.AdrLine[i].Validate()))
+	}
+	if v.StrtNm != nil {
+		errs = append(errs, common.PrefixError("StrtNm", v.StrtNm.Validate()))
+	}
+	if v.BldgNb != nil {
+		errs = append(errs, common.PrefixError("BldgNb", v.BldgNb.Validate()))
+	}
+	if v.PstCd != nil {
+		errs = append(errs, common.PrefixError("PstCd", v.PstCd.Validate()))
+	}
+	if v.TwnNm != nil {
+		errs = append(errs, common.PrefixError("TwnNm", v.TwnNm.Validate()))
+	}
+	if v.CtrySubDvsn != nil {
+		errs = append(errs, common.PrefixError("CtrySubDvsn", v.CtrySubDvsn.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Ctry", v.Ctry.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks RequestType4Choice and its children against the schema.
+func (v RequestType4Choice) Validate() error {
+	var errs []error
+	if v.PmtCtrl != nil {
+		errs = append(errs, common.PrefixError("PmtCtrl", v.PmtCtrl.Validate()))
+	}
+	if v.Enqry != nil {
+		errs = append(errs, common.PrefixError("Enqry", v.Enqry.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"PmtCtrl", "Enqry", "Prtry"}, []bool{v.PmtCtrl != nil, v.Enqry != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks ResendRequestV01 and its children against the schema.
+func (v ResendRequestV01) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("MsgHdr", v.MsgHdr.Validate()))
+	errs = append(errs, common.PrefixError("RsndSchCrit", common.CheckOccurs(len(v.RsndSchCrit), 1, -1)))
+	for i := range v.RsndSchCrit {
+		errs = append(errs, common.PrefixError("RsndSchCrit"+"["+strconv.Itoa(i)+"]", v.RsndSchCrit[i].Validate()))
+	}
+	errs = append(errs, common.PrefixError("SplmtryData", common.CheckOccurs(len(v.SplmtryData), 0, -1)))
+	for i := range v.SplmtryData {
+		errs = append(errs, common.PrefixError("SplmtryData"+"["+strconv.Itoa(i)+"]", v.SplmtryData[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks ResendSearchCriteria2 and its children against the schema.
+func (v ResendSearchCriteria2) Validate() error {
+	var errs []error
+	if v.BizDt != nil {
+		errs = append(errs, common.PrefixError("BizDt", v.BizDt.Validate()))
+	}
+	if v.SeqNb != nil {
+		errs = append(errs, common.PrefixError("SeqNb", v.SeqNb.Validate()))
+	}
+	if v.SeqRg != nil {
+		errs = append(errs, common.PrefixError("SeqRg", v.SeqRg.Validate()))
+	}
+	if v.OrgnlMsgNmId != nil {
+		errs = append(errs, common.PrefixError("OrgnlMsgNmId", v.OrgnlMsgNmId.Validate()))
+	}
+	if v.FileRef != nil {
+		errs = append(errs, common.PrefixError("FileRef", v.FileRef.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Rcpt", v.Rcpt.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks SequenceRange1 and its children against the schema.
+func (v SequenceRange1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("FrSeq", v.FrSeq.Validate()))
+	errs = append(errs, common.PrefixError("ToSeq", v.ToSeq.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks SequenceRange1Choice and its children against the schema.
+func (v SequenceRange1Choice) Validate() error {
+	var errs []error
+	if v.FrSeq != nil {
+		errs = append(errs, common.PrefixError("FrSeq", v.FrSeq.Validate()))
+	}
+	if v.ToSeq != nil {
+		errs = append(errs, common.PrefixError("ToSeq", v.ToSeq.Validate()))
+	}
+	errs = append(errs, common.PrefixError("FrToSeq", common.CheckOccurs(len(v.FrToSeq), 0, -1)))
+	for i := range v.FrToSeq {
+		errs = append(errs, common.PrefixError("FrToSeq"+"["+strconv.Itoa(i)+"]", v.FrToSeq[i].Validate()))
+	}
+	errs = append(errs, common.PrefixError("EQSeq", common.CheckOccurs(len(v.EQSeq), 0, -1)))
+	for i := range v.EQSeq {
+		errs = append(errs, common.PrefixError("EQSeq"+"["+strconv.Itoa(i)+"]", v.EQSeq[i].Validate()))
+	}
+	errs = append(errs, common.PrefixError("NEQSeq", common.CheckOccurs(len(v.NEQSeq), 0, -1)))
+	for i := range v.NEQSeq {
+		errs = append(errs, common.PrefixError("NEQSeq"+"["+strconv.Itoa(i)+"]", v.NEQSeq[i].Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"FrSeq", "ToSeq", "FrToSeq", "EQSeq", "NEQSeq"}, []bool{v.FrSeq != nil, v.ToSeq != nil, len(v.FrToSeq) > 0, len(v.EQSeq) > 0, len(v.NEQSeq) > 0}))
+	return errors.Join(errs...)
+}
+
+// Validate checks SupplementaryData1 and its children against the schema.
+func (v SupplementaryData1) Validate() error {
+	var errs []error
+	if v.PlcAndNm != nil {
+		errs = append(errs, common.PrefixError("PlcAndNm", v.PlcAndNm.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Envlp", v.Envlp.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks SupplementaryDataEnvelope1 and its children against the schema.
+func (v SupplementaryDataEnvelope1) Validate() error {
+	return nil
+}
+
+// Validate checks the facets of AddressType2Code.
+func (v AddressType2Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "ADDR", "PBOX", "HOME", "BIZZ", "MLTO", "DLVY"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of AnyBICDec2014Identifier.
+func (v AnyBICDec2014Identifier) Validate() error {
+	if err := common.CheckPattern(string(v), patternAnyBICDec2014Identifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of CountryCode.
+func (v CountryCode) Validate() error {
+	if err := common.CheckPattern(string(v), patternCountryCode); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalEnquiryRequestType1Code.
+func (v ExternalEnquiryRequestType1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalPaymentControlRequestType1Code.
+func (v ExternalPaymentControlRequestType1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of LEIIdentifier.
+func (v LEIIdentifier) Validate() error {
+	if err := common.CheckPattern(string(v), patternLEIIdentifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max16Text.
+func (v Max16Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 16); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max350Text.
+func (v Max350Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 350); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max35Text.
+func (v Max35Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 35); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max70Text.
+func (v Max70Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 70); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+// Code generated by scripts/gen_codes.go; DO NOT EDIT.
+
+package admi_007_001_01
+
+// IsValid reports whether c is one of the AddressType2Code codes.
+func (c AddressType2Code) IsValid() bool {
+	switch c {
+	case AddressType2CodeAddr,
+		AddressType2CodePbox,
+		AddressType2CodeHome,
+		AddressType2CodeBizz,
+		AddressType2CodeMlto,
+		AddressType2CodeDlvy:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,261 @@
+// Code generated by scripts/gen_validate.go; DO NOT EDIT.
+
+package admi_007_001_01
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+var (
+	patternAnyBICDec2014Identifier = regexp.MustCompile(`^(?:[A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1})$`)
+	patternCountryCode             = regexp.MustCompile(`^(?:[A-Z]{2,2})$`)
+	patternLEIIdentifier           = regexp.MustCompile(`^(?:[A-Z0-9]{18,18}[0-9]{2,2})$`)
+	patternMax4AlphaNumericText    = regexp.MustCompile(`^(?:[a-zA-Z0-9]{1,4})$`)
+)
+
+// Validate checks Document and its children against the schema.
+func (v Document) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("RctAck", v.RctAck.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks GenericIdentification36 and its children against the schema.
+func (v GenericIdentification36) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	if v.SchmeNm != nil {
+		errs = append(errs, common.PrefixError("SchmeNm", v.SchmeNm.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks MessageHeader10 and its children against the schema.
+func (v MessageHeader10) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("MsgId", v.MsgId.Validate()))
+	if v.CreDtTm != nil {
+		errs = append(errs, common.PrefixError("CreDtTm", v.CreDtTm.Validate()))
+	}
+	if v.QryNm != nil {
+		errs = append(errs, common.PrefixError("QryNm", v.QryNm.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks MessageReference1 and its children against the schema.
+func (v MessageReference1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Ref", v.Ref.Validate()))
+	if v.MsgNm != nil {
+		errs = append(errs, common.PrefixError("MsgNm", v.MsgNm.Validate()))
+	}
+	if v.RefIssr != nil {
+		errs = append(errs, common.PrefixError("RefIssr", v.RefIssr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks NameAndAddress5 and its children against the schema.
+func (v NameAndAddress5) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	if v.Adr != nil {
+		errs = append(errs, common.PrefixError("Adr", v.Adr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks PartyIdentification120Choice and its children against the schema.
+func (v PartyIdentification120Choice) Validate() error {
+	var errs []error
+	if v.AnyBIC != nil {
+		errs = append(errs, common.PrefixError("AnyBIC", v.AnyBIC.Validate()))
+	}
+	if v.PrtryId != nil {
+		errs = append(errs, common.PrefixError("PrtryId", v.PrtryId.Validate()))
+	}
+	if v.NmAndAdr != nil {
+		errs = append(errs, common.PrefixError("NmAndAdr", v.NmAndAdr.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"AnyBIC", "PrtryId", "NmAndAdr"}, []bool{v.AnyBIC != nil, v.PrtryId != nil, v.NmAndAdr != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks PartyIdentification136 and its children against the schema.
+func (v PartyIdentification136) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.LEI != nil {
+		errs = append(errs, common.PrefixError("LEI", v.LEI.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks PostalAddress1 and its children against the schema.
+func (v PostalAddress1) Validate() error {
+	var errs []error
+	if v.AdrTp != nil {
+		errs = append(errs, common.PrefixError("AdrTp", v.AdrTp.Validate()))
+	}
+	errs = append(errs, common.PrefixError("AdrLine", common.CheckOccurs(len(v.AdrLine), 0, 5)))
+	for i := range v.AdrLine {
+		errs = append(errs, common.PrefixError("AdrLine"+"["+strconv.Itoa(i)+"]", v.AdrLine[i].Validate()))
+	}
+	if v.StrtNm != nil {
+		errs = append(errs, common.PrefixError("StrtNm", v.StrtNm.Validate()))
+	}
+	if v.BldgNb != nil {
+		errs = append(errs, common.PrefixError("BldgNb", v.BldgNb.Validate()))
+	}
+	if v.PstCd != nil {
+		errs = append(errs, common.PrefixError("PstCd", v.PstCd.Validate()))
+	}
+	if v.TwnNm != nil {
+		errs = append(errs, common.PrefixError("TwnNm", v.TwnNm.Validate()))
+	}
+	if v.CtrySubDvsn != nil {
+		errs = append(errs, common.PrefixError("CtrySubDvsn", v.CtrySubDvsn.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Ctry", v.Ctry.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks ReceiptAcknowledgementReport2 and its children against the schema.
+func (v ReceiptAcknowledgementReport2) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("RltdRef", v.RltdRef.Validate()))
+	errs = append(errs, common.PrefixError("ReqHdlg", v.ReqHdlg.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks ReceiptAcknowledgementV01 and its children against the schema.
+func (v ReceiptAcknowledgementV01) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("MsgId", v.MsgId.Validate()))
+	errs = append(errs, common.PrefixError("Rpt", common.CheckOccurs(len(v.Rpt), 1, -1)))
+	for i := range v.Rpt {
+		errs = append(errs, common.PrefixError("Rpt"+"["+strconv.Itoa(i)+"]", v.Rpt[i].Validate()))
+	}
+	errs = append(errs, common.PrefixError("SplmtryData", common.CheckOccurs(len(v.SplmtryData), 0, -1)))
+	for i := range v.SplmtryData {
+		errs = append(errs, common.PrefixError("SplmtryData"+"["+strconv.Itoa(i)+"]", v.SplmtryData[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks RequestHandling2 and its children against the schema.
+func (v RequestHandling2) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("StsCd", v.StsCd.Validate()))
+	if v.StsDtTm != nil {
+		errs = append(errs, common.PrefixError("StsDtTm", v.StsDtTm.Validate()))
+	}
+	if v.Desc != nil {
+		errs = append(errs, common.PrefixError("Desc", v.Desc.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks SupplementaryData1 and its children against the schema.
+func (v SupplementaryData1) Validate() error {
+	var errs []error
+	if v.PlcAndNm != nil {
+		errs = append(errs, common.PrefixError("PlcAndNm", v.PlcAndNm.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Envlp", v.Envlp.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks SupplementaryDataEnvelope1 and its children against the schema.
+func (v SupplementaryDataEnvelope1) Validate() error {
+	return nil
+}
+
+// Validate checks the facets of AddressType2Code.
+func (v AddressType2Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "ADDR", "PBOX", "HOME", "BIZZ", "MLTO", "DLVY"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of AnyBICDec2014Identifier.
+func (v AnyBICDec2014Identifier) Validate() error {
+	if err := common.CheckPattern(string(v), patternAnyBICDec2014Identifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of CountryCode.
+func (v CountryCode) Validate() error {
+	if err := common.CheckPattern(string(v), patternCountryCode); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of LEIIdentifier.
+func (v LEIIdentifier) Validate() error {
+	if err := common.CheckPattern(string(v), patternLEIIdentifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max140Text.
+func (v Max140Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 140); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max16Text.
+func (v Max16Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 16); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max350Text.
+func (v Max350Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 350); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max35Text.
+func (v Max35Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 35); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max4AlphaNumericText.
+func (v Max4AlphaNumericText) Validate() error {
+	if err := common.CheckPattern(string(v), patternMax4AlphaNumericText); err != nil {
+		return err
+	}
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max70Text.
+func (v Max70Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 70); err != nil {
+		return err
+	}
+	return nil
+}
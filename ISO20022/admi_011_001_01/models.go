@@ -27,8 +27,6 @@ type Event1 struct {
 	EvtDesc *Max350Text `xml:"EvtDesc"`
 
 	EvtTm *common.ISODateTime `xml:"EvtTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type SupplementaryData1 struct {
@@ -37,8 +35,6 @@ type SupplementaryData1 struct {
 	PlcAndNm *Max350Text `xml:"PlcAndNm"`
 
 	Envlp SupplementaryDataEnvelope1 `xml:"Envlp"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type SupplementaryDataEnvelope1 struct {
@@ -59,8 +55,6 @@ type SystemEventAcknowledgementV01 struct {
 	AckDtls *Event1 `xml:"AckDtls"`
 
 	SplmtryData []SupplementaryData1 `xml:"SplmtryData"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 // XSD SimpleType declarations
@@ -0,0 +1,110 @@
+// Code generated by scripts/gen_validate.go; DO NOT EDIT.
+
+package admi_011_001_01
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+var (
+	patternExact4AlphaNumericText = regexp.MustCompile(`^(?:[a-zA-Z0-9]{4})$`)
+	patternMax4AlphaNumericText   = regexp.MustCompile(`^(?:[a-zA-Z0-9]{1,4})$`)
+)
+
+// Validate checks Document and its children against the schema.
+func (v Document) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("SysEvtAck", v.SysEvtAck.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks Event1 and its children against the schema.
+func (v Event1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("EvtCd", v.EvtCd.Validate()))
+	errs = append(errs, common.PrefixError("EvtParam", common.CheckOccurs(len(v.EvtParam), 0, -1)))
+	for i := range v.EvtParam {
+		errs = append(errs, common.PrefixError("EvtParam"+"["+strconv.Itoa(i)+"]", v.EvtParam[i].Validate()))
+	}
+	if v.EvtDesc != nil {
+		errs = append(errs, common.PrefixError("EvtDesc", v.EvtDesc.Validate()))
+	}
+	if v.EvtTm != nil {
+		errs = append(errs, common.PrefixError("EvtTm", v.EvtTm.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks SupplementaryData1 and its children against the schema.
+func (v SupplementaryData1) Validate() error {
+	var errs []error
+	if v.PlcAndNm != nil {
+		errs = append(errs, common.PrefixError("PlcAndNm", v.PlcAndNm.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Envlp", v.Envlp.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks SupplementaryDataEnvelope1 and its children against the schema.
+func (v SupplementaryDataEnvelope1) Validate() error {
+	return nil
+}
+
+// Validate checks SystemEventAcknowledgementV01 and its children against the schema.
+func (v SystemEventAcknowledgementV01) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("MsgId", v.MsgId.Validate()))
+	if v.OrgtrRef != nil {
+		errs = append(errs, common.PrefixError("OrgtrRef", v.OrgtrRef.Validate()))
+	}
+	if v.SttlmSsnIdr != nil {
+		errs = append(errs, common.PrefixError("SttlmSsnIdr", v.SttlmSsnIdr.Validate()))
+	}
+	if v.AckDtls != nil {
+		errs = append(errs, common.PrefixError("AckDtls", v.AckDtls.Validate()))
+	}
+	errs = append(errs, common.PrefixError("SplmtryData", common.CheckOccurs(len(v.SplmtryData), 0, -1)))
+	for i := range v.SplmtryData {
+		errs = append(errs, common.PrefixError("SplmtryData"+"["+strconv.Itoa(i)+"]", v.SplmtryData[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks the facets of Exact4AlphaNumericText.
+func (v Exact4AlphaNumericText) Validate() error {
+	if err := common.CheckPattern(string(v), patternExact4AlphaNumericText); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max350Text.
+func (v Max350Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 350); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max35Text.
+func (v Max35Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 35); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max4AlphaNumericText.
+func (v Max4AlphaNumericText) Validate() error {
+	if err := common.CheckPattern(string(v), patternMax4AlphaNumericText); err != nil {
+		return err
+	}
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
@@ -27,16 +27,12 @@ type AdministrationProprietaryMessageV02 struct {
 	Othr *MessageReference `xml:"Othr"`
 
 	PrtryData ProprietaryData5 `xml:"PrtryData"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type MessageReference struct {
 	XMLName xml.Name
 
 	Ref Max35Text `xml:",any"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ProprietaryData5 struct {
@@ -45,8 +41,6 @@ type ProprietaryData5 struct {
 	Tp Max35Text `xml:"Tp"`
 
 	Data SupplementaryDataEnvelope1 `xml:"Data"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type SupplementaryDataEnvelope1 struct {
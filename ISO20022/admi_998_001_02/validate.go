@@ -0,0 +1,63 @@
+// Code generated by scripts/gen_validate.go; DO NOT EDIT.
+
+package admi_998_001_02
+
+import (
+	"errors"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+// Validate checks Document and its children against the schema.
+func (v Document) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("AdmstnPrtryMsg", v.AdmstnPrtryMsg.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks AdministrationProprietaryMessageV02 and its children against the schema.
+func (v AdministrationProprietaryMessageV02) Validate() error {
+	var errs []error
+	if v.MsgId != nil {
+		errs = append(errs, common.PrefixError("MsgId", v.MsgId.Validate()))
+	}
+	if v.Rltd != nil {
+		errs = append(errs, common.PrefixError("Rltd", v.Rltd.Validate()))
+	}
+	if v.Prvs != nil {
+		errs = append(errs, common.PrefixError("Prvs", v.Prvs.Validate()))
+	}
+	if v.Othr != nil {
+		errs = append(errs, common.PrefixError("Othr", v.Othr.Validate()))
+	}
+	errs = append(errs, common.PrefixError("PrtryData", v.PrtryData.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks MessageReference and its children against the schema.
+func (v MessageReference) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Ref", v.Ref.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks ProprietaryData5 and its children against the schema.
+func (v ProprietaryData5) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	errs = append(errs, common.PrefixError("Data", v.Data.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks SupplementaryDataEnvelope1 and its children against the schema.
+func (v SupplementaryDataEnvelope1) Validate() error {
+	return nil
+}
+
+// Validate checks the facets of Max35Text.
+func (v Max35Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 35); err != nil {
+		return err
+	}
+	return nil
+}
@@ -23,8 +23,6 @@ type AccountIdentification4Choice struct {
 	IBAN *IBAN2007Identifier `xml:"IBAN"`
 
 	Othr *GenericAccountIdentification1 `xml:"Othr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type AccountSchemeName1Choice struct {
@@ -33,8 +31,6 @@ type AccountSchemeName1Choice struct {
 	Cd *ExternalAccountIdentification1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ActiveOrHistoricCurrencyAndAmount struct {
@@ -42,8 +38,7 @@ type ActiveOrHistoricCurrencyAndAmount struct {
 
 	Ccy ActiveOrHistoricCurrencyCode `xml:"Ccy,attr"`
 
-	Text     string `xml:",chardata"`
-	InnerXml string `xml:",innerxml"`
+	Text string `xml:",chardata"`
 }
 
 type AddressType3Choice struct {
@@ -52,8 +47,6 @@ type AddressType3Choice struct {
 	Cd *AddressType2Code `xml:"Cd"`
 
 	Prtry *GenericIdentification30 `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type AmendmentInformationDetails13 struct {
@@ -82,8 +75,6 @@ type AmendmentInformationDetails13 struct {
 	OrgnlRsn *MandateSetupReason1Choice `xml:"OrgnlRsn"`
 
 	OrgnlTrckgDays *Exact2NumericText `xml:"OrgnlTrckgDays"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type AmountType4Choice struct {
@@ -92,8 +83,6 @@ type AmountType4Choice struct {
 	InstdAmt *ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt"`
 
 	EqvtAmt *EquivalentAmount2 `xml:"EqvtAmt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type BranchAndFinancialInstitutionIdentification6 struct {
@@ -102,8 +91,6 @@ type BranchAndFinancialInstitutionIdentification6 struct {
 	FinInstnId FinancialInstitutionIdentification18 `xml:"FinInstnId"`
 
 	BrnchId *BranchData3 `xml:"BrnchId"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type BranchData3 struct {
@@ -116,8 +103,6 @@ type BranchData3 struct {
 	Nm *Max140Text `xml:"Nm"`
 
 	PstlAdr *PostalAddress24 `xml:"PstlAdr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Case5 struct {
@@ -128,8 +113,6 @@ type Case5 struct {
 	Cretr Party40Choice `xml:"Cretr"`
 
 	ReopCaseIndctn *YesNoIndicator `xml:"ReopCaseIndctn"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CaseAssignment5 struct {
@@ -142,8 +125,6 @@ type CaseAssignment5 struct {
 	Assgne Party40Choice `xml:"Assgne"`
 
 	CreDtTm common.ISODateTime `xml:"CreDtTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CashAccount38 struct {
@@ -158,8 +139,6 @@ type CashAccount38 struct {
 	Nm *Max70Text `xml:"Nm"`
 
 	Prxy *ProxyAccountIdentification1 `xml:"Prxy"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CashAccountType2Choice struct {
@@ -168,8 +147,6 @@ type CashAccountType2Choice struct {
 	Cd *ExternalCashAccountType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CategoryPurpose1Choice struct {
@@ -178,8 +155,6 @@ type CategoryPurpose1Choice struct {
 	Cd *ExternalCategoryPurpose1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ClearingSystemIdentification2Choice struct {
@@ -188,8 +163,6 @@ type ClearingSystemIdentification2Choice struct {
 	Cd *ExternalClearingSystemIdentification1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ClearingSystemIdentification3Choice struct {
@@ -198,8 +171,6 @@ type ClearingSystemIdentification3Choice struct {
 	Cd *ExternalCashClearingSystem1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -208,8 +179,6 @@ type ClearingSystemMemberIdentification2 struct {
 	ClrSysId *ClearingSystemIdentification2Choice `xml:"ClrSysId"`
 
 	MmbId Max35Text `xml:"MmbId"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Contact4 struct {
@@ -238,8 +207,6 @@ type Contact4 struct {
 	Othr []OtherContact1 `xml:"Othr"`
 
 	PrefrdMtd *PreferredContactMethod1Code `xml:"PrefrdMtd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CreditorReferenceInformation2 struct {
@@ -248,8 +215,6 @@ type CreditorReferenceInformation2 struct {
 	Tp *CreditorReferenceType2 `xml:"Tp"`
 
 	Ref *Max35Text `xml:"Ref"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CreditorReferenceType1Choice struct {
@@ -258,8 +223,6 @@ type CreditorReferenceType1Choice struct {
 	Cd *DocumentType3Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CreditorReferenceType2 struct {
@@ -268,8 +231,6 @@ type CreditorReferenceType2 struct {
 	CdOrPrtry CreditorReferenceType1Choice `xml:"CdOrPrtry"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DateAndDateTime2Choice struct {
@@ -278,8 +239,6 @@ type DateAndDateTime2Choice struct {
 	Dt *common.ISODate `xml:"Dt"`
 
 	DtTm *common.ISODateTime `xml:"DtTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DateAndPlaceOfBirth1 struct {
@@ -292,8 +251,6 @@ type DateAndPlaceOfBirth1 struct {
 	CityOfBirth Max35Text `xml:"CityOfBirth"`
 
 	CtryOfBirth CountryCode `xml:"CtryOfBirth"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DatePeriod2 struct {
@@ -302,8 +259,6 @@ type DatePeriod2 struct {
 	FrDt common.ISODate `xml:"FrDt"`
 
 	ToDt common.ISODate `xml:"ToDt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DiscountAmountAndType1 struct {
@@ -312,8 +267,6 @@ type DiscountAmountAndType1 struct {
 	Tp *DiscountAmountType1Choice `xml:"Tp"`
 
 	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DiscountAmountType1Choice struct {
@@ -322,8 +275,6 @@ type DiscountAmountType1Choice struct {
 	Cd *ExternalDiscountAmountType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DocumentAdjustment1 struct {
@@ -336,8 +287,6 @@ type DocumentAdjustment1 struct {
 	Rsn *Max4Text `xml:"Rsn"`
 
 	AddtlInf *Max140Text `xml:"AddtlInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DocumentLineIdentification1 struct {
@@ -348,8 +297,6 @@ type DocumentLineIdentification1 struct {
 	Nb *Max35Text `xml:"Nb"`
 
 	RltdDt *common.ISODate `xml:"RltdDt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DocumentLineInformation1 struct {
@@ -360,8 +307,6 @@ type DocumentLineInformation1 struct {
 	Desc *Max2048Text `xml:"Desc"`
 
 	Amt *RemittanceAmount3 `xml:"Amt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DocumentLineType1 struct {
@@ -370,8 +315,6 @@ type DocumentLineType1 struct {
 	CdOrPrtry DocumentLineType1Choice `xml:"CdOrPrtry"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DocumentLineType1Choice struct {
@@ -380,8 +323,6 @@ type DocumentLineType1Choice struct {
 	Cd *ExternalDocumentLineType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type EquivalentAmount2 struct {
@@ -390,8 +331,6 @@ type EquivalentAmount2 struct {
 	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
 
 	CcyOfTrf ActiveOrHistoricCurrencyCode `xml:"CcyOfTrf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
@@ -400,8 +339,6 @@ type FinancialIdentificationSchemeName1Choice struct {
 	Cd *ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type FinancialInstitutionIdentification18 struct {
@@ -418,8 +355,6 @@ type FinancialInstitutionIdentification18 struct {
 	PstlAdr *PostalAddress24 `xml:"PstlAdr"`
 
 	Othr *GenericFinancialIdentification1 `xml:"Othr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Frequency36Choice struct {
@@ -430,8 +365,6 @@ type Frequency36Choice struct {
 	Prd *FrequencyPeriod1 `xml:"Prd"`
 
 	PtInTm *FrequencyAndMoment1 `xml:"PtInTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type FrequencyAndMoment1 struct {
@@ -440,8 +373,6 @@ type FrequencyAndMoment1 struct {
 	Tp Frequency6Code `xml:"Tp"`
 
 	PtInTm Exact2NumericText `xml:"PtInTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type FrequencyPeriod1 struct {
@@ -450,8 +381,6 @@ type FrequencyPeriod1 struct {
 	Tp Frequency6Code `xml:"Tp"`
 
 	CntPerPrd DecimalNumber `xml:"CntPerPrd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Garnishment3 struct {
@@ -472,8 +401,6 @@ type Garnishment3 struct {
 	FmlyMdclInsrncInd *TrueFalseIndicator `xml:"FmlyMdclInsrncInd"`
 
 	MplyeeTermntnInd *TrueFalseIndicator `xml:"MplyeeTermntnInd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GarnishmentType1 struct {
@@ -482,8 +409,6 @@ type GarnishmentType1 struct {
 	CdOrPrtry GarnishmentType1Choice `xml:"CdOrPrtry"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GarnishmentType1Choice struct {
@@ -492,8 +417,6 @@ type GarnishmentType1Choice struct {
 	Cd *ExternalGarnishmentType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericAccountIdentification1 struct {
@@ -504,8 +427,6 @@ type GenericAccountIdentification1 struct {
 	SchmeNm *AccountSchemeName1Choice `xml:"SchmeNm"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericFinancialIdentification1 struct {
@@ -516,8 +437,6 @@ type GenericFinancialIdentification1 struct {
 	SchmeNm *FinancialIdentificationSchemeName1Choice `xml:"SchmeNm"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericIdentification30 struct {
@@ -528,8 +447,6 @@ type GenericIdentification30 struct {
 	Issr Max35Text `xml:"Issr"`
 
 	SchmeNm *Max35Text `xml:"SchmeNm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericOrganisationIdentification1 struct {
@@ -540,8 +457,6 @@ type GenericOrganisationIdentification1 struct {
 	SchmeNm *OrganisationIdentificationSchemeName1Choice `xml:"SchmeNm"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericPersonIdentification1 struct {
@@ -552,8 +467,6 @@ type GenericPersonIdentification1 struct {
 	SchmeNm *PersonIdentificationSchemeName1Choice `xml:"SchmeNm"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type LocalInstrument2Choice struct {
@@ -562,8 +475,6 @@ type LocalInstrument2Choice struct {
 	Cd *ExternalLocalInstrument1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type MandateRelatedInformation14 struct {
@@ -588,8 +499,6 @@ type MandateRelatedInformation14 struct {
 	Rsn *MandateSetupReason1Choice `xml:"Rsn"`
 
 	TrckgDays *Exact2NumericText `xml:"TrckgDays"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type MandateSetupReason1Choice struct {
@@ -598,8 +507,6 @@ type MandateSetupReason1Choice struct {
 	Cd *ExternalMandateSetupReason1Code `xml:"Cd"`
 
 	Prtry *Max70Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type MissingOrIncorrectInformation3 struct {
@@ -610,8 +517,6 @@ type MissingOrIncorrectInformation3 struct {
 	MssngInf []UnableToApplyMissing1 `xml:"MssngInf"`
 
 	IncrrctInf []UnableToApplyIncorrect1 `xml:"IncrrctInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OrganisationIdentification29 struct {
@@ -622,8 +527,6 @@ type OrganisationIdentification29 struct {
 	LEI *LEIIdentifier `xml:"LEI"`
 
 	Othr []GenericOrganisationIdentification1 `xml:"Othr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
@@ -632,8 +535,6 @@ type OrganisationIdentificationSchemeName1Choice struct {
 	Cd *ExternalOrganisationIdentification1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OriginalGroupInformation29 struct {
@@ -644,8 +545,6 @@ type OriginalGroupInformation29 struct {
 	OrgnlMsgNmId Max35Text `xml:"OrgnlMsgNmId"`
 
 	OrgnlCreDtTm *common.ISODateTime `xml:"OrgnlCreDtTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OriginalTransactionReference28 struct {
@@ -694,8 +593,6 @@ type OriginalTransactionReference28 struct {
 	UltmtCdtr *Party40Choice `xml:"UltmtCdtr"`
 
 	Purp *Purpose2Choice `xml:"Purp"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OtherContact1 struct {
@@ -704,8 +601,6 @@ type OtherContact1 struct {
 	ChanlTp Max4Text `xml:"ChanlTp"`
 
 	Id *Max128Text `xml:"Id"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Party38Choice struct {
@@ -714,8 +609,6 @@ type Party38Choice struct {
 	OrgId *OrganisationIdentification29 `xml:"OrgId"`
 
 	PrvtId *PersonIdentification13 `xml:"PrvtId"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Party40Choice struct {
@@ -724,8 +617,6 @@ type Party40Choice struct {
 	Pty *PartyIdentification135 `xml:"Pty"`
 
 	Agt *BranchAndFinancialInstitutionIdentification6 `xml:"Agt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PartyIdentification135 struct {
@@ -740,8 +631,6 @@ type PartyIdentification135 struct {
 	CtryOfRes *CountryCode `xml:"CtryOfRes"`
 
 	CtctDtls *Contact4 `xml:"CtctDtls"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PaymentTypeInformation27 struct {
@@ -758,8 +647,6 @@ type PaymentTypeInformation27 struct {
 	SeqTp *SequenceType3Code `xml:"SeqTp"`
 
 	CtgyPurp *CategoryPurpose1Choice `xml:"CtgyPurp"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PersonIdentification13 struct {
@@ -768,8 +655,6 @@ type PersonIdentification13 struct {
 	DtAndPlcOfBirth *DateAndPlaceOfBirth1 `xml:"DtAndPlcOfBirth"`
 
 	Othr []GenericPersonIdentification1 `xml:"Othr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PersonIdentificationSchemeName1Choice struct {
@@ -778,8 +663,6 @@ type PersonIdentificationSchemeName1Choice struct {
 	Cd *ExternalPersonIdentification1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PostalAddress24 struct {
@@ -816,8 +699,6 @@ type PostalAddress24 struct {
 	Ctry *CountryCode `xml:"Ctry"`
 
 	AdrLine []Max70Text `xml:"AdrLine"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ProxyAccountIdentification1 struct {
@@ -826,8 +707,6 @@ type ProxyAccountIdentification1 struct {
 	Tp *ProxyAccountType1Choice `xml:"Tp"`
 
 	Id Max2048Text `xml:"Id"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ProxyAccountType1Choice struct {
@@ -836,8 +715,6 @@ type ProxyAccountType1Choice struct {
 	Cd *ExternalProxyAccountType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Purpose2Choice struct {
@@ -846,8 +723,6 @@ type Purpose2Choice struct {
 	Cd *ExternalPurpose1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ReferredDocumentInformation7 struct {
@@ -860,8 +735,6 @@ type ReferredDocumentInformation7 struct {
 	RltdDt *common.ISODate `xml:"RltdDt"`
 
 	LineDtls []DocumentLineInformation1 `xml:"LineDtls"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ReferredDocumentType3Choice struct {
@@ -870,8 +743,6 @@ type ReferredDocumentType3Choice struct {
 	Cd *DocumentType6Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ReferredDocumentType4 struct {
@@ -880,8 +751,6 @@ type ReferredDocumentType4 struct {
 	CdOrPrtry ReferredDocumentType3Choice `xml:"CdOrPrtry"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type RemittanceAmount2 struct {
@@ -898,8 +767,6 @@ type RemittanceAmount2 struct {
 	AdjstmntAmtAndRsn []DocumentAdjustment1 `xml:"AdjstmntAmtAndRsn"`
 
 	RmtdAmt *ActiveOrHistoricCurrencyAndAmount `xml:"RmtdAmt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type RemittanceAmount3 struct {
@@ -916,8 +783,6 @@ type RemittanceAmount3 struct {
 	AdjstmntAmtAndRsn []DocumentAdjustment1 `xml:"AdjstmntAmtAndRsn"`
 
 	RmtdAmt *ActiveOrHistoricCurrencyAndAmount `xml:"RmtdAmt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type RemittanceInformation16 struct {
@@ -926,8 +791,6 @@ type RemittanceInformation16 struct {
 	Ustrd []Max140Text `xml:"Ustrd"`
 
 	Strd []StructuredRemittanceInformation16 `xml:"Strd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ServiceLevel8Choice struct {
@@ -936,8 +799,6 @@ type ServiceLevel8Choice struct {
 	Cd *ExternalServiceLevel1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type SettlementInstruction7 struct {
@@ -960,8 +821,6 @@ type SettlementInstruction7 struct {
 	ThrdRmbrsmntAgt *BranchAndFinancialInstitutionIdentification6 `xml:"ThrdRmbrsmntAgt"`
 
 	ThrdRmbrsmntAgtAcct *CashAccount38 `xml:"ThrdRmbrsmntAgtAcct"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type StructuredRemittanceInformation16 struct {
@@ -982,8 +841,6 @@ type StructuredRemittanceInformation16 struct {
 	GrnshmtRmt *Garnishment3 `xml:"GrnshmtRmt"`
 
 	AddtlRmtInf []Max140Text `xml:"AddtlRmtInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type SupplementaryData1 struct {
@@ -992,8 +849,6 @@ type SupplementaryData1 struct {
 	PlcAndNm *Max350Text `xml:"PlcAndNm"`
 
 	Envlp SupplementaryDataEnvelope1 `xml:"Envlp"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type SupplementaryDataEnvelope1 struct {
@@ -1012,8 +867,6 @@ type TaxAmount2 struct {
 	TtlAmt *ActiveOrHistoricCurrencyAndAmount `xml:"TtlAmt"`
 
 	Dtls []TaxRecordDetails2 `xml:"Dtls"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxAmountAndType1 struct {
@@ -1022,8 +875,6 @@ type TaxAmountAndType1 struct {
 	Tp *TaxAmountType1Choice `xml:"Tp"`
 
 	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxAmountType1Choice struct {
@@ -1032,8 +883,6 @@ type TaxAmountType1Choice struct {
 	Cd *ExternalTaxAmountType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxAuthorisation1 struct {
@@ -1042,8 +891,6 @@ type TaxAuthorisation1 struct {
 	Titl *Max35Text `xml:"Titl"`
 
 	Nm *Max140Text `xml:"Nm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxInformation7 struct {
@@ -1070,8 +917,6 @@ type TaxInformation7 struct {
 	SeqNb *Number `xml:"SeqNb"`
 
 	Rcrd []TaxRecord2 `xml:"Rcrd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxParty1 struct {
@@ -1082,8 +927,6 @@ type TaxParty1 struct {
 	RegnId *Max35Text `xml:"RegnId"`
 
 	TaxTp *Max35Text `xml:"TaxTp"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxParty2 struct {
@@ -1096,8 +939,6 @@ type TaxParty2 struct {
 	TaxTp *Max35Text `xml:"TaxTp"`
 
 	Authstn *TaxAuthorisation1 `xml:"Authstn"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxPeriod2 struct {
@@ -1108,8 +949,6 @@ type TaxPeriod2 struct {
 	Tp *TaxRecordPeriod1Code `xml:"Tp"`
 
 	FrToDt *DatePeriod2 `xml:"FrToDt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxRecord2 struct {
@@ -1132,8 +971,6 @@ type TaxRecord2 struct {
 	TaxAmt *TaxAmount2 `xml:"TaxAmt"`
 
 	AddtlInf *Max140Text `xml:"AddtlInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxRecordDetails2 struct {
@@ -1142,8 +979,6 @@ type TaxRecordDetails2 struct {
 	Prd *TaxPeriod2 `xml:"Prd"`
 
 	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type UnableToApplyIncorrect1 struct {
@@ -1152,8 +987,6 @@ type UnableToApplyIncorrect1 struct {
 	Cd UnableToApplyIncorrectInformation4Code `xml:"Cd"`
 
 	AddtlIncrrctInf *Max140Text `xml:"AddtlIncrrctInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type UnableToApplyJustification3Choice struct {
@@ -1164,8 +997,6 @@ type UnableToApplyJustification3Choice struct {
 	MssngOrIncrrctInf *MissingOrIncorrectInformation3 `xml:"MssngOrIncrrctInf"`
 
 	PssblDplctInstr *TrueFalseIndicator `xml:"PssblDplctInstr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type UnableToApplyMissing1 struct {
@@ -1174,8 +1005,6 @@ type UnableToApplyMissing1 struct {
 	Cd UnableToApplyMissingInformation3Code `xml:"Cd"`
 
 	AddtlMssngInf *Max140Text `xml:"AddtlMssngInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type UnableToApplyV07 struct {
@@ -1190,8 +1019,6 @@ type UnableToApplyV07 struct {
 	Justfn UnableToApplyJustification3Choice `xml:"Justfn"`
 
 	SplmtryData []SupplementaryData1 `xml:"SplmtryData"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type UnderlyingGroupInformation1 struct {
@@ -1204,8 +1031,6 @@ type UnderlyingGroupInformation1 struct {
 	OrgnlCreDtTm *common.ISODateTime `xml:"OrgnlCreDtTm"`
 
 	OrgnlMsgDlvryChanl *Max35Text `xml:"OrgnlMsgDlvryChanl"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type UnderlyingPaymentInstruction5 struct {
@@ -1228,8 +1053,6 @@ type UnderlyingPaymentInstruction5 struct {
 	ReqdColltnDt *common.ISODate `xml:"ReqdColltnDt"`
 
 	OrgnlTxRef *OriginalTransactionReference28 `xml:"OrgnlTxRef"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type UnderlyingPaymentTransaction4 struct {
@@ -1250,8 +1073,6 @@ type UnderlyingPaymentTransaction4 struct {
 	OrgnlIntrBkSttlmDt common.ISODate `xml:"OrgnlIntrBkSttlmDt"`
 
 	OrgnlTxRef *OriginalTransactionReference28 `xml:"OrgnlTxRef"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type UnderlyingStatementEntry3 struct {
@@ -1264,8 +1085,6 @@ type UnderlyingStatementEntry3 struct {
 	OrgnlNtryId *Max35Text `xml:"OrgnlNtryId"`
 
 	OrgnlUETR *UUIDv4Identifier `xml:"OrgnlUETR"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type UnderlyingTransaction5Choice struct {
@@ -1276,8 +1095,6 @@ type UnderlyingTransaction5Choice struct {
 	IntrBk *UnderlyingPaymentTransaction4 `xml:"IntrBk"`
 
 	StmtNtry *UnderlyingStatementEntry3 `xml:"StmtNtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 // XSD SimpleType declarations
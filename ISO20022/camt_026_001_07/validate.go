@@ -0,0 +1,2056 @@
+// Code generated by scripts/gen_validate.go; DO NOT EDIT.
+
+package camt_026_001_07
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+var (
+	patternActiveOrHistoricCurrencyCode = regexp.MustCompile(`^(?:[A-Z]{3,3})$`)
+	patternAnyBICDec2014Identifier      = regexp.MustCompile(`^(?:[A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1})$`)
+	patternBICFIDec2014Identifier       = regexp.MustCompile(`^(?:[A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1})$`)
+	patternCountryCode                  = regexp.MustCompile(`^(?:[A-Z]{2,2})$`)
+	patternExact2NumericText            = regexp.MustCompile(`^(?:[0-9]{2})$`)
+	patternExact4AlphaNumericText       = regexp.MustCompile(`^(?:[a-zA-Z0-9]{4})$`)
+	patternIBAN2007Identifier           = regexp.MustCompile(`^(?:[A-Z]{2,2}[0-9]{2,2}[a-zA-Z0-9]{1,30})$`)
+	patternLEIIdentifier                = regexp.MustCompile(`^(?:[A-Z0-9]{18,18}[0-9]{2,2})$`)
+	patternPhoneNumber                  = regexp.MustCompile(`^(?:\+[0-9]{1,3}-[0-9()+\-]{1,30})$`)
+	patternUUIDv4Identifier             = regexp.MustCompile(`^(?:[a-f0-9]{8}-[a-f0-9]{4}-4[a-f0-9]{3}-[89ab][a-f0-9]{3}-[a-f0-9]{12})$`)
+)
+
+// Validate checks Document and its children against the schema.
+func (v Document) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("UblToApply", v.UblToApply.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks AccountIdentification4Choice and its children against the schema.
+func (v AccountIdentification4Choice) Validate() error {
+	var errs []error
+	if v.IBAN != nil {
+		errs = append(errs, common.PrefixError("IBAN", v.IBAN.Validate()))
+	}
+	if v.Othr != nil {
+		errs = append(errs, common.PrefixError("Othr", v.Othr.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"IBAN", "Othr"}, []bool{v.IBAN != nil, v.Othr != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks AccountSchemeName1Choice and its children against the schema.
+func (v AccountSchemeName1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks ActiveOrHistoricCurrencyAndAmount and its children against the schema.
+func (v ActiveOrHistoricCurrencyAndAmount) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("@Ccy", v.Ccy.Validate()))
+	if err := common.CheckDecimal(v.Text, 18, 5, "0"); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks AddressType3Choice and its children against the schema.
+func (v AddressType3Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks AmendmentInformationDetails13 and its children against the schema.
+func (v AmendmentInformationDetails13) Validate() error {
+	var errs []error
+	if v.OrgnlMndtId != nil {
+		errs = append(errs, common.PrefixError("OrgnlMndtId", v.OrgnlMndtId.Validate()))
+	}
+	if v.OrgnlCdtrSchmeId != nil {
+		errs = append(errs, common.PrefixError("OrgnlCdtrSchmeId", v.OrgnlCdtrSchmeId.Validate()))
+	}
+	if v.OrgnlCdtrAgt != nil {
+		errs = append(errs, common.PrefixError("OrgnlCdtrAgt", v.OrgnlCdtrAgt.Validate()))
+	}
+	if v.OrgnlCdtrAgtAcct != nil {
+		errs = append(errs, common.PrefixError("OrgnlCdtrAgtAcct", v.OrgnlCdtrAgtAcct.Validate()))
+	}
+	if v.OrgnlDbtr != nil {
+		errs = append(errs, common.PrefixError("OrgnlDbtr", v.OrgnlDbtr.Validate()))
+	}
+	if v.OrgnlDbtrAcct != nil {
+		errs = append(errs, common.PrefixError("OrgnlDbtrAcct", v.OrgnlDbtrAcct.Validate()))
+	}
+	if v.OrgnlDbtrAgt != nil {
+		errs = append(errs, common.PrefixError("OrgnlDbtrAgt", v.OrgnlDbtrAgt.Validate()))
+	}
+	if v.OrgnlDbtrAgtAcct != nil {
+		errs = append(errs, common.PrefixError("OrgnlDbtrAgtAcct", v.OrgnlDbtrAgtAcct.Validate()))
+	}
+	if v.OrgnlFnlColltnDt != nil {
+		errs = append(errs, common.PrefixError("OrgnlFnlColltnDt", v.OrgnlFnlColltnDt.Validate()))
+	}
+	if v.OrgnlFrqcy != nil {
+		errs = append(errs, common.PrefixError("OrgnlFrqcy", v.OrgnlFrqcy.Validate()))
+	}
+	if v.OrgnlRsn != nil {
+		errs = append(errs, common.PrefixError("OrgnlRsn", v.OrgnlRsn.Validate()))
+	}
+	if v.OrgnlTrckgDays != nil {
+		errs = append(errs, common.PrefixError("OrgnlTrckgDays", v.OrgnlTrckgDays.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks AmountType4Choice and its children against the schema.
+func (v AmountType4Choice) Validate() error {
+	var errs []error
+	if v.InstdAmt != nil {
+		errs = append(errs, common.PrefixError("InstdAmt", v.InstdAmt.Validate()))
+	}
+	if v.EqvtAmt != nil {
+		errs = append(errs, common.PrefixError("EqvtAmt", v.EqvtAmt.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"InstdAmt", "EqvtAmt"}, []bool{v.InstdAmt != nil, v.EqvtAmt != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks BranchAndFinancialInstitutionIdentification6 and its children against the schema.
+func (v BranchAndFinancialInstitutionIdentification6) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("FinInstnId", v.FinInstnId.Validate()))
+	if v.BrnchId != nil {
+		errs = append(errs, common.PrefixError("BrnchId", v.BrnchId.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks BranchData3 and its children against the schema.
+func (v BranchData3) Validate() error {
+	var errs []error
+	if v.Id != nil {
+		errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	}
+	if v.LEI != nil {
+		errs = append(errs, common.PrefixError("LEI", v.LEI.Validate()))
+	}
+	if v.Nm != nil {
+		errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	}
+	if v.PstlAdr != nil {
+		errs = append(errs, common.PrefixError("PstlAdr", v.PstlAdr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks Case5 and its children against the schema.
+func (v Case5) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	errs = append(errs, common.PrefixError("Cretr", v.Cretr.Validate()))
+	if v.ReopCaseIndctn != nil {
+		errs = append(errs, common.PrefixError("ReopCaseIndctn", v.ReopCaseIndctn.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks CaseAssignment5 and its children against the schema.
+func (v CaseAssignment5) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	errs = append(errs, common.PrefixError("Assgnr", v.Assgnr.Validate()))
+	errs = append(errs, common.PrefixError("Assgne", v.Assgne.Validate()))
+	errs = append(errs, common.PrefixError("CreDtTm", v.CreDtTm.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks CashAccount38 and its children against the schema.
+func (v CashAccount38) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.Ccy != nil {
+		errs = append(errs, common.PrefixError("Ccy", v.Ccy.Validate()))
+	}
+	if v.Nm != nil {
+		errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	}
+	if v.Prxy != nil {
+		errs = append(errs, common.PrefixError("Prxy", v.Prxy.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks CashAccountType2Choice and its children against the schema.
+func (v CashAccountType2Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks CategoryPurpose1Choice and its children against the schema.
+func (v CategoryPurpose1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks ClearingSystemIdentification2Choice and its children against the schema.
+func (v ClearingSystemIdentification2Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks ClearingSystemIdentification3Choice and its children against the schema.
+func (v ClearingSystemIdentification3Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks ClearingSystemMemberIdentification2 and its children against the schema.
+func (v ClearingSystemMemberIdentification2) Validate() error {
+	var errs []error
+	if v.ClrSysId != nil {
+		errs = append(errs, common.PrefixError("ClrSysId", v.ClrSysId.Validate()))
+	}
+	errs = append(errs, common.PrefixError("MmbId", v.MmbId.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks Contact4 and its children against the schema.
+func (v Contact4) Validate() error {
+	var errs []error
+	if v.NmPrfx != nil {
+		errs = append(errs, common.PrefixError("NmPrfx", v.NmPrfx.Validate()))
+	}
+	if v.Nm != nil {
+		errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	}
+	if v.PhneNb != nil {
+		errs = append(errs, common.PrefixError("PhneNb", v.PhneNb.Validate()))
+	}
+	if v.MobNb != nil {
+		errs = append(errs, common.PrefixError("MobNb", v.MobNb.Validate()))
+	}
+	if v.FaxNb != nil {
+		errs = append(errs, common.PrefixError("FaxNb", v.FaxNb.Validate()))
+	}
+	if v.EmailAdr != nil {
+		errs = append(errs, common.PrefixError("EmailAdr", v.EmailAdr.Validate()))
+	}
+	if v.EmailPurp != nil {
+		errs = append(errs, common.PrefixError("EmailPurp", v.EmailPurp.Validate()))
+	}
+	if v.JobTitl != nil {
+		errs = append(errs, common.PrefixError("JobTitl", v.JobTitl.Validate()))
+	}
+	if v.Rspnsblty != nil {
+		errs = append(errs, common.PrefixError("Rspnsblty", v.Rspnsblty.Validate()))
+	}
+	if v.Dept != nil {
+		errs = append(errs, common.PrefixError("Dept", v.Dept.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Othr", common.CheckOccurs(len(v.Othr), 0, -1)))
+	for i := range v.Othr {
+		errs = append(errs, common.PrefixError("Othr"+"["+strconv.Itoa(i)+"]", v.Othr[i].Validate()))
+	}
+	if v.PrefrdMtd != nil {
+		errs = append(errs, common.PrefixError("PrefrdMtd", v.PrefrdMtd.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks CreditorReferenceInformation2 and its children against the schema.
+func (v CreditorReferenceInformation2) Validate() error {
+	var errs []error
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.Ref != nil {
+		errs = append(errs, common.PrefixError("Ref", v.Ref.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks CreditorReferenceType1Choice and its children against the schema.
+func (v CreditorReferenceType1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks CreditorReferenceType2 and its children against the schema.
+func (v CreditorReferenceType2) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("CdOrPrtry", v.CdOrPrtry.Validate()))
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks DateAndDateTime2Choice and its children against the schema.
+func (v DateAndDateTime2Choice) Validate() error {
+	var errs []error
+	if v.Dt != nil {
+		errs = append(errs, common.PrefixError("Dt", v.Dt.Validate()))
+	}
+	if v.DtTm != nil {
+		errs = append(errs, common.PrefixError("DtTm", v.DtTm.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Dt", "DtTm"}, []bool{v.Dt != nil, v.DtTm != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks DateAndPlaceOfBirth1 and its children against the schema.
+func (v DateAndPlaceOfBirth1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("BirthDt", v.BirthDt.Validate()))
+	if v.PrvcOfBirth != nil {
+		errs = append(errs, common.PrefixError("PrvcOfBirth", v.PrvcOfBirth.Validate()))
+	}
+	errs = append(errs, common.PrefixError("CityOfBirth", v.CityOfBirth.Validate()))
+	errs = append(errs, common.PrefixError("CtryOfBirth", v.CtryOfBirth.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks DatePeriod2 and its children against the schema.
+func (v DatePeriod2) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("FrDt", v.FrDt.Validate()))
+	errs = append(errs, common.PrefixError("ToDt", v.ToDt.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks DiscountAmountAndType1 and its children against the schema.
+func (v DiscountAmountAndType1) Validate() error {
+	var errs []error
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks DiscountAmountType1Choice and its children against the schema.
+func (v DiscountAmountType1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks DocumentAdjustment1 and its children against the schema.
+func (v DocumentAdjustment1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	if v.CdtDbtInd != nil {
+		errs = append(errs, common.PrefixError("CdtDbtInd", v.CdtDbtInd.Validate()))
+	}
+	if v.Rsn != nil {
+		errs = append(errs, common.PrefixError("Rsn", v.Rsn.Validate()))
+	}
+	if v.AddtlInf != nil {
+		errs = append(errs, common.PrefixError("AddtlInf", v.AddtlInf.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks DocumentLineIdentification1 and its children against the schema.
+func (v DocumentLineIdentification1) Validate() error {
+	var errs []error
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.Nb != nil {
+		errs = append(errs, common.PrefixError("Nb", v.Nb.Validate()))
+	}
+	if v.RltdDt != nil {
+		errs = append(errs, common.PrefixError("RltdDt", v.RltdDt.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks DocumentLineInformation1 and its children against the schema.
+func (v DocumentLineInformation1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", common.CheckOccurs(len(v.Id), 1, -1)))
+	for i := range v.Id {
+		errs = append(errs, common.PrefixError("Id"+"["+strconv.Itoa(i)+"]", v.Id[i].Validate()))
+	}
+	if v.Desc != nil {
+		errs = append(errs, common.PrefixError("Desc", v.Desc.Validate()))
+	}
+	if v.Amt != nil {
+		errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks DocumentLineType1 and its children against the schema.
+func (v DocumentLineType1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("CdOrPrtry", v.CdOrPrtry.Validate()))
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks DocumentLineType1Choice and its children against the schema.
+func (v DocumentLineType1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks EquivalentAmount2 and its children against the schema.
+func (v EquivalentAmount2) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	errs = append(errs, common.PrefixError("CcyOfTrf", v.CcyOfTrf.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks FinancialIdentificationSchemeName1Choice and its children against the schema.
+func (v FinancialIdentificationSchemeName1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks FinancialInstitutionIdentification18 and its children against the schema.
+func (v FinancialInstitutionIdentification18) Validate() error {
+	var errs []error
+	if v.BICFI != nil {
+		errs = append(errs, common.PrefixError("BICFI", v.BICFI.Validate()))
+	}
+	if v.ClrSysMmbId != nil {
+		errs = append(errs, common.PrefixError("ClrSysMmbId", v.ClrSysMmbId.Validate()))
+	}
+	if v.LEI != nil {
+		errs = append(errs, common.PrefixError("LEI", v.LEI.Validate()))
+	}
+	if v.Nm != nil {
+		errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	}
+	if v.PstlAdr != nil {
+		errs = append(errs, common.PrefixError("PstlAdr", v.PstlAdr.Validate()))
+	}
+	if v.Othr != nil {
+		errs = append(errs, common.PrefixError("Othr", v.Othr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks Frequency36Choice and its children against the schema.
+func (v Frequency36Choice) Validate() error {
+	var errs []error
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.Prd != nil {
+		errs = append(errs, common.PrefixError("Prd", v.Prd.Validate()))
+	}
+	if v.PtInTm != nil {
+		errs = append(errs, common.PrefixError("PtInTm", v.PtInTm.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Tp", "Prd", "PtInTm"}, []bool{v.Tp != nil, v.Prd != nil, v.PtInTm != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks FrequencyAndMoment1 and its children against the schema.
+func (v FrequencyAndMoment1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	errs = append(errs, common.PrefixError("PtInTm", v.PtInTm.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks FrequencyPeriod1 and its children against the schema.
+func (v FrequencyPeriod1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	errs = append(errs, common.PrefixError("CntPerPrd", v.CntPerPrd.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks Garnishment3 and its children against the schema.
+func (v Garnishment3) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	if v.Grnshee != nil {
+		errs = append(errs, common.PrefixError("Grnshee", v.Grnshee.Validate()))
+	}
+	if v.GrnshmtAdmstr != nil {
+		errs = append(errs, common.PrefixError("GrnshmtAdmstr", v.GrnshmtAdmstr.Validate()))
+	}
+	if v.RefNb != nil {
+		errs = append(errs, common.PrefixError("RefNb", v.RefNb.Validate()))
+	}
+	if v.Dt != nil {
+		errs = append(errs, common.PrefixError("Dt", v.Dt.Validate()))
+	}
+	if v.RmtdAmt != nil {
+		errs = append(errs, common.PrefixError("RmtdAmt", v.RmtdAmt.Validate()))
+	}
+	if v.FmlyMdclInsrncInd != nil {
+		errs = append(errs, common.PrefixError("FmlyMdclInsrncInd", v.FmlyMdclInsrncInd.Validate()))
+	}
+	if v.MplyeeTermntnInd != nil {
+		errs = append(errs, common.PrefixError("MplyeeTermntnInd", v.MplyeeTermntnInd.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GarnishmentType1 and its children against the schema.
+func (v GarnishmentType1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("CdOrPrtry", v.CdOrPrtry.Validate()))
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GarnishmentType1Choice and its children against the schema.
+func (v GarnishmentType1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks GenericAccountIdentification1 and its children against the schema.
+func (v GenericAccountIdentification1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.SchmeNm != nil {
+		errs = append(errs, common.PrefixError("SchmeNm", v.SchmeNm.Validate()))
+	}
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GenericFinancialIdentification1 and its children against the schema.
+func (v GenericFinancialIdentification1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.SchmeNm != nil {
+		errs = append(errs, common.PrefixError("SchmeNm", v.SchmeNm.Validate()))
+	}
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GenericIdentification30 and its children against the schema.
+func (v GenericIdentification30) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	if v.SchmeNm != nil {
+		errs = append(errs, common.PrefixError("SchmeNm", v.SchmeNm.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GenericOrganisationIdentification1 and its children against the schema.
+func (v GenericOrganisationIdentification1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.SchmeNm != nil {
+		errs = append(errs, common.PrefixError("SchmeNm", v.SchmeNm.Validate()))
+	}
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GenericPersonIdentification1 and its children against the schema.
+func (v GenericPersonIdentification1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.SchmeNm != nil {
+		errs = append(errs, common.PrefixError("SchmeNm", v.SchmeNm.Validate()))
+	}
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks LocalInstrument2Choice and its children against the schema.
+func (v LocalInstrument2Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks MandateRelatedInformation14 and its children against the schema.
+func (v MandateRelatedInformation14) Validate() error {
+	var errs []error
+	if v.MndtId != nil {
+		errs = append(errs, common.PrefixError("MndtId", v.MndtId.Validate()))
+	}
+	if v.DtOfSgntr != nil {
+		errs = append(errs, common.PrefixError("DtOfSgntr", v.DtOfSgntr.Validate()))
+	}
+	if v.AmdmntInd != nil {
+		errs = append(errs, common.PrefixError("AmdmntInd", v.AmdmntInd.Validate()))
+	}
+	if v.AmdmntInfDtls != nil {
+		errs = append(errs, common.PrefixError("AmdmntInfDtls", v.AmdmntInfDtls.Validate()))
+	}
+	if v.ElctrncSgntr != nil {
+		errs = append(errs, common.PrefixError("ElctrncSgntr", v.ElctrncSgntr.Validate()))
+	}
+	if v.FrstColltnDt != nil {
+		errs = append(errs, common.PrefixError("FrstColltnDt", v.FrstColltnDt.Validate()))
+	}
+	if v.FnlColltnDt != nil {
+		errs = append(errs, common.PrefixError("FnlColltnDt", v.FnlColltnDt.Validate()))
+	}
+	if v.Frqcy != nil {
+		errs = append(errs, common.PrefixError("Frqcy", v.Frqcy.Validate()))
+	}
+	if v.Rsn != nil {
+		errs = append(errs, common.PrefixError("Rsn", v.Rsn.Validate()))
+	}
+	if v.TrckgDays != nil {
+		errs = append(errs, common.PrefixError("TrckgDays", v.TrckgDays.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks MandateSetupReason1Choice and its children against the schema.
+func (v MandateSetupReason1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks MissingOrIncorrectInformation3 and its children against the schema.
+func (v MissingOrIncorrectInformation3) Validate() error {
+	var errs []error
+	if v.AMLReq != nil {
+		errs = append(errs, common.PrefixError("AMLReq", v.AMLReq.Validate()))
+	}
+	errs = append(errs, common.PrefixError("MssngInf", common.CheckOccurs(len(v.MssngInf), 0, 10)))
+	for i := range v.MssngInf {
+		errs = append(errs, common.PrefixError("MssngInf"+"["+strconv.Itoa(i)+"]", v.MssngInf[i].Validate()))
+	}
+	errs = append(errs, common.PrefixError("IncrrctInf", common.CheckOccurs(len(v.IncrrctInf), 0, 10)))
+	for i := range v.IncrrctInf {
+		errs = append(errs, common.PrefixError("IncrrctInf"+"["+strconv.Itoa(i)+"]", v.IncrrctInf[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks OrganisationIdentification29 and its children against the schema.
+func (v OrganisationIdentification29) Validate() error {
+	var errs []error
+	if v.AnyBIC != nil {
+		errs = append(errs, common.PrefixError("AnyBIC", v.AnyBIC.Validate()))
+	}
+	if v.LEI != nil {
+		errs = append(errs, common.PrefixError("LEI", v.LEI.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Othr", common.CheckOccurs(len(v.Othr), 0, -1)))
+	for i := range v.Othr {
+		errs = append(errs, common.PrefixError("Othr"+"["+strconv.Itoa(i)+"]", v.Othr[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks OrganisationIdentificationSchemeName1Choice and its children against the schema.
+func (v OrganisationIdentificationSchemeName1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks OriginalGroupInformation29 and its children against the schema.
+func (v OriginalGroupInformation29) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("OrgnlMsgId", v.OrgnlMsgId.Validate()))
+	errs = append(errs, common.PrefixError("OrgnlMsgNmId", v.OrgnlMsgNmId.Validate()))
+	if v.OrgnlCreDtTm != nil {
+		errs = append(errs, common.PrefixError("OrgnlCreDtTm", v.OrgnlCreDtTm.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks OriginalTransactionReference28 and its children against the schema.
+func (v OriginalTransactionReference28) Validate() error {
+	var errs []error
+	if v.IntrBkSttlmAmt != nil {
+		errs = append(errs, common.PrefixError("IntrBkSttlmAmt", v.IntrBkSttlmAmt.Validate()))
+	}
+	if v.Amt != nil {
+		errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	}
+	if v.IntrBkSttlmDt != nil {
+		errs = append(errs, common.PrefixError("IntrBkSttlmDt", v.IntrBkSttlmDt.Validate()))
+	}
+	if v.ReqdColltnDt != nil {
+		errs = append(errs, common.PrefixError("ReqdColltnDt", v.ReqdColltnDt.Validate()))
+	}
+	if v.ReqdExctnDt != nil {
+		errs = append(errs, common.PrefixError("ReqdExctnDt", v.ReqdExctnDt.Validate()))
+	}
+	if v.CdtrSchmeId != nil {
+		errs = append(errs, common.PrefixError("CdtrSchmeId", v.CdtrSchmeId.Validate()))
+	}
+	if v.SttlmInf != nil {
+		errs = append(errs, common.PrefixError("SttlmInf", v.SttlmInf.Validate()))
+	}
+	if v.PmtTpInf != nil {
+		errs = append(errs, common.PrefixError("PmtTpInf", v.PmtTpInf.Validate()))
+	}
+	if v.PmtMtd != nil {
+		errs = append(errs, common.PrefixError("PmtMtd", v.PmtMtd.Validate()))
+	}
+	if v.MndtRltdInf != nil {
+		errs = append(errs, common.PrefixError("MndtRltdInf", v.MndtRltdInf.Validate()))
+	}
+	if v.RmtInf != nil {
+		errs = append(errs, common.PrefixError("RmtInf", v.RmtInf.Validate()))
+	}
+	if v.UltmtDbtr != nil {
+		errs = append(errs, common.PrefixError("UltmtDbtr", v.UltmtDbtr.Validate()))
+	}
+	if v.Dbtr != nil {
+		errs = append(errs, common.PrefixError("Dbtr", v.Dbtr.Validate()))
+	}
+	if v.DbtrAcct != nil {
+		errs = append(errs, common.PrefixError("DbtrAcct", v.DbtrAcct.Validate()))
+	}
+	if v.DbtrAgt != nil {
+		errs = append(errs, common.PrefixError("DbtrAgt", v.DbtrAgt.Validate()))
+	}
+	if v.DbtrAgtAcct != nil {
+		errs = append(errs, common.PrefixError("DbtrAgtAcct", v.DbtrAgtAcct.Validate()))
+	}
+	if v.CdtrAgt != nil {
+		errs = append(errs, common.PrefixError("CdtrAgt", v.CdtrAgt.Validate()))
+	}
+	if v.CdtrAgtAcct != nil {
+		errs = append(errs, common.PrefixError("CdtrAgtAcct", v.CdtrAgtAcct.Validate()))
+	}
+	if v.Cdtr != nil {
+		errs = append(errs, common.PrefixError("Cdtr", v.Cdtr.Validate()))
+	}
+	if v.CdtrAcct != nil {
+		errs = append(errs, common.PrefixError("CdtrAcct", v.CdtrAcct.Validate()))
+	}
+	if v.UltmtCdtr != nil {
+		errs = append(errs, common.PrefixError("UltmtCdtr", v.UltmtCdtr.Validate()))
+	}
+	if v.Purp != nil {
+		errs = append(errs, common.PrefixError("Purp", v.Purp.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks OtherContact1 and its children against the schema.
+func (v OtherContact1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("ChanlTp", v.ChanlTp.Validate()))
+	if v.Id != nil {
+		errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks Party38Choice and its children against the schema.
+func (v Party38Choice) Validate() error {
+	var errs []error
+	if v.OrgId != nil {
+		errs = append(errs, common.PrefixError("OrgId", v.OrgId.Validate()))
+	}
+	if v.PrvtId != nil {
+		errs = append(errs, common.PrefixError("PrvtId", v.PrvtId.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"OrgId", "PrvtId"}, []bool{v.OrgId != nil, v.PrvtId != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks Party40Choice and its children against the schema.
+func (v Party40Choice) Validate() error {
+	var errs []error
+	if v.Pty != nil {
+		errs = append(errs, common.PrefixError("Pty", v.Pty.Validate()))
+	}
+	if v.Agt != nil {
+		errs = append(errs, common.PrefixError("Agt", v.Agt.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Pty", "Agt"}, []bool{v.Pty != nil, v.Agt != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks PartyIdentification135 and its children against the schema.
+func (v PartyIdentification135) Validate() error {
+	var errs []error
+	if v.Nm != nil {
+		errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	}
+	if v.PstlAdr != nil {
+		errs = append(errs, common.PrefixError("PstlAdr", v.PstlAdr.Validate()))
+	}
+	if v.Id != nil {
+		errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	}
+	if v.CtryOfRes != nil {
+		errs = append(errs, common.PrefixError("CtryOfRes", v.CtryOfRes.Validate()))
+	}
+	if v.CtctDtls != nil {
+		errs = append(errs, common.PrefixError("CtctDtls", v.CtctDtls.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks PaymentTypeInformation27 and its children against the schema.
+func (v PaymentTypeInformation27) Validate() error {
+	var errs []error
+	if v.InstrPrty != nil {
+		errs = append(errs, common.PrefixError("InstrPrty", v.InstrPrty.Validate()))
+	}
+	if v.ClrChanl != nil {
+		errs = append(errs, common.PrefixError("ClrChanl", v.ClrChanl.Validate()))
+	}
+	errs = append(errs, common.PrefixError("SvcLvl", common.CheckOccurs(len(v.SvcLvl), 0, -1)))
+	for i := range v.SvcLvl {
+		errs = append(errs, common.PrefixError("SvcLvl"+"["+strconv.Itoa(i)+"]", v.SvcLvl[i].Validate()))
+	}
+	if v.LclInstrm != nil {
+		errs = append(errs, common.PrefixError("LclInstrm", v.LclInstrm.Validate()))
+	}
+	if v.SeqTp != nil {
+		errs = append(errs, common.PrefixError("SeqTp", v.SeqTp.Validate()))
+	}
+	if v.CtgyPurp != nil {
+		errs = append(errs, common.PrefixError("CtgyPurp", v.CtgyPurp.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks PersonIdentification13 and its children against the schema.
+func (v PersonIdentification13) Validate() error {
+	var errs []error
+	if v.DtAndPlcOfBirth != nil {
+		errs = append(errs, common.PrefixError("DtAndPlcOfBirth", v.DtAndPlcOfBirth.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Othr", common.CheckOccurs(len(v.Othr), 0, -1)))
+	for i := range v.Othr {
+		errs = append(errs, common.PrefixError("Othr"+"["+strconv.Itoa(i)+"]", v.Othr[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks PersonIdentificationSchemeName1Choice and its children against the schema.
+func (v PersonIdentificationSchemeName1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks PostalAddress24 and its children against the schema.
+func (v PostalAddress24) Validate() error {
+	var errs []error
+	if v.AdrTp != nil {
+		errs = append(errs, common.PrefixError("AdrTp", v.AdrTp.Validate()))
+	}
+	if v.Dept != nil {
+		errs = append(errs, common.PrefixError("Dept", v.Dept.Validate()))
+	}
+	if v.SubDept != nil {
+		errs = append(errs, common.PrefixError("SubDept", v.SubDept.Validate()))
+	}
+	if v.StrtNm != nil {
+		errs = append(errs, common.PrefixError("StrtNm", v.StrtNm.Validate()))
+	}
+	if v.BldgNb != nil {
+		errs = append(errs, common.PrefixError("BldgNb", v.BldgNb.Validate()))
+	}
+	if v.BldgNm != nil {
+		errs = append(errs, common.PrefixError("BldgNm", v.BldgNm.Validate()))
+	}
+	if v.Flr != nil {
+		errs = append(errs, common.PrefixError("Flr", v.Flr.Validate()))
+	}
+	if v.PstBx != nil {
+		errs = append(errs, common.PrefixError("PstBx", v.PstBx.Validate()))
+	}
+	if v.Room != nil {
+		errs = append(errs, common.PrefixError("Room", v.Room.Validate()))
+	}
+	if v.PstCd != nil {
+		errs = append(errs, common.PrefixError("PstCd", v.PstCd.Validate()))
+	}
+	if v.TwnNm != nil {
+		errs = append(errs, common.PrefixError("TwnNm", v.TwnNm.Validate()))
+	}
+	if v.TwnLctnNm != nil {
+		errs = append(errs, common.PrefixError("TwnLctnNm", v.TwnLctnNm.Validate()))
+	}
+	if v.DstrctNm != nil {
+		errs = append(errs, common.PrefixError("DstrctNm", v.DstrctNm.Validate()))
+	}
+	if v.CtrySubDvsn != nil {
+		errs = append(errs, common.PrefixError("CtrySubDvsn", v.CtrySubDvsn.Validate()))
+	}
+	if v.Ctry != nil {
+		errs = append(errs, common.PrefixError("Ctry", v.Ctry.Validate()))
+	}
+	errs = append(errs, common.PrefixError("AdrLine", common.CheckOccurs(len(v.AdrLine), 0, 7)))
+	for i := range v.AdrLine {
+		errs = append(errs, common.PrefixError("AdrLine"+"["+strconv.Itoa(i)+"]", v.AdrLine[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks ProxyAccountIdentification1 and its children against the schema.
+func (v ProxyAccountIdentification1) Validate() error {
+	var errs []error
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks ProxyAccountType1Choice and its children against the schema.
+func (v ProxyAccountType1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks Purpose2Choice and its children against the schema.
+func (v Purpose2Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks ReferredDocumentInformation7 and its children against the schema.
+func (v ReferredDocumentInformation7) Validate() error {
+	var errs []error
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.Nb != nil {
+		errs = append(errs, common.PrefixError("Nb", v.Nb.Validate()))
+	}
+	if v.RltdDt != nil {
+		errs = append(errs, common.PrefixError("RltdDt", v.RltdDt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("LineDtls", common.CheckOccurs(len(v.LineDtls), 0, -1)))
+	for i := range v.LineDtls {
+		errs = append(errs, common.PrefixError("LineDtls"+"["+strconv.Itoa(i)+"]", v.LineDtls[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks ReferredDocumentType3Choice and its children against the schema.
+func (v ReferredDocumentType3Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks ReferredDocumentType4 and its children against the schema.
+func (v ReferredDocumentType4) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("CdOrPrtry", v.CdOrPrtry.Validate()))
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks RemittanceAmount2 and its children against the schema.
+func (v RemittanceAmount2) Validate() error {
+	var errs []error
+	if v.DuePyblAmt != nil {
+		errs = append(errs, common.PrefixError("DuePyblAmt", v.DuePyblAmt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("DscntApldAmt", common.CheckOccurs(len(v.DscntApldAmt), 0, -1)))
+	for i := range v.DscntApldAmt {
+		errs = append(errs, common.PrefixError("DscntApldAmt"+"["+strconv.Itoa(i)+"]", v.DscntApldAmt[i].Validate()))
+	}
+	if v.CdtNoteAmt != nil {
+		errs = append(errs, common.PrefixError("CdtNoteAmt", v.CdtNoteAmt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("TaxAmt", common.CheckOccurs(len(v.TaxAmt), 0, -1)))
+	for i := range v.TaxAmt {
+		errs = append(errs, common.PrefixError("TaxAmt"+"["+strconv.Itoa(i)+"]", v.TaxAmt[i].Validate()))
+	}
+	errs = append(errs, common.PrefixError("AdjstmntAmtAndRsn", common.CheckOccurs(len(v.AdjstmntAmtAndRsn), 0, -1)))
+	for i := range v.AdjstmntAmtAndRsn {
+		errs = append(errs, common.PrefixError("AdjstmntAmtAndRsn"+"["+strconv.Itoa(i)+"]", v.AdjstmntAmtAndRsn[i].Validate()))
+	}
+	if v.RmtdAmt != nil {
+		errs = append(errs, common.PrefixError("RmtdAmt", v.RmtdAmt.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks RemittanceAmount3 and its children against the schema.
+func (v RemittanceAmount3) Validate() error {
+	var errs []error
+	if v.DuePyblAmt != nil {
+		errs = append(errs, common.PrefixError("DuePyblAmt", v.DuePyblAmt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("DscntApldAmt", common.CheckOccurs(len(v.DscntApldAmt), 0, -1)))
+	for i := range v.DscntApldAmt {
+		errs = append(errs, common.PrefixError("DscntApldAmt"+"["+strconv.Itoa(i)+"]", v.DscntApldAmt[i].Validate()))
+	}
+	if v.CdtNoteAmt != nil {
+		errs = append(errs, common.PrefixError("CdtNoteAmt", v.CdtNoteAmt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("TaxAmt", common.CheckOccurs(len(v.TaxAmt), 0, -1)))
+	for i := range v.TaxAmt {
+		errs = append(errs, common.PrefixError("TaxAmt"+"["+strconv.Itoa(i)+"]", v.TaxAmt[i].Validate()))
+	}
+	errs = append(errs, common.PrefixError("AdjstmntAmtAndRsn", common.CheckOccurs(len(v.AdjstmntAmtAndRsn), 0, -1)))
+	for i := range v.AdjstmntAmtAndRsn {
+		errs = append(errs, common.PrefixError("AdjstmntAmtAndRsn"+"["+strconv.Itoa(i)+"]", v.AdjstmntAmtAndRsn[i].Validate()))
+	}
+	if v.RmtdAmt != nil {
+		errs = append(errs, common.PrefixError("RmtdAmt", v.RmtdAmt.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks RemittanceInformation16 and its children against the schema.
+func (v RemittanceInformation16) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Ustrd", common.CheckOccurs(len(v.Ustrd), 0, -1)))
+	for i := range v.Ustrd {
+		errs = append(errs, common.PrefixError("Ustrd"+"["+strconv.Itoa(i)+"]", v.Ustrd[i].Validate()))
+	}
+	errs = append(errs, common.PrefixError("Strd", common.CheckOccurs(len(v.Strd), 0, -1)))
+	for i := range v.Strd {
+		errs = append(errs, common.PrefixError("Strd"+"["+strconv.Itoa(i)+"]", v.Strd[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks ServiceLevel8Choice and its children against the schema.
+func (v ServiceLevel8Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks SettlementInstruction7 and its children against the schema.
+func (v SettlementInstruction7) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("SttlmMtd", v.SttlmMtd.Validate()))
+	if v.SttlmAcct != nil {
+		errs = append(errs, common.PrefixError("SttlmAcct", v.SttlmAcct.Validate()))
+	}
+	if v.ClrSys != nil {
+		errs = append(errs, common.PrefixError("ClrSys", v.ClrSys.Validate()))
+	}
+	if v.InstgRmbrsmntAgt != nil {
+		errs = append(errs, common.PrefixError("InstgRmbrsmntAgt", v.InstgRmbrsmntAgt.Validate()))
+	}
+	if v.InstgRmbrsmntAgtAcct != nil {
+		errs = append(errs, common.PrefixError("InstgRmbrsmntAgtAcct", v.InstgRmbrsmntAgtAcct.Validate()))
+	}
+	if v.InstdRmbrsmntAgt != nil {
+		errs = append(errs, common.PrefixError("InstdRmbrsmntAgt", v.InstdRmbrsmntAgt.Validate()))
+	}
+	if v.InstdRmbrsmntAgtAcct != nil {
+		errs = append(errs, common.PrefixError("InstdRmbrsmntAgtAcct", v.InstdRmbrsmntAgtAcct.Validate()))
+	}
+	if v.ThrdRmbrsmntAgt != nil {
+		errs = append(errs, common.PrefixError("ThrdRmbrsmntAgt", v.ThrdRmbrsmntAgt.Validate()))
+	}
+	if v.ThrdRmbrsmntAgtAcct != nil {
+		errs = append(errs, common.PrefixError("ThrdRmbrsmntAgtAcct", v.ThrdRmbrsmntAgtAcct.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks StructuredRemittanceInformation16 and its children against the schema.
+func (v StructuredRemittanceInformation16) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("RfrdDocInf", common.CheckOccurs(len(v.RfrdDocInf), 0, -1)))
+	for i := range v.RfrdDocInf {
+		errs = append(errs, common.PrefixError("RfrdDocInf"+"["+strconv.Itoa(i)+"]", v.RfrdDocInf[i].Validate()))
+	}
+	if v.RfrdDocAmt != nil {
+		errs = append(errs, common.PrefixError("RfrdDocAmt", v.RfrdDocAmt.Validate()))
+	}
+	if v.CdtrRefInf != nil {
+		errs = append(errs, common.PrefixError("CdtrRefInf", v.CdtrRefInf.Validate()))
+	}
+	if v.Invcr != nil {
+		errs = append(errs, common.PrefixError("Invcr", v.Invcr.Validate()))
+	}
+	if v.Invcee != nil {
+		errs = append(errs, common.PrefixError("Invcee", v.Invcee.Validate()))
+	}
+	if v.TaxRmt != nil {
+		errs = append(errs, common.PrefixError("TaxRmt", v.TaxRmt.Validate()))
+	}
+	if v.GrnshmtRmt != nil {
+		errs = append(errs, common.PrefixError("GrnshmtRmt", v.GrnshmtRmt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("AddtlRmtInf", common.CheckOccurs(len(v.AddtlRmtInf), 0, 3)))
+	for i := range v.AddtlRmtInf {
+		errs = append(errs, common.PrefixError("AddtlRmtInf"+"["+strconv.Itoa(i)+"]", v.AddtlRmtInf[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks SupplementaryData1 and its children against the schema.
+func (v SupplementaryData1) Validate() error {
+	var errs []error
+	if v.PlcAndNm != nil {
+		errs = append(errs, common.PrefixError("PlcAndNm", v.PlcAndNm.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Envlp", v.Envlp.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks SupplementaryDataEnvelope1 and its children against the schema.
+func (v SupplementaryDataEnvelope1) Validate() error {
+	return nil
+}
+
+// Validate checks TaxAmount2 and its children against the schema.
+func (v TaxAmount2) Validate() error {
+	var errs []error
+	if v.Rate != nil {
+		errs = append(errs, common.PrefixError("Rate", v.Rate.Validate()))
+	}
+	if v.TaxblBaseAmt != nil {
+		errs = append(errs, common.PrefixError("TaxblBaseAmt", v.TaxblBaseAmt.Validate()))
+	}
+	if v.TtlAmt != nil {
+		errs = append(errs, common.PrefixError("TtlAmt", v.TtlAmt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Dtls", common.CheckOccurs(len(v.Dtls), 0, -1)))
+	for i := range v.Dtls {
+		errs = append(errs, common.PrefixError("Dtls"+"["+strconv.Itoa(i)+"]", v.Dtls[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxAmountAndType1 and its children against the schema.
+func (v TaxAmountAndType1) Validate() error {
+	var errs []error
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxAmountType1Choice and its children against the schema.
+func (v TaxAmountType1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxAuthorisation1 and its children against the schema.
+func (v TaxAuthorisation1) Validate() error {
+	var errs []error
+	if v.Titl != nil {
+		errs = append(errs, common.PrefixError("Titl", v.Titl.Validate()))
+	}
+	if v.Nm != nil {
+		errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxInformation7 and its children against the schema.
+func (v TaxInformation7) Validate() error {
+	var errs []error
+	if v.Cdtr != nil {
+		errs = append(errs, common.PrefixError("Cdtr", v.Cdtr.Validate()))
+	}
+	if v.Dbtr != nil {
+		errs = append(errs, common.PrefixError("Dbtr", v.Dbtr.Validate()))
+	}
+	if v.UltmtDbtr != nil {
+		errs = append(errs, common.PrefixError("UltmtDbtr", v.UltmtDbtr.Validate()))
+	}
+	if v.AdmstnZone != nil {
+		errs = append(errs, common.PrefixError("AdmstnZone", v.AdmstnZone.Validate()))
+	}
+	if v.RefNb != nil {
+		errs = append(errs, common.PrefixError("RefNb", v.RefNb.Validate()))
+	}
+	if v.Mtd != nil {
+		errs = append(errs, common.PrefixError("Mtd", v.Mtd.Validate()))
+	}
+	if v.TtlTaxblBaseAmt != nil {
+		errs = append(errs, common.PrefixError("TtlTaxblBaseAmt", v.TtlTaxblBaseAmt.Validate()))
+	}
+	if v.TtlTaxAmt != nil {
+		errs = append(errs, common.PrefixError("TtlTaxAmt", v.TtlTaxAmt.Validate()))
+	}
+	if v.Dt != nil {
+		errs = append(errs, common.PrefixError("Dt", v.Dt.Validate()))
+	}
+	if v.SeqNb != nil {
+		errs = append(errs, common.PrefixError("SeqNb", v.SeqNb.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Rcrd", common.CheckOccurs(len(v.Rcrd), 0, -1)))
+	for i := range v.Rcrd {
+		errs = append(errs, common.PrefixError("Rcrd"+"["+strconv.Itoa(i)+"]", v.Rcrd[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxParty1 and its children against the schema.
+func (v TaxParty1) Validate() error {
+	var errs []error
+	if v.TaxId != nil {
+		errs = append(errs, common.PrefixError("TaxId", v.TaxId.Validate()))
+	}
+	if v.RegnId != nil {
+		errs = append(errs, common.PrefixError("RegnId", v.RegnId.Validate()))
+	}
+	if v.TaxTp != nil {
+		errs = append(errs, common.PrefixError("TaxTp", v.TaxTp.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxParty2 and its children against the schema.
+func (v TaxParty2) Validate() error {
+	var errs []error
+	if v.TaxId != nil {
+		errs = append(errs, common.PrefixError("TaxId", v.TaxId.Validate()))
+	}
+	if v.RegnId != nil {
+		errs = append(errs, common.PrefixError("RegnId", v.RegnId.Validate()))
+	}
+	if v.TaxTp != nil {
+		errs = append(errs, common.PrefixError("TaxTp", v.TaxTp.Validate()))
+	}
+	if v.Authstn != nil {
+		errs = append(errs, common.PrefixError("Authstn", v.Authstn.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxPeriod2 and its children against the schema.
+func (v TaxPeriod2) Validate() error {
+	var errs []error
+	if v.Yr != nil {
+		errs = append(errs, common.PrefixError("Yr", v.Yr.Validate()))
+	}
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.FrToDt != nil {
+		errs = append(errs, common.PrefixError("FrToDt", v.FrToDt.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxRecord2 and its children against the schema.
+func (v TaxRecord2) Validate() error {
+	var errs []error
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.Ctgy != nil {
+		errs = append(errs, common.PrefixError("Ctgy", v.Ctgy.Validate()))
+	}
+	if v.CtgyDtls != nil {
+		errs = append(errs, common.PrefixError("CtgyDtls", v.CtgyDtls.Validate()))
+	}
+	if v.DbtrSts != nil {
+		errs = append(errs, common.PrefixError("DbtrSts", v.DbtrSts.Validate()))
+	}
+	if v.CertId != nil {
+		errs = append(errs, common.PrefixError("CertId", v.CertId.Validate()))
+	}
+	if v.FrmsCd != nil {
+		errs = append(errs, common.PrefixError("FrmsCd", v.FrmsCd.Validate()))
+	}
+	if v.Prd != nil {
+		errs = append(errs, common.PrefixError("Prd", v.Prd.Validate()))
+	}
+	if v.TaxAmt != nil {
+		errs = append(errs, common.PrefixError("TaxAmt", v.TaxAmt.Validate()))
+	}
+	if v.AddtlInf != nil {
+		errs = append(errs, common.PrefixError("AddtlInf", v.AddtlInf.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxRecordDetails2 and its children against the schema.
+func (v TaxRecordDetails2) Validate() error {
+	var errs []error
+	if v.Prd != nil {
+		errs = append(errs, common.PrefixError("Prd", v.Prd.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks UnableToApplyIncorrect1 and its children against the schema.
+func (v UnableToApplyIncorrect1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	if v.AddtlIncrrctInf != nil {
+		errs = append(errs, common.PrefixError("AddtlIncrrctInf", v.AddtlIncrrctInf.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks UnableToApplyJustification3Choice and its children against the schema.
+func (v UnableToApplyJustification3Choice) Validate() error {
+	var errs []error
+	if v.AnyInf != nil {
+		errs = append(errs, common.PrefixError("AnyInf", v.AnyInf.Validate()))
+	}
+	if v.MssngOrIncrrctInf != nil {
+		errs = append(errs, common.PrefixError("MssngOrIncrrctInf", v.MssngOrIncrrctInf.Validate()))
+	}
+	if v.PssblDplctInstr != nil {
+		errs = append(errs, common.PrefixError("PssblDplctInstr", v.PssblDplctInstr.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"AnyInf", "MssngOrIncrrctInf", "PssblDplctInstr"}, []bool{v.AnyInf != nil, v.MssngOrIncrrctInf != nil, v.PssblDplctInstr != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks UnableToApplyMissing1 and its children against the schema.
+func (v UnableToApplyMissing1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	if v.AddtlMssngInf != nil {
+		errs = append(errs, common.PrefixError("AddtlMssngInf", v.AddtlMssngInf.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks UnableToApplyV07 and its children against the schema.
+func (v UnableToApplyV07) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Assgnmt", v.Assgnmt.Validate()))
+	if v.Case != nil {
+		errs = append(errs, common.PrefixError("Case", v.Case.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Undrlyg", v.Undrlyg.Validate()))
+	errs = append(errs, common.PrefixError("Justfn", v.Justfn.Validate()))
+	errs = append(errs, common.PrefixError("SplmtryData", common.CheckOccurs(len(v.SplmtryData), 0, -1)))
+	for i := range v.SplmtryData {
+		errs = append(errs, common.PrefixError("SplmtryData"+"["+strconv.Itoa(i)+"]", v.SplmtryData[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks UnderlyingGroupInformation1 and its children against the schema.
+func (v UnderlyingGroupInformation1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("OrgnlMsgId", v.OrgnlMsgId.Validate()))
+	errs = append(errs, common.PrefixError("OrgnlMsgNmId", v.OrgnlMsgNmId.Validate()))
+	if v.OrgnlCreDtTm != nil {
+		errs = append(errs, common.PrefixError("OrgnlCreDtTm", v.OrgnlCreDtTm.Validate()))
+	}
+	if v.OrgnlMsgDlvryChanl != nil {
+		errs = append(errs, common.PrefixError("OrgnlMsgDlvryChanl", v.OrgnlMsgDlvryChanl.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks UnderlyingPaymentInstruction5 and its children against the schema.
+func (v UnderlyingPaymentInstruction5) Validate() error {
+	var errs []error
+	if v.OrgnlGrpInf != nil {
+		errs = append(errs, common.PrefixError("OrgnlGrpInf", v.OrgnlGrpInf.Validate()))
+	}
+	if v.OrgnlPmtInfId != nil {
+		errs = append(errs, common.PrefixError("OrgnlPmtInfId", v.OrgnlPmtInfId.Validate()))
+	}
+	if v.OrgnlInstrId != nil {
+		errs = append(errs, common.PrefixError("OrgnlInstrId", v.OrgnlInstrId.Validate()))
+	}
+	if v.OrgnlEndToEndId != nil {
+		errs = append(errs, common.PrefixError("OrgnlEndToEndId", v.OrgnlEndToEndId.Validate()))
+	}
+	if v.OrgnlUETR != nil {
+		errs = append(errs, common.PrefixError("OrgnlUETR", v.OrgnlUETR.Validate()))
+	}
+	errs = append(errs, common.PrefixError("OrgnlInstdAmt", v.OrgnlInstdAmt.Validate()))
+	if v.ReqdExctnDt != nil {
+		errs = append(errs, common.PrefixError("ReqdExctnDt", v.ReqdExctnDt.Validate()))
+	}
+	if v.ReqdColltnDt != nil {
+		errs = append(errs, common.PrefixError("ReqdColltnDt", v.ReqdColltnDt.Validate()))
+	}
+	if v.OrgnlTxRef != nil {
+		errs = append(errs, common.PrefixError("OrgnlTxRef", v.OrgnlTxRef.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks UnderlyingPaymentTransaction4 and its children against the schema.
+func (v UnderlyingPaymentTransaction4) Validate() error {
+	var errs []error
+	if v.OrgnlGrpInf != nil {
+		errs = append(errs, common.PrefixError("OrgnlGrpInf", v.OrgnlGrpInf.Validate()))
+	}
+	if v.OrgnlInstrId != nil {
+		errs = append(errs, common.PrefixError("OrgnlInstrId", v.OrgnlInstrId.Validate()))
+	}
+	if v.OrgnlEndToEndId != nil {
+		errs = append(errs, common.PrefixError("OrgnlEndToEndId", v.OrgnlEndToEndId.Validate()))
+	}
+	if v.OrgnlTxId != nil {
+		errs = append(errs, common.PrefixError("OrgnlTxId", v.OrgnlTxId.Validate()))
+	}
+	if v.OrgnlUETR != nil {
+		errs = append(errs, common.PrefixError("OrgnlUETR", v.OrgnlUETR.Validate()))
+	}
+	errs = append(errs, common.PrefixError("OrgnlIntrBkSttlmAmt", v.OrgnlIntrBkSttlmAmt.Validate()))
+	errs = append(errs, common.PrefixError("OrgnlIntrBkSttlmDt", v.OrgnlIntrBkSttlmDt.Validate()))
+	if v.OrgnlTxRef != nil {
+		errs = append(errs, common.PrefixError("OrgnlTxRef", v.OrgnlTxRef.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks UnderlyingStatementEntry3 and its children against the schema.
+func (v UnderlyingStatementEntry3) Validate() error {
+	var errs []error
+	if v.OrgnlGrpInf != nil {
+		errs = append(errs, common.PrefixError("OrgnlGrpInf", v.OrgnlGrpInf.Validate()))
+	}
+	if v.OrgnlStmtId != nil {
+		errs = append(errs, common.PrefixError("OrgnlStmtId", v.OrgnlStmtId.Validate()))
+	}
+	if v.OrgnlNtryId != nil {
+		errs = append(errs, common.PrefixError("OrgnlNtryId", v.OrgnlNtryId.Validate()))
+	}
+	if v.OrgnlUETR != nil {
+		errs = append(errs, common.PrefixError("OrgnlUETR", v.OrgnlUETR.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks UnderlyingTransaction5Choice and its children against the schema.
+func (v UnderlyingTransaction5Choice) Validate() error {
+	var errs []error
+	if v.Initn != nil {
+		errs = append(errs, common.PrefixError("Initn", v.Initn.Validate()))
+	}
+	if v.IntrBk != nil {
+		errs = append(errs, common.PrefixError("IntrBk", v.IntrBk.Validate()))
+	}
+	if v.StmtNtry != nil {
+		errs = append(errs, common.PrefixError("StmtNtry", v.StmtNtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Initn", "IntrBk", "StmtNtry"}, []bool{v.Initn != nil, v.IntrBk != nil, v.StmtNtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks the facets of AMLIndicator.
+func (v AMLIndicator) Validate() error {
+	return nil
+}
+
+// Validate checks the facets of ActiveOrHistoricCurrencyAndAmountSimpleType.
+func (v ActiveOrHistoricCurrencyAndAmountSimpleType) Validate() error {
+	if err := common.CheckDecimal(strconv.FormatFloat(float64(v), 'f', -1, 64), 18, 5, "0"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ActiveOrHistoricCurrencyCode.
+func (v ActiveOrHistoricCurrencyCode) Validate() error {
+	if err := common.CheckPattern(string(v), patternActiveOrHistoricCurrencyCode); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of AddressType2Code.
+func (v AddressType2Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "ADDR", "PBOX", "HOME", "BIZZ", "MLTO", "DLVY"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of AnyBICDec2014Identifier.
+func (v AnyBICDec2014Identifier) Validate() error {
+	if err := common.CheckPattern(string(v), patternAnyBICDec2014Identifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of BICFIDec2014Identifier.
+func (v BICFIDec2014Identifier) Validate() error {
+	if err := common.CheckPattern(string(v), patternBICFIDec2014Identifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ClearingChannel2Code.
+func (v ClearingChannel2Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "RTGS", "RTNS", "MPNS", "BOOK"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of CountryCode.
+func (v CountryCode) Validate() error {
+	if err := common.CheckPattern(string(v), patternCountryCode); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of CreditDebitCode.
+func (v CreditDebitCode) Validate() error {
+	if err := common.CheckEnumeration(string(v), "CRDT", "DBIT"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of DecimalNumber.
+func (v DecimalNumber) Validate() error {
+	if err := common.CheckDecimal(strconv.FormatFloat(float64(v), 'f', -1, 64), 18, 17, ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of DocumentType3Code.
+func (v DocumentType3Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "RADM", "RPIN", "FXDR", "DISP", "PUOR", "SCOR"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of DocumentType6Code.
+func (v DocumentType6Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "MSIN", "CNFA", "DNFA", "CINV", "CREN", "DEBN", "HIRI", "SBIN", "CMCN", "SOAC", "DISP", "BOLD", "VCHR", "AROI", "TSUT", "PUOR"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Exact2NumericText.
+func (v Exact2NumericText) Validate() error {
+	if err := common.CheckPattern(string(v), patternExact2NumericText); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Exact4AlphaNumericText.
+func (v Exact4AlphaNumericText) Validate() error {
+	if err := common.CheckPattern(string(v), patternExact4AlphaNumericText); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalAccountIdentification1Code.
+func (v ExternalAccountIdentification1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalCashAccountType1Code.
+func (v ExternalCashAccountType1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalCashClearingSystem1Code.
+func (v ExternalCashClearingSystem1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 3); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalCategoryPurpose1Code.
+func (v ExternalCategoryPurpose1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalClearingSystemIdentification1Code.
+func (v ExternalClearingSystemIdentification1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 5); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalDiscountAmountType1Code.
+func (v ExternalDiscountAmountType1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalDocumentLineType1Code.
+func (v ExternalDocumentLineType1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalFinancialInstitutionIdentification1Code.
+func (v ExternalFinancialInstitutionIdentification1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalGarnishmentType1Code.
+func (v ExternalGarnishmentType1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalLocalInstrument1Code.
+func (v ExternalLocalInstrument1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 35); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalMandateSetupReason1Code.
+func (v ExternalMandateSetupReason1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalOrganisationIdentification1Code.
+func (v ExternalOrganisationIdentification1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalPersonIdentification1Code.
+func (v ExternalPersonIdentification1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalProxyAccountType1Code.
+func (v ExternalProxyAccountType1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalPurpose1Code.
+func (v ExternalPurpose1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalServiceLevel1Code.
+func (v ExternalServiceLevel1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalTaxAmountType1Code.
+func (v ExternalTaxAmountType1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Frequency6Code.
+func (v Frequency6Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "YEAR", "MNTH", "QURT", "MIAN", "WEEK", "DAIL", "ADHO", "INDA", "FRTN"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of IBAN2007Identifier.
+func (v IBAN2007Identifier) Validate() error {
+	if err := common.CheckPattern(string(v), patternIBAN2007Identifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of LEIIdentifier.
+func (v LEIIdentifier) Validate() error {
+	if err := common.CheckPattern(string(v), patternLEIIdentifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max1025Text.
+func (v Max1025Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 1025); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max128Text.
+func (v Max128Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 128); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max140Text.
+func (v Max140Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 140); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max16Text.
+func (v Max16Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 16); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max2048Text.
+func (v Max2048Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 2048); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max34Text.
+func (v Max34Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 34); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max350Text.
+func (v Max350Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 350); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max35Text.
+func (v Max35Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 35); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max4Text.
+func (v Max4Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max70Text.
+func (v Max70Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 70); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of NamePrefix2Code.
+func (v NamePrefix2Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "DOCT", "MADM", "MISS", "MIST", "MIKS"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Number.
+func (v Number) Validate() error {
+	if err := common.CheckDecimal(strconv.FormatFloat(float64(v), 'f', -1, 64), 18, 0, ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of PaymentMethod4Code.
+func (v PaymentMethod4Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "CHK", "TRF", "DD", "TRA"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of PercentageRate.
+func (v PercentageRate) Validate() error {
+	if err := common.CheckDecimal(strconv.FormatFloat(float64(v), 'f', -1, 64), 11, 10, ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of PhoneNumber.
+func (v PhoneNumber) Validate() error {
+	if err := common.CheckPattern(string(v), patternPhoneNumber); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of PreferredContactMethod1Code.
+func (v PreferredContactMethod1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "LETT", "MAIL", "PHON", "FAXX", "CELL"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Priority2Code.
+func (v Priority2Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "HIGH", "NORM"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of SequenceType3Code.
+func (v SequenceType3Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "FRST", "RCUR", "FNAL", "OOFF", "RPRE"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of SettlementMethod1Code.
+func (v SettlementMethod1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "INDA", "INGA", "COVE", "CLRG"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of TaxRecordPeriod1Code.
+func (v TaxRecordPeriod1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "MM01", "MM02", "MM03", "MM04", "MM05", "MM06", "MM07", "MM08", "MM09", "MM10", "MM11", "MM12", "QTR1", "QTR2", "QTR3", "QTR4", "HLF1", "HLF2"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of TrueFalseIndicator.
+func (v TrueFalseIndicator) Validate() error {
+	return nil
+}
+
+// Validate checks the facets of UUIDv4Identifier.
+func (v UUIDv4Identifier) Validate() error {
+	if err := common.CheckPattern(string(v), patternUUIDv4Identifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of UnableToApplyIncorrectInformation4Code.
+func (v UnableToApplyIncorrectInformation4Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "IN01", "IN02", "IN03", "IN04", "IN05", "IN06", "IN07", "IN08", "IN09", "IN10", "IN11", "IN12", "IN13", "IN14", "IN15", "IN16", "IN17", "IN18", "IN19", "MM20", "MM21", "MM22", "MM25", "MM26", "MM27", "MM28", "MM29", "MM30", "MM31", "MM32", "IN33", "MM34", "MM35", "IN36", "IN37", "IN38", "IN39", "NARR"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of UnableToApplyMissingInformation3Code.
+func (v UnableToApplyMissingInformation3Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "MS01", "MS02", "MS03", "MS04", "MS05", "MS06", "MS07", "MS08", "MS09", "MS10", "MS11", "MS12", "MS13", "MS14", "MS15", "MS16", "MS17", "NARR"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of YesNoIndicator.
+func (v YesNoIndicator) Validate() error {
+	return nil
+}
@@ -23,8 +23,6 @@ type AccountIdentification4Choice struct {
 	IBAN *IBAN2007Identifier `xml:"IBAN"`
 
 	Othr *GenericAccountIdentification1 `xml:"Othr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type AccountSchemeName1Choice struct {
@@ -33,8 +31,6 @@ type AccountSchemeName1Choice struct {
 	Cd *ExternalAccountIdentification1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ActiveCurrencyAndAmount struct {
@@ -42,8 +38,7 @@ type ActiveCurrencyAndAmount struct {
 
 	Ccy ActiveCurrencyCode `xml:"Ccy,attr"`
 
-	Text     string `xml:",chardata"`
-	InnerXml string `xml:",innerxml"`
+	Text string `xml:",chardata"`
 }
 
 type ActiveOrHistoricCurrencyAndAmount struct {
@@ -51,8 +46,7 @@ type ActiveOrHistoricCurrencyAndAmount struct {
 
 	Ccy ActiveOrHistoricCurrencyCode `xml:"Ccy,attr"`
 
-	Text     string `xml:",chardata"`
-	InnerXml string `xml:",innerxml"`
+	Text string `xml:",chardata"`
 }
 
 type AddressType3Choice struct {
@@ -61,8 +55,6 @@ type AddressType3Choice struct {
 	Cd *AddressType2Code `xml:"Cd"`
 
 	Prtry *GenericIdentification30 `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type AmendmentInformationDetails13 struct {
@@ -91,8 +83,6 @@ type AmendmentInformationDetails13 struct {
 	OrgnlRsn *MandateSetupReason1Choice `xml:"OrgnlRsn"`
 
 	OrgnlTrckgDays *Exact2NumericText `xml:"OrgnlTrckgDays"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type AmountType4Choice struct {
@@ -101,8 +91,6 @@ type AmountType4Choice struct {
 	InstdAmt *ActiveOrHistoricCurrencyAndAmount `xml:"InstdAmt"`
 
 	EqvtAmt *EquivalentAmount2 `xml:"EqvtAmt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type BranchAndFinancialInstitutionIdentification6 struct {
@@ -111,8 +99,6 @@ type BranchAndFinancialInstitutionIdentification6 struct {
 	FinInstnId FinancialInstitutionIdentification18 `xml:"FinInstnId"`
 
 	BrnchId *BranchData3 `xml:"BrnchId"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type BranchData3 struct {
@@ -125,8 +111,6 @@ type BranchData3 struct {
 	Nm *Max140Text `xml:"Nm"`
 
 	PstlAdr *PostalAddress24 `xml:"PstlAdr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CancellationStatusReason3Choice struct {
@@ -135,8 +119,6 @@ type CancellationStatusReason3Choice struct {
 	Cd *ExternalPaymentCancellationRejection1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CancellationStatusReason4 struct {
@@ -147,8 +129,6 @@ type CancellationStatusReason4 struct {
 	Rsn *CancellationStatusReason3Choice `xml:"Rsn"`
 
 	AddtlInf []Max105Text `xml:"AddtlInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Case5 struct {
@@ -159,8 +139,6 @@ type Case5 struct {
 	Cretr Party40Choice `xml:"Cretr"`
 
 	ReopCaseIndctn *YesNoIndicator `xml:"ReopCaseIndctn"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CaseAssignment5 struct {
@@ -173,8 +151,6 @@ type CaseAssignment5 struct {
 	Assgne Party40Choice `xml:"Assgne"`
 
 	CreDtTm common.ISODateTime `xml:"CreDtTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CashAccount38 struct {
@@ -189,8 +165,6 @@ type CashAccount38 struct {
 	Nm *Max70Text `xml:"Nm"`
 
 	Prxy *ProxyAccountIdentification1 `xml:"Prxy"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CashAccountType2Choice struct {
@@ -199,8 +173,6 @@ type CashAccountType2Choice struct {
 	Cd *ExternalCashAccountType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CategoryPurpose1Choice struct {
@@ -209,8 +181,6 @@ type CategoryPurpose1Choice struct {
 	Cd *ExternalCategoryPurpose1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ChargeType3Choice struct {
@@ -219,8 +189,6 @@ type ChargeType3Choice struct {
 	Cd *ExternalChargeType1Code `xml:"Cd"`
 
 	Prtry *GenericIdentification3 `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Charges6 struct {
@@ -229,8 +197,6 @@ type Charges6 struct {
 	TtlChrgsAndTaxAmt *ActiveOrHistoricCurrencyAndAmount `xml:"TtlChrgsAndTaxAmt"`
 
 	Rcrd []ChargesRecord3 `xml:"Rcrd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Charges7 struct {
@@ -239,8 +205,6 @@ type Charges7 struct {
 	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
 
 	Agt BranchAndFinancialInstitutionIdentification6 `xml:"Agt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ChargesRecord3 struct {
@@ -261,8 +225,6 @@ type ChargesRecord3 struct {
 	Agt *BranchAndFinancialInstitutionIdentification6 `xml:"Agt"`
 
 	Tax *TaxCharges2 `xml:"Tax"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ClaimNonReceipt2 struct {
@@ -271,8 +233,6 @@ type ClaimNonReceipt2 struct {
 	DtPrcd common.ISODate `xml:"DtPrcd"`
 
 	OrgnlNxtAgt *BranchAndFinancialInstitutionIdentification6 `xml:"OrgnlNxtAgt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ClaimNonReceipt2Choice struct {
@@ -281,8 +241,6 @@ type ClaimNonReceipt2Choice struct {
 	Accptd *ClaimNonReceipt2 `xml:"Accptd"`
 
 	Rjctd *ClaimNonReceiptRejectReason1Choice `xml:"Rjctd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ClaimNonReceiptRejectReason1Choice struct {
@@ -291,8 +249,6 @@ type ClaimNonReceiptRejectReason1Choice struct {
 	Cd *ExternalClaimNonReceiptRejection1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ClearingSystemIdentification2Choice struct {
@@ -301,8 +257,6 @@ type ClearingSystemIdentification2Choice struct {
 	Cd *ExternalClearingSystemIdentification1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ClearingSystemIdentification3Choice struct {
@@ -311,8 +265,6 @@ type ClearingSystemIdentification3Choice struct {
 	Cd *ExternalCashClearingSystem1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -321,8 +273,6 @@ type ClearingSystemMemberIdentification2 struct {
 	ClrSysId *ClearingSystemIdentification2Choice `xml:"ClrSysId"`
 
 	MmbId Max35Text `xml:"MmbId"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Compensation2 struct {
@@ -335,8 +285,6 @@ type Compensation2 struct {
 	CdtrAgt BranchAndFinancialInstitutionIdentification6 `xml:"CdtrAgt"`
 
 	Rsn CompensationReason1Choice `xml:"Rsn"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CompensationReason1Choice struct {
@@ -345,8 +293,6 @@ type CompensationReason1Choice struct {
 	Cd *ExternalPaymentCompensationReason1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Contact4 struct {
@@ -375,8 +321,6 @@ type Contact4 struct {
 	Othr []OtherContact1 `xml:"Othr"`
 
 	PrefrdMtd *PreferredContactMethod1Code `xml:"PrefrdMtd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CorrectiveGroupInformation1 struct {
@@ -387,8 +331,6 @@ type CorrectiveGroupInformation1 struct {
 	MsgNmId Max35Text `xml:"MsgNmId"`
 
 	CreDtTm *common.ISODateTime `xml:"CreDtTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CorrectiveInterbankTransaction2 struct {
@@ -407,8 +349,6 @@ type CorrectiveInterbankTransaction2 struct {
 	IntrBkSttlmAmt ActiveOrHistoricCurrencyAndAmount `xml:"IntrBkSttlmAmt"`
 
 	IntrBkSttlmDt common.ISODate `xml:"IntrBkSttlmDt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CorrectivePaymentInitiation4 struct {
@@ -429,8 +369,6 @@ type CorrectivePaymentInitiation4 struct {
 	ReqdExctnDt *DateAndDateTime2Choice `xml:"ReqdExctnDt"`
 
 	ReqdColltnDt *common.ISODate `xml:"ReqdColltnDt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CorrectiveTransaction4Choice struct {
@@ -439,8 +377,6 @@ type CorrectiveTransaction4Choice struct {
 	Initn *CorrectivePaymentInitiation4 `xml:"Initn"`
 
 	IntrBk *CorrectiveInterbankTransaction2 `xml:"IntrBk"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CreditorReferenceInformation2 struct {
@@ -449,8 +385,6 @@ type CreditorReferenceInformation2 struct {
 	Tp *CreditorReferenceType2 `xml:"Tp"`
 
 	Ref *Max35Text `xml:"Ref"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CreditorReferenceType1Choice struct {
@@ -459,8 +393,6 @@ type CreditorReferenceType1Choice struct {
 	Cd *DocumentType3Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CreditorReferenceType2 struct {
@@ -469,8 +401,6 @@ type CreditorReferenceType2 struct {
 	CdOrPrtry CreditorReferenceType1Choice `xml:"CdOrPrtry"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DateAndDateTime2Choice struct {
@@ -479,8 +409,6 @@ type DateAndDateTime2Choice struct {
 	Dt *common.ISODate `xml:"Dt"`
 
 	DtTm *common.ISODateTime `xml:"DtTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DateAndPlaceOfBirth1 struct {
@@ -493,8 +421,6 @@ type DateAndPlaceOfBirth1 struct {
 	CityOfBirth Max35Text `xml:"CityOfBirth"`
 
 	CtryOfBirth CountryCode `xml:"CtryOfBirth"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DatePeriod2 struct {
@@ -503,8 +429,6 @@ type DatePeriod2 struct {
 	FrDt common.ISODate `xml:"FrDt"`
 
 	ToDt common.ISODate `xml:"ToDt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DiscountAmountAndType1 struct {
@@ -513,8 +437,6 @@ type DiscountAmountAndType1 struct {
 	Tp *DiscountAmountType1Choice `xml:"Tp"`
 
 	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DiscountAmountType1Choice struct {
@@ -523,8 +445,6 @@ type DiscountAmountType1Choice struct {
 	Cd *ExternalDiscountAmountType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DocumentAdjustment1 struct {
@@ -537,8 +457,6 @@ type DocumentAdjustment1 struct {
 	Rsn *Max4Text `xml:"Rsn"`
 
 	AddtlInf *Max140Text `xml:"AddtlInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DocumentLineIdentification1 struct {
@@ -549,8 +467,6 @@ type DocumentLineIdentification1 struct {
 	Nb *Max35Text `xml:"Nb"`
 
 	RltdDt *common.ISODate `xml:"RltdDt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DocumentLineInformation1 struct {
@@ -561,8 +477,6 @@ type DocumentLineInformation1 struct {
 	Desc *Max2048Text `xml:"Desc"`
 
 	Amt *RemittanceAmount3 `xml:"Amt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DocumentLineType1 struct {
@@ -571,8 +485,6 @@ type DocumentLineType1 struct {
 	CdOrPrtry DocumentLineType1Choice `xml:"CdOrPrtry"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DocumentLineType1Choice struct {
@@ -581,8 +493,6 @@ type DocumentLineType1Choice struct {
 	Cd *ExternalDocumentLineType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type EquivalentAmount2 struct {
@@ -591,8 +501,6 @@ type EquivalentAmount2 struct {
 	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
 
 	CcyOfTrf ActiveOrHistoricCurrencyCode `xml:"CcyOfTrf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
@@ -601,8 +509,6 @@ type FinancialIdentificationSchemeName1Choice struct {
 	Cd *ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type FinancialInstitutionIdentification18 struct {
@@ -619,8 +525,6 @@ type FinancialInstitutionIdentification18 struct {
 	PstlAdr *PostalAddress24 `xml:"PstlAdr"`
 
 	Othr *GenericFinancialIdentification1 `xml:"Othr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Frequency36Choice struct {
@@ -631,8 +535,6 @@ type Frequency36Choice struct {
 	Prd *FrequencyPeriod1 `xml:"Prd"`
 
 	PtInTm *FrequencyAndMoment1 `xml:"PtInTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type FrequencyAndMoment1 struct {
@@ -641,8 +543,6 @@ type FrequencyAndMoment1 struct {
 	Tp Frequency6Code `xml:"Tp"`
 
 	PtInTm Exact2NumericText `xml:"PtInTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type FrequencyPeriod1 struct {
@@ -651,8 +551,6 @@ type FrequencyPeriod1 struct {
 	Tp Frequency6Code `xml:"Tp"`
 
 	CntPerPrd DecimalNumber `xml:"CntPerPrd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Garnishment3 struct {
@@ -673,8 +571,6 @@ type Garnishment3 struct {
 	FmlyMdclInsrncInd *TrueFalseIndicator `xml:"FmlyMdclInsrncInd"`
 
 	MplyeeTermntnInd *TrueFalseIndicator `xml:"MplyeeTermntnInd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GarnishmentType1 struct {
@@ -683,8 +579,6 @@ type GarnishmentType1 struct {
 	CdOrPrtry GarnishmentType1Choice `xml:"CdOrPrtry"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GarnishmentType1Choice struct {
@@ -693,8 +587,6 @@ type GarnishmentType1Choice struct {
 	Cd *ExternalGarnishmentType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericAccountIdentification1 struct {
@@ -705,8 +597,6 @@ type GenericAccountIdentification1 struct {
 	SchmeNm *AccountSchemeName1Choice `xml:"SchmeNm"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericFinancialIdentification1 struct {
@@ -717,8 +607,6 @@ type GenericFinancialIdentification1 struct {
 	SchmeNm *FinancialIdentificationSchemeName1Choice `xml:"SchmeNm"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericIdentification3 struct {
@@ -727,8 +615,6 @@ type GenericIdentification3 struct {
 	Id Max35Text `xml:"Id"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericIdentification30 struct {
@@ -739,8 +625,6 @@ type GenericIdentification30 struct {
 	Issr Max35Text `xml:"Issr"`
 
 	SchmeNm *Max35Text `xml:"SchmeNm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericOrganisationIdentification1 struct {
@@ -751,8 +635,6 @@ type GenericOrganisationIdentification1 struct {
 	SchmeNm *OrganisationIdentificationSchemeName1Choice `xml:"SchmeNm"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericPersonIdentification1 struct {
@@ -763,8 +645,6 @@ type GenericPersonIdentification1 struct {
 	SchmeNm *PersonIdentificationSchemeName1Choice `xml:"SchmeNm"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type InvestigationStatus5Choice struct {
@@ -777,8 +657,6 @@ type InvestigationStatus5Choice struct {
 	DplctOf *Case5 `xml:"DplctOf"`
 
 	AssgnmtCxlConf *YesNoIndicator `xml:"AssgnmtCxlConf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type LocalInstrument2Choice struct {
@@ -787,8 +665,6 @@ type LocalInstrument2Choice struct {
 	Cd *ExternalLocalInstrument1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type MandateRelatedInformation14 struct {
@@ -813,8 +689,6 @@ type MandateRelatedInformation14 struct {
 	Rsn *MandateSetupReason1Choice `xml:"Rsn"`
 
 	TrckgDays *Exact2NumericText `xml:"TrckgDays"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type MandateSetupReason1Choice struct {
@@ -823,8 +697,6 @@ type MandateSetupReason1Choice struct {
 	Cd *ExternalMandateSetupReason1Code `xml:"Cd"`
 
 	Prtry *Max70Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ModificationStatusReason1Choice struct {
@@ -833,8 +705,6 @@ type ModificationStatusReason1Choice struct {
 	Cd *ExternalPaymentModificationRejection1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ModificationStatusReason2 struct {
@@ -845,8 +715,6 @@ type ModificationStatusReason2 struct {
 	Rsn *ModificationStatusReason1Choice `xml:"Rsn"`
 
 	AddtlInf []Max105Text `xml:"AddtlInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type NumberOfCancellationsPerStatus1 struct {
@@ -857,8 +725,6 @@ type NumberOfCancellationsPerStatus1 struct {
 	DtldSts CancellationIndividualStatus1Code `xml:"DtldSts"`
 
 	DtldCtrlSum *DecimalNumber `xml:"DtldCtrlSum"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type NumberOfTransactionsPerStatus1 struct {
@@ -869,8 +735,6 @@ type NumberOfTransactionsPerStatus1 struct {
 	DtldSts TransactionIndividualStatus1Code `xml:"DtldSts"`
 
 	DtldCtrlSum *DecimalNumber `xml:"DtldCtrlSum"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OrganisationIdentification29 struct {
@@ -881,8 +745,6 @@ type OrganisationIdentification29 struct {
 	LEI *LEIIdentifier `xml:"LEI"`
 
 	Othr []GenericOrganisationIdentification1 `xml:"Othr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
@@ -891,8 +753,6 @@ type OrganisationIdentificationSchemeName1Choice struct {
 	Cd *ExternalOrganisationIdentification1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OriginalGroupHeader14 struct {
@@ -917,8 +777,6 @@ type OriginalGroupHeader14 struct {
 	CxlStsRsnInf []CancellationStatusReason4 `xml:"CxlStsRsnInf"`
 
 	NbOfTxsPerCxlSts []NumberOfTransactionsPerStatus1 `xml:"NbOfTxsPerCxlSts"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OriginalGroupInformation29 struct {
@@ -929,8 +787,6 @@ type OriginalGroupInformation29 struct {
 	OrgnlMsgNmId Max35Text `xml:"OrgnlMsgNmId"`
 
 	OrgnlCreDtTm *common.ISODateTime `xml:"OrgnlCreDtTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OriginalPaymentInstruction30 struct {
@@ -955,8 +811,6 @@ type OriginalPaymentInstruction30 struct {
 	NbOfTxsPerCxlSts []NumberOfCancellationsPerStatus1 `xml:"NbOfTxsPerCxlSts"`
 
 	TxInfAndSts []PaymentTransaction103 `xml:"TxInfAndSts"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OriginalTransactionReference28 struct {
@@ -1005,8 +859,6 @@ type OriginalTransactionReference28 struct {
 	UltmtCdtr *Party40Choice `xml:"UltmtCdtr"`
 
 	Purp *Purpose2Choice `xml:"Purp"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OtherContact1 struct {
@@ -1015,8 +867,6 @@ type OtherContact1 struct {
 	ChanlTp Max4Text `xml:"ChanlTp"`
 
 	Id *Max128Text `xml:"Id"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Party38Choice struct {
@@ -1025,8 +875,6 @@ type Party38Choice struct {
 	OrgId *OrganisationIdentification29 `xml:"OrgId"`
 
 	PrvtId *PersonIdentification13 `xml:"PrvtId"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Party40Choice struct {
@@ -1035,8 +883,6 @@ type Party40Choice struct {
 	Pty *PartyIdentification135 `xml:"Pty"`
 
 	Agt *BranchAndFinancialInstitutionIdentification6 `xml:"Agt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PartyIdentification135 struct {
@@ -1051,8 +897,6 @@ type PartyIdentification135 struct {
 	CtryOfRes *CountryCode `xml:"CtryOfRes"`
 
 	CtctDtls *Contact4 `xml:"CtctDtls"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PaymentTransaction102 struct {
@@ -1089,8 +933,6 @@ type PaymentTransaction102 struct {
 	Assgne *Party40Choice `xml:"Assgne"`
 
 	OrgnlTxRef *OriginalTransactionReference28 `xml:"OrgnlTxRef"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PaymentTransaction103 struct {
@@ -1117,8 +959,6 @@ type PaymentTransaction103 struct {
 	OrgnlReqdColltnDt *common.ISODate `xml:"OrgnlReqdColltnDt"`
 
 	OrgnlTxRef *OriginalTransactionReference28 `xml:"OrgnlTxRef"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PaymentTransaction107 struct {
@@ -1155,8 +995,6 @@ type PaymentTransaction107 struct {
 	Assgne *Party40Choice `xml:"Assgne"`
 
 	OrgnlTxRef *OriginalTransactionReference28 `xml:"OrgnlTxRef"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PaymentTypeInformation27 struct {
@@ -1173,8 +1011,6 @@ type PaymentTypeInformation27 struct {
 	SeqTp *SequenceType3Code `xml:"SeqTp"`
 
 	CtgyPurp *CategoryPurpose1Choice `xml:"CtgyPurp"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PersonIdentification13 struct {
@@ -1183,8 +1019,6 @@ type PersonIdentification13 struct {
 	DtAndPlcOfBirth *DateAndPlaceOfBirth1 `xml:"DtAndPlcOfBirth"`
 
 	Othr []GenericPersonIdentification1 `xml:"Othr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PersonIdentificationSchemeName1Choice struct {
@@ -1193,8 +1027,6 @@ type PersonIdentificationSchemeName1Choice struct {
 	Cd *ExternalPersonIdentification1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PostalAddress24 struct {
@@ -1231,8 +1063,6 @@ type PostalAddress24 struct {
 	Ctry *CountryCode `xml:"Ctry"`
 
 	AdrLine []Max70Text `xml:"AdrLine"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ProxyAccountIdentification1 struct {
@@ -1241,8 +1071,6 @@ type ProxyAccountIdentification1 struct {
 	Tp *ProxyAccountType1Choice `xml:"Tp"`
 
 	Id Max2048Text `xml:"Id"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ProxyAccountType1Choice struct {
@@ -1251,8 +1079,6 @@ type ProxyAccountType1Choice struct {
 	Cd *ExternalProxyAccountType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Purpose2Choice struct {
@@ -1261,8 +1087,6 @@ type Purpose2Choice struct {
 	Cd *ExternalPurpose1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ReferredDocumentInformation7 struct {
@@ -1275,8 +1099,6 @@ type ReferredDocumentInformation7 struct {
 	RltdDt *common.ISODate `xml:"RltdDt"`
 
 	LineDtls []DocumentLineInformation1 `xml:"LineDtls"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ReferredDocumentType3Choice struct {
@@ -1285,8 +1107,6 @@ type ReferredDocumentType3Choice struct {
 	Cd *DocumentType6Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ReferredDocumentType4 struct {
@@ -1295,8 +1115,6 @@ type ReferredDocumentType4 struct {
 	CdOrPrtry ReferredDocumentType3Choice `xml:"CdOrPrtry"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type RemittanceAmount2 struct {
@@ -1313,8 +1131,6 @@ type RemittanceAmount2 struct {
 	AdjstmntAmtAndRsn []DocumentAdjustment1 `xml:"AdjstmntAmtAndRsn"`
 
 	RmtdAmt *ActiveOrHistoricCurrencyAndAmount `xml:"RmtdAmt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type RemittanceAmount3 struct {
@@ -1331,8 +1147,6 @@ type RemittanceAmount3 struct {
 	AdjstmntAmtAndRsn []DocumentAdjustment1 `xml:"AdjstmntAmtAndRsn"`
 
 	RmtdAmt *ActiveOrHistoricCurrencyAndAmount `xml:"RmtdAmt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type RemittanceInformation16 struct {
@@ -1341,8 +1155,6 @@ type RemittanceInformation16 struct {
 	Ustrd []Max140Text `xml:"Ustrd"`
 
 	Strd []StructuredRemittanceInformation16 `xml:"Strd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ResolutionData1 struct {
@@ -1363,8 +1175,6 @@ type ResolutionData1 struct {
 	Compstn *Compensation2 `xml:"Compstn"`
 
 	Chrgs []Charges7 `xml:"Chrgs"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ResolutionOfInvestigationV09 struct {
@@ -1389,8 +1199,6 @@ type ResolutionOfInvestigationV09 struct {
 	RsltnRltdInf *ResolutionData1 `xml:"RsltnRltdInf"`
 
 	SplmtryData []SupplementaryData1 `xml:"SplmtryData"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ServiceLevel8Choice struct {
@@ -1399,8 +1207,6 @@ type ServiceLevel8Choice struct {
 	Cd *ExternalServiceLevel1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type SettlementInstruction7 struct {
@@ -1423,8 +1229,6 @@ type SettlementInstruction7 struct {
 	ThrdRmbrsmntAgt *BranchAndFinancialInstitutionIdentification6 `xml:"ThrdRmbrsmntAgt"`
 
 	ThrdRmbrsmntAgtAcct *CashAccount38 `xml:"ThrdRmbrsmntAgtAcct"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type StatementResolutionEntry4 struct {
@@ -1443,8 +1247,6 @@ type StatementResolutionEntry4 struct {
 	Chrgs []Charges6 `xml:"Chrgs"`
 
 	Purp *Purpose2Choice `xml:"Purp"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type StructuredRemittanceInformation16 struct {
@@ -1465,8 +1267,6 @@ type StructuredRemittanceInformation16 struct {
 	GrnshmtRmt *Garnishment3 `xml:"GrnshmtRmt"`
 
 	AddtlRmtInf []Max140Text `xml:"AddtlRmtInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type SupplementaryData1 struct {
@@ -1475,8 +1275,6 @@ type SupplementaryData1 struct {
 	PlcAndNm *Max350Text `xml:"PlcAndNm"`
 
 	Envlp SupplementaryDataEnvelope1 `xml:"Envlp"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type SupplementaryDataEnvelope1 struct {
@@ -1495,8 +1293,6 @@ type TaxAmount2 struct {
 	TtlAmt *ActiveOrHistoricCurrencyAndAmount `xml:"TtlAmt"`
 
 	Dtls []TaxRecordDetails2 `xml:"Dtls"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxAmountAndType1 struct {
@@ -1505,8 +1301,6 @@ type TaxAmountAndType1 struct {
 	Tp *TaxAmountType1Choice `xml:"Tp"`
 
 	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxAmountType1Choice struct {
@@ -1515,8 +1309,6 @@ type TaxAmountType1Choice struct {
 	Cd *ExternalTaxAmountType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxAuthorisation1 struct {
@@ -1525,8 +1317,6 @@ type TaxAuthorisation1 struct {
 	Titl *Max35Text `xml:"Titl"`
 
 	Nm *Max140Text `xml:"Nm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxCharges2 struct {
@@ -1537,8 +1327,6 @@ type TaxCharges2 struct {
 	Rate *PercentageRate `xml:"Rate"`
 
 	Amt *ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxInformation7 struct {
@@ -1565,8 +1353,6 @@ type TaxInformation7 struct {
 	SeqNb *Number `xml:"SeqNb"`
 
 	Rcrd []TaxRecord2 `xml:"Rcrd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxParty1 struct {
@@ -1577,8 +1363,6 @@ type TaxParty1 struct {
 	RegnId *Max35Text `xml:"RegnId"`
 
 	TaxTp *Max35Text `xml:"TaxTp"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxParty2 struct {
@@ -1591,8 +1375,6 @@ type TaxParty2 struct {
 	TaxTp *Max35Text `xml:"TaxTp"`
 
 	Authstn *TaxAuthorisation1 `xml:"Authstn"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxPeriod2 struct {
@@ -1603,8 +1385,6 @@ type TaxPeriod2 struct {
 	Tp *TaxRecordPeriod1Code `xml:"Tp"`
 
 	FrToDt *DatePeriod2 `xml:"FrToDt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxRecord2 struct {
@@ -1627,8 +1407,6 @@ type TaxRecord2 struct {
 	TaxAmt *TaxAmount2 `xml:"TaxAmt"`
 
 	AddtlInf *Max140Text `xml:"AddtlInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxRecordDetails2 struct {
@@ -1637,8 +1415,6 @@ type TaxRecordDetails2 struct {
 	Prd *TaxPeriod2 `xml:"Prd"`
 
 	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type UnderlyingTransaction22 struct {
@@ -1649,8 +1425,6 @@ type UnderlyingTransaction22 struct {
 	OrgnlPmtInfAndSts []OriginalPaymentInstruction30 `xml:"OrgnlPmtInfAndSts"`
 
 	TxInfAndSts []PaymentTransaction102 `xml:"TxInfAndSts"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 // XSD SimpleType declarations
@@ -23,8 +23,6 @@ type AccountIdentification4Choice struct {
 	IBAN *IBAN2007Identifier `xml:"IBAN"`
 
 	Othr *GenericAccountIdentification1 `xml:"Othr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type AccountInterest4 struct {
@@ -39,8 +37,6 @@ type AccountInterest4 struct {
 	Rsn *Max35Text `xml:"Rsn"`
 
 	Tax *TaxCharges2 `xml:"Tax"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type AccountReport25 struct {
@@ -77,8 +73,6 @@ type AccountReport25 struct {
 	Ntry []ReportEntry10 `xml:"Ntry"`
 
 	AddtlRptInf *Max500Text `xml:"AddtlRptInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type AccountSchemeName1Choice struct {
@@ -87,8 +81,6 @@ type AccountSchemeName1Choice struct {
 	Cd *ExternalAccountIdentification1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ActiveCurrencyAndAmount struct {
@@ -96,8 +88,7 @@ type ActiveCurrencyAndAmount struct {
 
 	Ccy ActiveCurrencyCode `xml:"Ccy,attr"`
 
-	Text     string `xml:",chardata"`
-	InnerXml string `xml:",innerxml"`
+	Text string `xml:",chardata"`
 }
 
 type ActiveOrHistoricCurrencyAnd13DecimalAmount struct {
@@ -105,8 +96,7 @@ type ActiveOrHistoricCurrencyAnd13DecimalAmount struct {
 
 	Ccy ActiveOrHistoricCurrencyCode `xml:"Ccy,attr"`
 
-	Text     string `xml:",chardata"`
-	InnerXml string `xml:",innerxml"`
+	Text string `xml:",chardata"`
 }
 
 type ActiveOrHistoricCurrencyAndAmount struct {
@@ -114,8 +104,7 @@ type ActiveOrHistoricCurrencyAndAmount struct {
 
 	Ccy ActiveOrHistoricCurrencyCode `xml:"Ccy,attr"`
 
-	Text     string `xml:",chardata"`
-	InnerXml string `xml:",innerxml"`
+	Text string `xml:",chardata"`
 }
 
 type ActiveOrHistoricCurrencyAndAmountRange2 struct {
@@ -126,8 +115,6 @@ type ActiveOrHistoricCurrencyAndAmountRange2 struct {
 	CdtDbtInd *CreditDebitCode `xml:"CdtDbtInd"`
 
 	Ccy ActiveOrHistoricCurrencyCode `xml:"Ccy"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type AddressType3Choice struct {
@@ -136,8 +123,6 @@ type AddressType3Choice struct {
 	Cd *AddressType2Code `xml:"Cd"`
 
 	Prtry *GenericIdentification30 `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type AmountAndCurrencyExchange3 struct {
@@ -152,8 +137,6 @@ type AmountAndCurrencyExchange3 struct {
 	AnncdPstngAmt *AmountAndCurrencyExchangeDetails3 `xml:"AnncdPstngAmt"`
 
 	PrtryAmt []AmountAndCurrencyExchangeDetails4 `xml:"PrtryAmt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type AmountAndCurrencyExchangeDetails3 struct {
@@ -162,8 +145,6 @@ type AmountAndCurrencyExchangeDetails3 struct {
 	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
 
 	CcyXchg *CurrencyExchange5 `xml:"CcyXchg"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type AmountAndCurrencyExchangeDetails4 struct {
@@ -174,8 +155,6 @@ type AmountAndCurrencyExchangeDetails4 struct {
 	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
 
 	CcyXchg *CurrencyExchange5 `xml:"CcyXchg"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type AmountAndDirection35 struct {
@@ -184,8 +163,6 @@ type AmountAndDirection35 struct {
 	Amt NonNegativeDecimalNumber `xml:"Amt"`
 
 	CdtDbtInd CreditDebitCode `xml:"CdtDbtInd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type AmountRangeBoundary1 struct {
@@ -194,8 +171,6 @@ type AmountRangeBoundary1 struct {
 	BdryAmt ImpliedCurrencyAndAmount `xml:"BdryAmt"`
 
 	Incl YesNoIndicator `xml:"Incl"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type BalanceSubType1Choice struct {
@@ -204,8 +179,6 @@ type BalanceSubType1Choice struct {
 	Cd *ExternalBalanceSubType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type BalanceType10Choice struct {
@@ -214,8 +187,6 @@ type BalanceType10Choice struct {
 	Cd *ExternalBalanceType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type BalanceType13 struct {
@@ -224,8 +195,6 @@ type BalanceType13 struct {
 	CdOrPrtry BalanceType10Choice `xml:"CdOrPrtry"`
 
 	SubTp *BalanceSubType1Choice `xml:"SubTp"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type BankToCustomerAccountReportV08 struct {
@@ -236,8 +205,6 @@ type BankToCustomerAccountReportV08 struct {
 	Rpt []AccountReport25 `xml:"Rpt"`
 
 	SplmtryData []SupplementaryData1 `xml:"SplmtryData"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type BankTransactionCodeStructure4 struct {
@@ -246,8 +213,6 @@ type BankTransactionCodeStructure4 struct {
 	Domn *BankTransactionCodeStructure5 `xml:"Domn"`
 
 	Prtry *ProprietaryBankTransactionCodeStructure1 `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type BankTransactionCodeStructure5 struct {
@@ -256,8 +221,6 @@ type BankTransactionCodeStructure5 struct {
 	Cd ExternalBankTransactionDomain1Code `xml:"Cd"`
 
 	Fmly BankTransactionCodeStructure6 `xml:"Fmly"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type BankTransactionCodeStructure6 struct {
@@ -266,8 +229,6 @@ type BankTransactionCodeStructure6 struct {
 	Cd ExternalBankTransactionFamily1Code `xml:"Cd"`
 
 	SubFmlyCd ExternalBankTransactionSubFamily1Code `xml:"SubFmlyCd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type BatchInformation2 struct {
@@ -282,8 +243,6 @@ type BatchInformation2 struct {
 	TtlAmt *ActiveOrHistoricCurrencyAndAmount `xml:"TtlAmt"`
 
 	CdtDbtInd *CreditDebitCode `xml:"CdtDbtInd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type BranchAndFinancialInstitutionIdentification6 struct {
@@ -292,8 +251,6 @@ type BranchAndFinancialInstitutionIdentification6 struct {
 	FinInstnId FinancialInstitutionIdentification18 `xml:"FinInstnId"`
 
 	BrnchId *BranchData3 `xml:"BrnchId"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type BranchData3 struct {
@@ -306,8 +263,6 @@ type BranchData3 struct {
 	Nm *Max140Text `xml:"Nm"`
 
 	PstlAdr *PostalAddress24 `xml:"PstlAdr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CardAggregated2 struct {
@@ -322,8 +277,6 @@ type CardAggregated2 struct {
 	SeqNbRg *CardSequenceNumberRange1 `xml:"SeqNbRg"`
 
 	TxDtRg *DateOrDateTimePeriod1Choice `xml:"TxDtRg"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CardEntry4 struct {
@@ -336,8 +289,6 @@ type CardEntry4 struct {
 	AggtdNtry *CardAggregated2 `xml:"AggtdNtry"`
 
 	PrePdAcct *CashAccount38 `xml:"PrePdAcct"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CardIndividualTransaction2 struct {
@@ -366,8 +317,6 @@ type CardIndividualTransaction2 struct {
 	VldtnDt *common.ISODate `xml:"VldtnDt"`
 
 	VldtnSeqNb *Max35Text `xml:"VldtnSeqNb"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CardSecurityInformation1 struct {
@@ -376,8 +325,6 @@ type CardSecurityInformation1 struct {
 	CSCMgmt CSCManagement1Code `xml:"CSCMgmt"`
 
 	CSCVal *Min3Max4NumericText `xml:"CSCVal"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CardSequenceNumberRange1 struct {
@@ -386,8 +333,6 @@ type CardSequenceNumberRange1 struct {
 	FrstTx *Max35Text `xml:"FrstTx"`
 
 	LastTx *Max35Text `xml:"LastTx"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CardTransaction17 struct {
@@ -400,8 +345,6 @@ type CardTransaction17 struct {
 	Tx *CardTransaction3Choice `xml:"Tx"`
 
 	PrePdAcct *CashAccount38 `xml:"PrePdAcct"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CardTransaction3Choice struct {
@@ -410,8 +353,6 @@ type CardTransaction3Choice struct {
 	Aggtd *CardAggregated2 `xml:"Aggtd"`
 
 	Indv *CardIndividualTransaction2 `xml:"Indv"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CardholderAuthentication2 struct {
@@ -420,8 +361,6 @@ type CardholderAuthentication2 struct {
 	AuthntcnMtd AuthenticationMethod1Code `xml:"AuthntcnMtd"`
 
 	AuthntcnNtty AuthenticationEntity1Code `xml:"AuthntcnNtty"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CashAccount38 struct {
@@ -436,8 +375,6 @@ type CashAccount38 struct {
 	Nm *Max70Text `xml:"Nm"`
 
 	Prxy *ProxyAccountIdentification1 `xml:"Prxy"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CashAccount39 struct {
@@ -456,8 +393,6 @@ type CashAccount39 struct {
 	Ownr *PartyIdentification135 `xml:"Ownr"`
 
 	Svcr *BranchAndFinancialInstitutionIdentification6 `xml:"Svcr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CashAccountType2Choice struct {
@@ -466,8 +401,6 @@ type CashAccountType2Choice struct {
 	Cd *ExternalCashAccountType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CashAvailability1 struct {
@@ -478,8 +411,6 @@ type CashAvailability1 struct {
 	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
 
 	CdtDbtInd CreditDebitCode `xml:"CdtDbtInd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CashAvailabilityDate1Choice struct {
@@ -488,8 +419,6 @@ type CashAvailabilityDate1Choice struct {
 	NbOfDays *Max15PlusSignedNumericText `xml:"NbOfDays"`
 
 	ActlDt *common.ISODate `xml:"ActlDt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CashBalance8 struct {
@@ -506,8 +435,6 @@ type CashBalance8 struct {
 	Dt DateAndDateTime2Choice `xml:"Dt"`
 
 	Avlbty []CashAvailability1 `xml:"Avlbty"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CashDeposit1 struct {
@@ -518,8 +445,6 @@ type CashDeposit1 struct {
 	NbOfNotes Max15NumericText `xml:"NbOfNotes"`
 
 	Amt ActiveCurrencyAndAmount `xml:"Amt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ChargeType3Choice struct {
@@ -528,8 +453,6 @@ type ChargeType3Choice struct {
 	Cd *ExternalChargeType1Code `xml:"Cd"`
 
 	Prtry *GenericIdentification3 `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Charges6 struct {
@@ -538,8 +461,6 @@ type Charges6 struct {
 	TtlChrgsAndTaxAmt *ActiveOrHistoricCurrencyAndAmount `xml:"TtlChrgsAndTaxAmt"`
 
 	Rcrd []ChargesRecord3 `xml:"Rcrd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ChargesRecord3 struct {
@@ -560,8 +481,6 @@ type ChargesRecord3 struct {
 	Agt *BranchAndFinancialInstitutionIdentification6 `xml:"Agt"`
 
 	Tax *TaxCharges2 `xml:"Tax"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ClearingSystemIdentification2Choice struct {
@@ -570,8 +489,6 @@ type ClearingSystemIdentification2Choice struct {
 	Cd *ExternalClearingSystemIdentification1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ClearingSystemMemberIdentification2 struct {
@@ -580,8 +497,6 @@ type ClearingSystemMemberIdentification2 struct {
 	ClrSysId *ClearingSystemIdentification2Choice `xml:"ClrSysId"`
 
 	MmbId Max35Text `xml:"MmbId"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Contact4 struct {
@@ -610,8 +525,6 @@ type Contact4 struct {
 	Othr []OtherContact1 `xml:"Othr"`
 
 	PrefrdMtd *PreferredContactMethod1Code `xml:"PrefrdMtd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CorporateAction9 struct {
@@ -620,8 +533,6 @@ type CorporateAction9 struct {
 	EvtTp Max35Text `xml:"EvtTp"`
 
 	EvtId Max35Text `xml:"EvtId"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CreditLine3 struct {
@@ -634,8 +545,6 @@ type CreditLine3 struct {
 	Amt *ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
 
 	Dt *DateAndDateTime2Choice `xml:"Dt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CreditLineType1Choice struct {
@@ -644,8 +553,6 @@ type CreditLineType1Choice struct {
 	Cd *ExternalCreditLineType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CreditorReferenceInformation2 struct {
@@ -654,8 +561,6 @@ type CreditorReferenceInformation2 struct {
 	Tp *CreditorReferenceType2 `xml:"Tp"`
 
 	Ref *Max35Text `xml:"Ref"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CreditorReferenceType1Choice struct {
@@ -664,8 +569,6 @@ type CreditorReferenceType1Choice struct {
 	Cd *DocumentType3Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CreditorReferenceType2 struct {
@@ -674,8 +577,6 @@ type CreditorReferenceType2 struct {
 	CdOrPrtry CreditorReferenceType1Choice `xml:"CdOrPrtry"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type CurrencyExchange5 struct {
@@ -692,8 +593,6 @@ type CurrencyExchange5 struct {
 	CtrctId *Max35Text `xml:"CtrctId"`
 
 	QtnDt *common.ISODateTime `xml:"QtnDt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DateAndDateTime2Choice struct {
@@ -702,8 +601,6 @@ type DateAndDateTime2Choice struct {
 	Dt *common.ISODate `xml:"Dt"`
 
 	DtTm *common.ISODateTime `xml:"DtTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DateAndPlaceOfBirth1 struct {
@@ -716,8 +613,6 @@ type DateAndPlaceOfBirth1 struct {
 	CityOfBirth Max35Text `xml:"CityOfBirth"`
 
 	CtryOfBirth CountryCode `xml:"CtryOfBirth"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DateOrDateTimePeriod1Choice struct {
@@ -726,8 +621,6 @@ type DateOrDateTimePeriod1Choice struct {
 	Dt *DatePeriod2 `xml:"Dt"`
 
 	DtTm *DateTimePeriod1 `xml:"DtTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DatePeriod2 struct {
@@ -736,8 +629,6 @@ type DatePeriod2 struct {
 	FrDt common.ISODate `xml:"FrDt"`
 
 	ToDt common.ISODate `xml:"ToDt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DateTimePeriod1 struct {
@@ -746,8 +637,6 @@ type DateTimePeriod1 struct {
 	FrDtTm common.ISODateTime `xml:"FrDtTm"`
 
 	ToDtTm common.ISODateTime `xml:"ToDtTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DiscountAmountAndType1 struct {
@@ -756,8 +645,6 @@ type DiscountAmountAndType1 struct {
 	Tp *DiscountAmountType1Choice `xml:"Tp"`
 
 	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DiscountAmountType1Choice struct {
@@ -766,8 +653,6 @@ type DiscountAmountType1Choice struct {
 	Cd *ExternalDiscountAmountType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DisplayCapabilities1 struct {
@@ -778,8 +663,6 @@ type DisplayCapabilities1 struct {
 	NbOfLines Max3NumericText `xml:"NbOfLines"`
 
 	LineWidth Max3NumericText `xml:"LineWidth"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DocumentAdjustment1 struct {
@@ -792,8 +675,6 @@ type DocumentAdjustment1 struct {
 	Rsn *Max4Text `xml:"Rsn"`
 
 	AddtlInf *Max140Text `xml:"AddtlInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DocumentLineIdentification1 struct {
@@ -804,8 +685,6 @@ type DocumentLineIdentification1 struct {
 	Nb *Max35Text `xml:"Nb"`
 
 	RltdDt *common.ISODate `xml:"RltdDt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DocumentLineInformation1 struct {
@@ -816,8 +695,6 @@ type DocumentLineInformation1 struct {
 	Desc *Max2048Text `xml:"Desc"`
 
 	Amt *RemittanceAmount3 `xml:"Amt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DocumentLineType1 struct {
@@ -826,8 +703,6 @@ type DocumentLineType1 struct {
 	CdOrPrtry DocumentLineType1Choice `xml:"CdOrPrtry"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type DocumentLineType1Choice struct {
@@ -836,8 +711,6 @@ type DocumentLineType1Choice struct {
 	Cd *ExternalDocumentLineType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type EntryDetails9 struct {
@@ -846,8 +719,6 @@ type EntryDetails9 struct {
 	Btch *BatchInformation2 `xml:"Btch"`
 
 	TxDtls []EntryTransaction10 `xml:"TxDtls"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type EntryStatus1Choice struct {
@@ -856,8 +727,6 @@ type EntryStatus1Choice struct {
 	Cd *ExternalEntryStatus1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type EntryTransaction10 struct {
@@ -914,8 +783,6 @@ type EntryTransaction10 struct {
 	AddtlTxInf *Max500Text `xml:"AddtlTxInf"`
 
 	SplmtryData []SupplementaryData1 `xml:"SplmtryData"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type FinancialIdentificationSchemeName1Choice struct {
@@ -924,8 +791,6 @@ type FinancialIdentificationSchemeName1Choice struct {
 	Cd *ExternalFinancialInstitutionIdentification1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type FinancialInstitutionIdentification18 struct {
@@ -942,8 +807,6 @@ type FinancialInstitutionIdentification18 struct {
 	PstlAdr *PostalAddress24 `xml:"PstlAdr"`
 
 	Othr *GenericFinancialIdentification1 `xml:"Othr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type FinancialInstrumentQuantity1Choice struct {
@@ -954,8 +817,6 @@ type FinancialInstrumentQuantity1Choice struct {
 	FaceAmt *ImpliedCurrencyAndAmount `xml:"FaceAmt"`
 
 	AmtsdVal *ImpliedCurrencyAndAmount `xml:"AmtsdVal"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type FromToAmountRange1 struct {
@@ -964,8 +825,6 @@ type FromToAmountRange1 struct {
 	FrAmt AmountRangeBoundary1 `xml:"FrAmt"`
 
 	ToAmt AmountRangeBoundary1 `xml:"ToAmt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Garnishment3 struct {
@@ -986,8 +845,6 @@ type Garnishment3 struct {
 	FmlyMdclInsrncInd *TrueFalseIndicator `xml:"FmlyMdclInsrncInd"`
 
 	MplyeeTermntnInd *TrueFalseIndicator `xml:"MplyeeTermntnInd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GarnishmentType1 struct {
@@ -996,8 +853,6 @@ type GarnishmentType1 struct {
 	CdOrPrtry GarnishmentType1Choice `xml:"CdOrPrtry"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GarnishmentType1Choice struct {
@@ -1006,8 +861,6 @@ type GarnishmentType1Choice struct {
 	Cd *ExternalGarnishmentType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericAccountIdentification1 struct {
@@ -1018,8 +871,6 @@ type GenericAccountIdentification1 struct {
 	SchmeNm *AccountSchemeName1Choice `xml:"SchmeNm"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericFinancialIdentification1 struct {
@@ -1030,8 +881,6 @@ type GenericFinancialIdentification1 struct {
 	SchmeNm *FinancialIdentificationSchemeName1Choice `xml:"SchmeNm"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericIdentification1 struct {
@@ -1042,8 +891,6 @@ type GenericIdentification1 struct {
 	SchmeNm *Max35Text `xml:"SchmeNm"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericIdentification3 struct {
@@ -1052,8 +899,6 @@ type GenericIdentification3 struct {
 	Id Max35Text `xml:"Id"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericIdentification30 struct {
@@ -1064,8 +909,6 @@ type GenericIdentification30 struct {
 	Issr Max35Text `xml:"Issr"`
 
 	SchmeNm *Max35Text `xml:"SchmeNm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericIdentification32 struct {
@@ -1078,8 +921,6 @@ type GenericIdentification32 struct {
 	Issr *PartyType4Code `xml:"Issr"`
 
 	ShrtNm *Max35Text `xml:"ShrtNm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericOrganisationIdentification1 struct {
@@ -1090,8 +931,6 @@ type GenericOrganisationIdentification1 struct {
 	SchmeNm *OrganisationIdentificationSchemeName1Choice `xml:"SchmeNm"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GenericPersonIdentification1 struct {
@@ -1102,8 +941,6 @@ type GenericPersonIdentification1 struct {
 	SchmeNm *PersonIdentificationSchemeName1Choice `xml:"SchmeNm"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type GroupHeader81 struct {
@@ -1120,8 +957,6 @@ type GroupHeader81 struct {
 	OrgnlBizQry *OriginalBusinessQuery1 `xml:"OrgnlBizQry"`
 
 	AddtlInf *Max500Text `xml:"AddtlInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type IdentificationSource3Choice struct {
@@ -1130,8 +965,6 @@ type IdentificationSource3Choice struct {
 	Cd *ExternalFinancialInstrumentIdentificationType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ImpliedCurrencyAmountRange1Choice struct {
@@ -1146,8 +979,6 @@ type ImpliedCurrencyAmountRange1Choice struct {
 	EQAmt *ImpliedCurrencyAndAmount `xml:"EQAmt"`
 
 	NEQAmt *ImpliedCurrencyAndAmount `xml:"NEQAmt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type InterestRecord2 struct {
@@ -1166,8 +997,6 @@ type InterestRecord2 struct {
 	Rsn *Max35Text `xml:"Rsn"`
 
 	Tax *TaxCharges2 `xml:"Tax"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type InterestType1Choice struct {
@@ -1176,8 +1005,6 @@ type InterestType1Choice struct {
 	Cd *InterestType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type LocalInstrument2Choice struct {
@@ -1186,8 +1013,6 @@ type LocalInstrument2Choice struct {
 	Cd *ExternalLocalInstrument1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type MessageIdentification2 struct {
@@ -1196,8 +1021,6 @@ type MessageIdentification2 struct {
 	MsgNmId *Max35Text `xml:"MsgNmId"`
 
 	MsgId *Max35Text `xml:"MsgId"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type NameAndAddress16 struct {
@@ -1206,8 +1029,6 @@ type NameAndAddress16 struct {
 	Nm Max140Text `xml:"Nm"`
 
 	Adr PostalAddress24 `xml:"Adr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type NumberAndSumOfTransactions1 struct {
@@ -1216,8 +1037,6 @@ type NumberAndSumOfTransactions1 struct {
 	NbOfNtries *Max15NumericText `xml:"NbOfNtries"`
 
 	Sum *DecimalNumber `xml:"Sum"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type NumberAndSumOfTransactions4 struct {
@@ -1228,8 +1047,6 @@ type NumberAndSumOfTransactions4 struct {
 	Sum *DecimalNumber `xml:"Sum"`
 
 	TtlNetNtry *AmountAndDirection35 `xml:"TtlNetNtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OrganisationIdentification29 struct {
@@ -1240,8 +1057,6 @@ type OrganisationIdentification29 struct {
 	LEI *LEIIdentifier `xml:"LEI"`
 
 	Othr []GenericOrganisationIdentification1 `xml:"Othr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OrganisationIdentificationSchemeName1Choice struct {
@@ -1250,8 +1065,6 @@ type OrganisationIdentificationSchemeName1Choice struct {
 	Cd *ExternalOrganisationIdentification1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OriginalAndCurrentQuantities1 struct {
@@ -1260,8 +1073,6 @@ type OriginalAndCurrentQuantities1 struct {
 	FaceAmt ImpliedCurrencyAndAmount `xml:"FaceAmt"`
 
 	AmtsdVal ImpliedCurrencyAndAmount `xml:"AmtsdVal"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OriginalBusinessQuery1 struct {
@@ -1272,8 +1083,6 @@ type OriginalBusinessQuery1 struct {
 	MsgNmId *Max35Text `xml:"MsgNmId"`
 
 	CreDtTm *common.ISODateTime `xml:"CreDtTm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OtherContact1 struct {
@@ -1282,8 +1091,6 @@ type OtherContact1 struct {
 	ChanlTp Max4Text `xml:"ChanlTp"`
 
 	Id *Max128Text `xml:"Id"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type OtherIdentification1 struct {
@@ -1294,8 +1101,6 @@ type OtherIdentification1 struct {
 	Sfx *Max16Text `xml:"Sfx"`
 
 	Tp IdentificationSource3Choice `xml:"Tp"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Pagination1 struct {
@@ -1304,8 +1109,6 @@ type Pagination1 struct {
 	PgNb Max5NumericText `xml:"PgNb"`
 
 	LastPgInd YesNoIndicator `xml:"LastPgInd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Party38Choice struct {
@@ -1314,8 +1117,6 @@ type Party38Choice struct {
 	OrgId *OrganisationIdentification29 `xml:"OrgId"`
 
 	PrvtId *PersonIdentification13 `xml:"PrvtId"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Party40Choice struct {
@@ -1324,8 +1125,6 @@ type Party40Choice struct {
 	Pty *PartyIdentification135 `xml:"Pty"`
 
 	Agt *BranchAndFinancialInstitutionIdentification6 `xml:"Agt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PartyIdentification135 struct {
@@ -1340,8 +1139,6 @@ type PartyIdentification135 struct {
 	CtryOfRes *CountryCode `xml:"CtryOfRes"`
 
 	CtctDtls *Contact4 `xml:"CtctDtls"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PaymentCard4 struct {
@@ -1354,8 +1151,6 @@ type PaymentCard4 struct {
 	CardBrnd *GenericIdentification1 `xml:"CardBrnd"`
 
 	AddtlCardData *Max70Text `xml:"AddtlCardData"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PaymentContext3 struct {
@@ -1382,8 +1177,6 @@ type PaymentContext3 struct {
 	FllbckInd *TrueFalseIndicator `xml:"FllbckInd"`
 
 	AuthntcnMtd *CardholderAuthentication2 `xml:"AuthntcnMtd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PaymentReturnReason5 struct {
@@ -1396,8 +1189,6 @@ type PaymentReturnReason5 struct {
 	Rsn *ReturnReason5Choice `xml:"Rsn"`
 
 	AddtlInf []Max105Text `xml:"AddtlInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PersonIdentification13 struct {
@@ -1406,8 +1197,6 @@ type PersonIdentification13 struct {
 	DtAndPlcOfBirth *DateAndPlaceOfBirth1 `xml:"DtAndPlcOfBirth"`
 
 	Othr []GenericPersonIdentification1 `xml:"Othr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PersonIdentificationSchemeName1Choice struct {
@@ -1416,8 +1205,6 @@ type PersonIdentificationSchemeName1Choice struct {
 	Cd *ExternalPersonIdentification1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PlainCardData1 struct {
@@ -1436,8 +1223,6 @@ type PlainCardData1 struct {
 	TrckData []TrackData1 `xml:"TrckData"`
 
 	CardSctyCd *CardSecurityInformation1 `xml:"CardSctyCd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PointOfInteraction1 struct {
@@ -1452,8 +1237,6 @@ type PointOfInteraction1 struct {
 	Cpblties *PointOfInteractionCapabilities1 `xml:"Cpblties"`
 
 	Cmpnt []PointOfInteractionComponent1 `xml:"Cmpnt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PointOfInteractionCapabilities1 struct {
@@ -1468,8 +1251,6 @@ type PointOfInteractionCapabilities1 struct {
 	DispCpblties []DisplayCapabilities1 `xml:"DispCpblties"`
 
 	PrtLineWidth *Max3NumericText `xml:"PrtLineWidth"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PointOfInteractionComponent1 struct {
@@ -1486,8 +1267,6 @@ type PointOfInteractionComponent1 struct {
 	SrlNb *Max35Text `xml:"SrlNb"`
 
 	ApprvlNb []Max70Text `xml:"ApprvlNb"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PostalAddress24 struct {
@@ -1524,8 +1303,6 @@ type PostalAddress24 struct {
 	Ctry *CountryCode `xml:"Ctry"`
 
 	AdrLine []Max70Text `xml:"AdrLine"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Price7 struct {
@@ -1534,8 +1311,6 @@ type Price7 struct {
 	Tp YieldedOrValueType1Choice `xml:"Tp"`
 
 	Val PriceRateOrAmount3Choice `xml:"Val"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type PriceRateOrAmount3Choice struct {
@@ -1544,8 +1319,6 @@ type PriceRateOrAmount3Choice struct {
 	Rate *PercentageRate `xml:"Rate"`
 
 	Amt *ActiveOrHistoricCurrencyAnd13DecimalAmount `xml:"Amt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Product2 struct {
@@ -1564,8 +1337,6 @@ type Product2 struct {
 	TaxTp *Max35Text `xml:"TaxTp"`
 
 	AddtlPdctInf *Max35Text `xml:"AddtlPdctInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ProprietaryAgent4 struct {
@@ -1574,8 +1345,6 @@ type ProprietaryAgent4 struct {
 	Tp Max35Text `xml:"Tp"`
 
 	Agt BranchAndFinancialInstitutionIdentification6 `xml:"Agt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ProprietaryBankTransactionCodeStructure1 struct {
@@ -1584,8 +1353,6 @@ type ProprietaryBankTransactionCodeStructure1 struct {
 	Cd Max35Text `xml:"Cd"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ProprietaryDate3 struct {
@@ -1594,8 +1361,6 @@ type ProprietaryDate3 struct {
 	Tp Max35Text `xml:"Tp"`
 
 	Dt DateAndDateTime2Choice `xml:"Dt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ProprietaryParty5 struct {
@@ -1604,8 +1369,6 @@ type ProprietaryParty5 struct {
 	Tp Max35Text `xml:"Tp"`
 
 	Pty Party40Choice `xml:"Pty"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ProprietaryPrice2 struct {
@@ -1614,8 +1377,6 @@ type ProprietaryPrice2 struct {
 	Tp Max35Text `xml:"Tp"`
 
 	Pric ActiveOrHistoricCurrencyAndAmount `xml:"Pric"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ProprietaryQuantity1 struct {
@@ -1624,8 +1385,6 @@ type ProprietaryQuantity1 struct {
 	Tp Max35Text `xml:"Tp"`
 
 	Qty Max35Text `xml:"Qty"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ProprietaryReference1 struct {
@@ -1634,8 +1393,6 @@ type ProprietaryReference1 struct {
 	Tp Max35Text `xml:"Tp"`
 
 	Ref Max35Text `xml:"Ref"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ProxyAccountIdentification1 struct {
@@ -1644,8 +1401,6 @@ type ProxyAccountIdentification1 struct {
 	Tp *ProxyAccountType1Choice `xml:"Tp"`
 
 	Id Max2048Text `xml:"Id"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ProxyAccountType1Choice struct {
@@ -1654,8 +1409,6 @@ type ProxyAccountType1Choice struct {
 	Cd *ExternalProxyAccountType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Purpose2Choice struct {
@@ -1664,8 +1417,6 @@ type Purpose2Choice struct {
 	Cd *ExternalPurpose1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type Rate4 struct {
@@ -1674,8 +1425,6 @@ type Rate4 struct {
 	Tp RateType4Choice `xml:"Tp"`
 
 	VldtyRg *ActiveOrHistoricCurrencyAndAmountRange2 `xml:"VldtyRg"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type RateType4Choice struct {
@@ -1684,8 +1433,6 @@ type RateType4Choice struct {
 	Pctg *PercentageRate `xml:"Pctg"`
 
 	Othr *Max35Text `xml:"Othr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ReferredDocumentInformation7 struct {
@@ -1698,8 +1445,6 @@ type ReferredDocumentInformation7 struct {
 	RltdDt *common.ISODate `xml:"RltdDt"`
 
 	LineDtls []DocumentLineInformation1 `xml:"LineDtls"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ReferredDocumentType3Choice struct {
@@ -1708,8 +1453,6 @@ type ReferredDocumentType3Choice struct {
 	Cd *DocumentType6Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ReferredDocumentType4 struct {
@@ -1718,8 +1461,6 @@ type ReferredDocumentType4 struct {
 	CdOrPrtry ReferredDocumentType3Choice `xml:"CdOrPrtry"`
 
 	Issr *Max35Text `xml:"Issr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type RemittanceAmount2 struct {
@@ -1736,8 +1477,6 @@ type RemittanceAmount2 struct {
 	AdjstmntAmtAndRsn []DocumentAdjustment1 `xml:"AdjstmntAmtAndRsn"`
 
 	RmtdAmt *ActiveOrHistoricCurrencyAndAmount `xml:"RmtdAmt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type RemittanceAmount3 struct {
@@ -1754,8 +1493,6 @@ type RemittanceAmount3 struct {
 	AdjstmntAmtAndRsn []DocumentAdjustment1 `xml:"AdjstmntAmtAndRsn"`
 
 	RmtdAmt *ActiveOrHistoricCurrencyAndAmount `xml:"RmtdAmt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type RemittanceInformation16 struct {
@@ -1764,8 +1501,6 @@ type RemittanceInformation16 struct {
 	Ustrd []Max140Text `xml:"Ustrd"`
 
 	Strd []StructuredRemittanceInformation16 `xml:"Strd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type RemittanceLocation7 struct {
@@ -1774,8 +1509,6 @@ type RemittanceLocation7 struct {
 	RmtId *Max35Text `xml:"RmtId"`
 
 	RmtLctnDtls []RemittanceLocationData1 `xml:"RmtLctnDtls"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type RemittanceLocationData1 struct {
@@ -1786,8 +1519,6 @@ type RemittanceLocationData1 struct {
 	ElctrncAdr *Max2048Text `xml:"ElctrncAdr"`
 
 	PstlAdr *NameAndAddress16 `xml:"PstlAdr"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ReportEntry10 struct {
@@ -1830,8 +1561,6 @@ type ReportEntry10 struct {
 	NtryDtls []EntryDetails9 `xml:"NtryDtls"`
 
 	AddtlNtryInf *Max500Text `xml:"AddtlNtryInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ReportingSource1Choice struct {
@@ -1840,8 +1569,6 @@ type ReportingSource1Choice struct {
 	Cd *ExternalReportingSource1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type ReturnReason5Choice struct {
@@ -1850,8 +1577,6 @@ type ReturnReason5Choice struct {
 	Cd *ExternalReturnReason1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type SecuritiesAccount19 struct {
@@ -1862,8 +1587,6 @@ type SecuritiesAccount19 struct {
 	Tp *GenericIdentification30 `xml:"Tp"`
 
 	Nm *Max70Text `xml:"Nm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type SecurityIdentification19 struct {
@@ -1874,8 +1597,6 @@ type SecurityIdentification19 struct {
 	OthrId []OtherIdentification1 `xml:"OthrId"`
 
 	Desc *Max140Text `xml:"Desc"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type SequenceRange1 struct {
@@ -1884,8 +1605,6 @@ type SequenceRange1 struct {
 	FrSeq Max35Text `xml:"FrSeq"`
 
 	ToSeq Max35Text `xml:"ToSeq"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type SequenceRange1Choice struct {
@@ -1900,8 +1619,6 @@ type SequenceRange1Choice struct {
 	EQSeq []Max35Text `xml:"EQSeq"`
 
 	NEQSeq []Max35Text `xml:"NEQSeq"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type StructuredRemittanceInformation16 struct {
@@ -1922,8 +1639,6 @@ type StructuredRemittanceInformation16 struct {
 	GrnshmtRmt *Garnishment3 `xml:"GrnshmtRmt"`
 
 	AddtlRmtInf []Max140Text `xml:"AddtlRmtInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type SupplementaryData1 struct {
@@ -1932,8 +1647,6 @@ type SupplementaryData1 struct {
 	PlcAndNm *Max350Text `xml:"PlcAndNm"`
 
 	Envlp SupplementaryDataEnvelope1 `xml:"Envlp"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type SupplementaryDataEnvelope1 struct {
@@ -1952,8 +1665,6 @@ type TaxAmount2 struct {
 	TtlAmt *ActiveOrHistoricCurrencyAndAmount `xml:"TtlAmt"`
 
 	Dtls []TaxRecordDetails2 `xml:"Dtls"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxAmountAndType1 struct {
@@ -1962,8 +1673,6 @@ type TaxAmountAndType1 struct {
 	Tp *TaxAmountType1Choice `xml:"Tp"`
 
 	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxAmountType1Choice struct {
@@ -1972,8 +1681,6 @@ type TaxAmountType1Choice struct {
 	Cd *ExternalTaxAmountType1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxAuthorisation1 struct {
@@ -1982,8 +1689,6 @@ type TaxAuthorisation1 struct {
 	Titl *Max35Text `xml:"Titl"`
 
 	Nm *Max140Text `xml:"Nm"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxCharges2 struct {
@@ -1994,8 +1699,6 @@ type TaxCharges2 struct {
 	Rate *PercentageRate `xml:"Rate"`
 
 	Amt *ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxInformation7 struct {
@@ -2022,8 +1725,6 @@ type TaxInformation7 struct {
 	SeqNb *Number `xml:"SeqNb"`
 
 	Rcrd []TaxRecord2 `xml:"Rcrd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxInformation8 struct {
@@ -2048,8 +1749,6 @@ type TaxInformation8 struct {
 	SeqNb *Number `xml:"SeqNb"`
 
 	Rcrd []TaxRecord2 `xml:"Rcrd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxParty1 struct {
@@ -2060,8 +1759,6 @@ type TaxParty1 struct {
 	RegnId *Max35Text `xml:"RegnId"`
 
 	TaxTp *Max35Text `xml:"TaxTp"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxParty2 struct {
@@ -2074,8 +1771,6 @@ type TaxParty2 struct {
 	TaxTp *Max35Text `xml:"TaxTp"`
 
 	Authstn *TaxAuthorisation1 `xml:"Authstn"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxPeriod2 struct {
@@ -2086,8 +1781,6 @@ type TaxPeriod2 struct {
 	Tp *TaxRecordPeriod1Code `xml:"Tp"`
 
 	FrToDt *DatePeriod2 `xml:"FrToDt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxRecord2 struct {
@@ -2110,8 +1803,6 @@ type TaxRecord2 struct {
 	TaxAmt *TaxAmount2 `xml:"TaxAmt"`
 
 	AddtlInf *Max140Text `xml:"AddtlInf"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TaxRecordDetails2 struct {
@@ -2120,8 +1811,6 @@ type TaxRecordDetails2 struct {
 	Prd *TaxPeriod2 `xml:"Prd"`
 
 	Amt ActiveOrHistoricCurrencyAndAmount `xml:"Amt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TechnicalInputChannel1Choice struct {
@@ -2130,8 +1819,6 @@ type TechnicalInputChannel1Choice struct {
 	Cd *ExternalTechnicalInputChannel1Code `xml:"Cd"`
 
 	Prtry *Max35Text `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TotalTransactions6 struct {
@@ -2144,8 +1831,6 @@ type TotalTransactions6 struct {
 	TtlDbtNtries *NumberAndSumOfTransactions1 `xml:"TtlDbtNtries"`
 
 	TtlNtriesPerBkTxCd []TotalsPerBankTransactionCode5 `xml:"TtlNtriesPerBkTxCd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TotalsPerBankTransactionCode5 struct {
@@ -2168,8 +1853,6 @@ type TotalsPerBankTransactionCode5 struct {
 	Avlbty []CashAvailability1 `xml:"Avlbty"`
 
 	Dt *DateAndDateTime2Choice `xml:"Dt"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TrackData1 struct {
@@ -2178,8 +1861,6 @@ type TrackData1 struct {
 	TrckNb *Exact1NumericText `xml:"TrckNb"`
 
 	TrckVal Max140Text `xml:"TrckVal"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TransactionAgents5 struct {
@@ -2208,8 +1889,6 @@ type TransactionAgents5 struct {
 	SttlmPlc *BranchAndFinancialInstitutionIdentification6 `xml:"SttlmPlc"`
 
 	Prtry []ProprietaryAgent4 `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TransactionDates3 struct {
@@ -2230,8 +1909,6 @@ type TransactionDates3 struct {
 	TxDtTm *common.ISODateTime `xml:"TxDtTm"`
 
 	Prtry []ProprietaryDate3 `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TransactionIdentifier1 struct {
@@ -2240,8 +1917,6 @@ type TransactionIdentifier1 struct {
 	TxDtTm common.ISODateTime `xml:"TxDtTm"`
 
 	TxRef Max35Text `xml:"TxRef"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TransactionInterest4 struct {
@@ -2250,8 +1925,6 @@ type TransactionInterest4 struct {
 	TtlIntrstAndTaxAmt *ActiveOrHistoricCurrencyAndAmount `xml:"TtlIntrstAndTaxAmt"`
 
 	Rcrd []InterestRecord2 `xml:"Rcrd"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TransactionParties6 struct {
@@ -2274,8 +1947,6 @@ type TransactionParties6 struct {
 	TradgPty *Party40Choice `xml:"TradgPty"`
 
 	Prtry []ProprietaryParty5 `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TransactionPrice4Choice struct {
@@ -2284,8 +1955,6 @@ type TransactionPrice4Choice struct {
 	DealPric *Price7 `xml:"DealPric"`
 
 	Prtry []ProprietaryPrice2 `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TransactionQuantities3Choice struct {
@@ -2296,8 +1965,6 @@ type TransactionQuantities3Choice struct {
 	OrgnlAndCurFaceAmt *OriginalAndCurrentQuantities1 `xml:"OrgnlAndCurFaceAmt"`
 
 	Prtry *ProprietaryQuantity1 `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type TransactionReferences6 struct {
@@ -2332,8 +1999,6 @@ type TransactionReferences6 struct {
 	PrcgId *Max35Text `xml:"PrcgId"`
 
 	Prtry []ProprietaryReference1 `xml:"Prtry"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 type YieldedOrValueType1Choice struct {
@@ -2342,8 +2007,6 @@ type YieldedOrValueType1Choice struct {
 	Yldd *YesNoIndicator `xml:"Yldd"`
 
 	ValTp *PriceValueType1Code `xml:"ValTp"`
-
-	InnerXml string `xml:",innerxml"`
 }
 
 // XSD SimpleType declarations
@@ -0,0 +1,456 @@
+// Code generated by scripts/gen_codes.go; DO NOT EDIT.
+
+package camt_054_001_08
+
+// Codes of the ExternalReturnReason1Code external code list used by FedNow.
+const (
+	ExternalReturnReason1CodeAc03 ExternalReturnReason1Code = "AC03" // InvalidCreditorAccountNumber
+	ExternalReturnReason1CodeAc04 ExternalReturnReason1Code = "AC04" // ClosedAccountNumber
+	ExternalReturnReason1CodeAc06 ExternalReturnReason1Code = "AC06" // BlockedAccount
+	ExternalReturnReason1CodeAc07 ExternalReturnReason1Code = "AC07" // ClosedCreditorAccountNumber
+	ExternalReturnReason1CodeAg01 ExternalReturnReason1Code = "AG01" // TransactionForbidden
+	ExternalReturnReason1CodeAm09 ExternalReturnReason1Code = "AM09" // WrongAmount
+	ExternalReturnReason1CodeBe04 ExternalReturnReason1Code = "BE04" // MissingCreditorAddress
+	ExternalReturnReason1CodeCust ExternalReturnReason1Code = "CUST" // RequestedByCustomer
+	ExternalReturnReason1CodeDupl ExternalReturnReason1Code = "DUPL" // DuplicatePayment
+	ExternalReturnReason1CodeFocr ExternalReturnReason1Code = "FOCR" // FollowingCancellationRequest
+	ExternalReturnReason1CodeFr01 ExternalReturnReason1Code = "FR01" // Fraud
+	ExternalReturnReason1CodeMd07 ExternalReturnReason1Code = "MD07" // EndCustomerDeceased
+	ExternalReturnReason1CodeNarr ExternalReturnReason1Code = "NARR" // Narrative
+	ExternalReturnReason1CodeRr04 ExternalReturnReason1Code = "RR04" // RegulatoryReason
+	ExternalReturnReason1CodeUpay ExternalReturnReason1Code = "UPAY" // UnduePayment
+)
+
+// IsValid reports whether c is one of the ExternalReturnReason1Code codes used by FedNow.
+func (c ExternalReturnReason1Code) IsValid() bool {
+	switch c {
+	case ExternalReturnReason1CodeAc03,
+		ExternalReturnReason1CodeAc04,
+		ExternalReturnReason1CodeAc06,
+		ExternalReturnReason1CodeAc07,
+		ExternalReturnReason1CodeAg01,
+		ExternalReturnReason1CodeAm09,
+		ExternalReturnReason1CodeBe04,
+		ExternalReturnReason1CodeCust,
+		ExternalReturnReason1CodeDupl,
+		ExternalReturnReason1CodeFocr,
+		ExternalReturnReason1CodeFr01,
+		ExternalReturnReason1CodeMd07,
+		ExternalReturnReason1CodeNarr,
+		ExternalReturnReason1CodeRr04,
+		ExternalReturnReason1CodeUpay:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the AddressType2Code codes.
+func (c AddressType2Code) IsValid() bool {
+	switch c {
+	case AddressType2CodeAddr,
+		AddressType2CodePbox,
+		AddressType2CodeHome,
+		AddressType2CodeBizz,
+		AddressType2CodeMlto,
+		AddressType2CodeDlvy:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the AttendanceContext1Code codes.
+func (c AttendanceContext1Code) IsValid() bool {
+	switch c {
+	case AttendanceContext1CodeAttd,
+		AttendanceContext1CodeSatt,
+		AttendanceContext1CodeUatt:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the AuthenticationEntity1Code codes.
+func (c AuthenticationEntity1Code) IsValid() bool {
+	switch c {
+	case AuthenticationEntity1CodeIccd,
+		AuthenticationEntity1CodeAgnt,
+		AuthenticationEntity1CodeMerc:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the AuthenticationMethod1Code codes.
+func (c AuthenticationMethod1Code) IsValid() bool {
+	switch c {
+	case AuthenticationMethod1CodeUknw,
+		AuthenticationMethod1CodeByps,
+		AuthenticationMethod1CodeNpin,
+		AuthenticationMethod1CodeFpin,
+		AuthenticationMethod1CodeCpsg,
+		AuthenticationMethod1CodePpsg,
+		AuthenticationMethod1CodeManu,
+		AuthenticationMethod1CodeMerc,
+		AuthenticationMethod1CodeScrt,
+		AuthenticationMethod1CodeSnct,
+		AuthenticationMethod1CodeScnl:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the CSCManagement1Code codes.
+func (c CSCManagement1Code) IsValid() bool {
+	switch c {
+	case CSCManagement1CodePrst,
+		CSCManagement1CodeByps,
+		CSCManagement1CodeUnrd,
+		CSCManagement1CodeNcsc:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the CardDataReading1Code codes.
+func (c CardDataReading1Code) IsValid() bool {
+	switch c {
+	case CardDataReading1CodeTagc,
+		CardDataReading1CodePhys,
+		CardDataReading1CodeBrcd,
+		CardDataReading1CodeMgst,
+		CardDataReading1CodeCicc,
+		CardDataReading1CodeDfle,
+		CardDataReading1CodeCtls,
+		CardDataReading1CodeEctl:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the CardPaymentServiceType2Code codes.
+func (c CardPaymentServiceType2Code) IsValid() bool {
+	switch c {
+	case CardPaymentServiceType2CodeAggr,
+		CardPaymentServiceType2CodeDccv,
+		CardPaymentServiceType2CodeGrtt,
+		CardPaymentServiceType2CodeInsp,
+		CardPaymentServiceType2CodeLoyt,
+		CardPaymentServiceType2CodeNres,
+		CardPaymentServiceType2CodePuco,
+		CardPaymentServiceType2CodeRecp,
+		CardPaymentServiceType2CodeSoaf,
+		CardPaymentServiceType2CodeUnaf,
+		CardPaymentServiceType2CodeVcau:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the CardholderVerificationCapability1Code codes.
+func (c CardholderVerificationCapability1Code) IsValid() bool {
+	switch c {
+	case CardholderVerificationCapability1CodeMnsg,
+		CardholderVerificationCapability1CodeNpin,
+		CardholderVerificationCapability1CodeFcpn,
+		CardholderVerificationCapability1CodeFepn,
+		CardholderVerificationCapability1CodeFdsg,
+		CardholderVerificationCapability1CodeFbio,
+		CardholderVerificationCapability1CodeMnvr,
+		CardholderVerificationCapability1CodeFbig,
+		CardholderVerificationCapability1CodeApki,
+		CardholderVerificationCapability1CodePkis,
+		CardholderVerificationCapability1CodeChdt,
+		CardholderVerificationCapability1CodeScec:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the ChargeBearerType1Code codes.
+func (c ChargeBearerType1Code) IsValid() bool {
+	switch c {
+	case ChargeBearerType1CodeDebt,
+		ChargeBearerType1CodeCred,
+		ChargeBearerType1CodeShar,
+		ChargeBearerType1CodeSlev:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the CopyDuplicate1Code codes.
+func (c CopyDuplicate1Code) IsValid() bool {
+	switch c {
+	case CopyDuplicate1CodeCodu,
+		CopyDuplicate1CodeCopy,
+		CopyDuplicate1CodeDupl:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the CreditDebitCode codes.
+func (c CreditDebitCode) IsValid() bool {
+	switch c {
+	case CreditDebitCodeCrdt,
+		CreditDebitCodeDbit:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the DocumentType3Code codes.
+func (c DocumentType3Code) IsValid() bool {
+	switch c {
+	case DocumentType3CodeRadm,
+		DocumentType3CodeRpin,
+		DocumentType3CodeFxdr,
+		DocumentType3CodeDisp,
+		DocumentType3CodePuor,
+		DocumentType3CodeScor:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the DocumentType6Code codes.
+func (c DocumentType6Code) IsValid() bool {
+	switch c {
+	case DocumentType6CodeMsin,
+		DocumentType6CodeCnfa,
+		DocumentType6CodeDnfa,
+		DocumentType6CodeCinv,
+		DocumentType6CodeCren,
+		DocumentType6CodeDebn,
+		DocumentType6CodeHiri,
+		DocumentType6CodeSbin,
+		DocumentType6CodeCmcn,
+		DocumentType6CodeSoac,
+		DocumentType6CodeDisp,
+		DocumentType6CodeBold,
+		DocumentType6CodeVchr,
+		DocumentType6CodeAroi,
+		DocumentType6CodeTsut,
+		DocumentType6CodePuor:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the InterestType1Code codes.
+func (c InterestType1Code) IsValid() bool {
+	switch c {
+	case InterestType1CodeIndy,
+		InterestType1CodeOvrn:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the NamePrefix2Code codes.
+func (c NamePrefix2Code) IsValid() bool {
+	switch c {
+	case NamePrefix2CodeDoct,
+		NamePrefix2CodeMadm,
+		NamePrefix2CodeMiss,
+		NamePrefix2CodeMist,
+		NamePrefix2CodeMiks:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the OnLineCapability1Code codes.
+func (c OnLineCapability1Code) IsValid() bool {
+	switch c {
+	case OnLineCapability1CodeOfln,
+		OnLineCapability1CodeOnln,
+		OnLineCapability1CodeSmon:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the POIComponentType1Code codes.
+func (c POIComponentType1Code) IsValid() bool {
+	switch c {
+	case POIComponentType1CodeSoft,
+		POIComponentType1CodeEmvk,
+		POIComponentType1CodeEmvo,
+		POIComponentType1CodeMrit,
+		POIComponentType1CodeChit,
+		POIComponentType1CodeSecm,
+		POIComponentType1CodePedv:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the PartyType3Code codes.
+func (c PartyType3Code) IsValid() bool {
+	switch c {
+	case PartyType3CodeOpoi,
+		PartyType3CodeMerc,
+		PartyType3CodeAccp,
+		PartyType3CodeItag,
+		PartyType3CodeAcqr,
+		PartyType3CodeCiss,
+		PartyType3CodeDlis:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the PartyType4Code codes.
+func (c PartyType4Code) IsValid() bool {
+	switch c {
+	case PartyType4CodeMerc,
+		PartyType4CodeAccp,
+		PartyType4CodeItag,
+		PartyType4CodeAcqr,
+		PartyType4CodeCiss,
+		PartyType4CodeTaxh:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the PreferredContactMethod1Code codes.
+func (c PreferredContactMethod1Code) IsValid() bool {
+	switch c {
+	case PreferredContactMethod1CodeLett,
+		PreferredContactMethod1CodeMail,
+		PreferredContactMethod1CodePhon,
+		PreferredContactMethod1CodeFaxx,
+		PreferredContactMethod1CodeCell:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the PriceValueType1Code codes.
+func (c PriceValueType1Code) IsValid() bool {
+	switch c {
+	case PriceValueType1CodeDisc,
+		PriceValueType1CodePrem,
+		PriceValueType1CodeParv:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the RemittanceLocationMethod2Code codes.
+func (c RemittanceLocationMethod2Code) IsValid() bool {
+	switch c {
+	case RemittanceLocationMethod2CodeFaxi,
+		RemittanceLocationMethod2CodeEdic,
+		RemittanceLocationMethod2CodeUrid,
+		RemittanceLocationMethod2CodeEmal,
+		RemittanceLocationMethod2CodePost,
+		RemittanceLocationMethod2CodeSmsm:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the TaxRecordPeriod1Code codes.
+func (c TaxRecordPeriod1Code) IsValid() bool {
+	switch c {
+	case TaxRecordPeriod1CodeMm01,
+		TaxRecordPeriod1CodeMm02,
+		TaxRecordPeriod1CodeMm03,
+		TaxRecordPeriod1CodeMm04,
+		TaxRecordPeriod1CodeMm05,
+		TaxRecordPeriod1CodeMm06,
+		TaxRecordPeriod1CodeMm07,
+		TaxRecordPeriod1CodeMm08,
+		TaxRecordPeriod1CodeMm09,
+		TaxRecordPeriod1CodeMm10,
+		TaxRecordPeriod1CodeMm11,
+		TaxRecordPeriod1CodeMm12,
+		TaxRecordPeriod1CodeQtr1,
+		TaxRecordPeriod1CodeQtr2,
+		TaxRecordPeriod1CodeQtr3,
+		TaxRecordPeriod1CodeQtr4,
+		TaxRecordPeriod1CodeHlf1,
+		TaxRecordPeriod1CodeHlf2:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the TransactionChannel1Code codes.
+func (c TransactionChannel1Code) IsValid() bool {
+	switch c {
+	case TransactionChannel1CodeMail,
+		TransactionChannel1CodeTlph,
+		TransactionChannel1CodeEcom,
+		TransactionChannel1CodeTvpy:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the TransactionEnvironment1Code codes.
+func (c TransactionEnvironment1Code) IsValid() bool {
+	switch c {
+	case TransactionEnvironment1CodeMerc,
+		TransactionEnvironment1CodePriv,
+		TransactionEnvironment1CodePubl:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the UnitOfMeasure1Code codes.
+func (c UnitOfMeasure1Code) IsValid() bool {
+	switch c {
+	case UnitOfMeasure1CodePiec,
+		UnitOfMeasure1CodeTons,
+		UnitOfMeasure1CodeFoot,
+		UnitOfMeasure1CodeGbga,
+		UnitOfMeasure1CodeUsga,
+		UnitOfMeasure1CodeGram,
+		UnitOfMeasure1CodeInch,
+		UnitOfMeasure1CodeKilo,
+		UnitOfMeasure1CodePund,
+		UnitOfMeasure1CodeMetr,
+		UnitOfMeasure1CodeCmet,
+		UnitOfMeasure1CodeMmet,
+		UnitOfMeasure1CodeLitr,
+		UnitOfMeasure1CodeCeli,
+		UnitOfMeasure1CodeMili,
+		UnitOfMeasure1CodeGbou,
+		UnitOfMeasure1CodeUsou,
+		UnitOfMeasure1CodeGbqa,
+		UnitOfMeasure1CodeUsqa,
+		UnitOfMeasure1CodeGbpi,
+		UnitOfMeasure1CodeUspi,
+		UnitOfMeasure1CodeMile,
+		UnitOfMeasure1CodeKmet,
+		UnitOfMeasure1CodeYard,
+		UnitOfMeasure1CodeSqki,
+		UnitOfMeasure1CodeHect,
+		UnitOfMeasure1CodeAres,
+		UnitOfMeasure1CodeSmet,
+		UnitOfMeasure1CodeScmt,
+		UnitOfMeasure1CodeSmil,
+		UnitOfMeasure1CodeSqmi,
+		UnitOfMeasure1CodeSqya,
+		UnitOfMeasure1CodeSqfo,
+		UnitOfMeasure1CodeSqin,
+		UnitOfMeasure1CodeAcre:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the UserInterface2Code codes.
+func (c UserInterface2Code) IsValid() bool {
+	switch c {
+	case UserInterface2CodeMdsp,
+		UserInterface2CodeCdsp:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,3555 @@
+// Code generated by scripts/gen_validate.go; DO NOT EDIT.
+
+package camt_054_001_08
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+var (
+	patternActiveCurrencyCode           = regexp.MustCompile(`^(?:[A-Z]{3,3})$`)
+	patternActiveOrHistoricCurrencyCode = regexp.MustCompile(`^(?:[A-Z]{3,3})$`)
+	patternAnyBICDec2014Identifier      = regexp.MustCompile(`^(?:[A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1})$`)
+	patternBICFIDec2014Identifier       = regexp.MustCompile(`^(?:[A-Z0-9]{4,4}[A-Z]{2,2}[A-Z0-9]{2,2}([A-Z0-9]{3,3}){0,1})$`)
+	patternCountryCode                  = regexp.MustCompile(`^(?:[A-Z]{2,2})$`)
+	patternExact1NumericText            = regexp.MustCompile(`^(?:[0-9])$`)
+	patternExact3NumericText            = regexp.MustCompile(`^(?:[0-9]{3})$`)
+	patternExact4AlphaNumericText       = regexp.MustCompile(`^(?:[a-zA-Z0-9]{4})$`)
+	patternIBAN2007Identifier           = regexp.MustCompile(`^(?:[A-Z]{2,2}[0-9]{2,2}[a-zA-Z0-9]{1,30})$`)
+	patternISINOct2015Identifier        = regexp.MustCompile(`^(?:[A-Z]{2,2}[A-Z0-9]{9,9}[0-9]{1,1})$`)
+	patternISO2ALanguageCode            = regexp.MustCompile(`^(?:[a-z]{2,2})$`)
+	patternLEIIdentifier                = regexp.MustCompile(`^(?:[A-Z0-9]{18,18}[0-9]{2,2})$`)
+	patternMax15NumericText             = regexp.MustCompile(`^(?:[0-9]{1,15})$`)
+	patternMax15PlusSignedNumericText   = regexp.MustCompile(`^(?:[\+]{0,1}[0-9]{1,15})$`)
+	patternMax3NumericText              = regexp.MustCompile(`^(?:[0-9]{1,3})$`)
+	patternMax5NumericText              = regexp.MustCompile(`^(?:[0-9]{1,5})$`)
+	patternMin2Max3NumericText          = regexp.MustCompile(`^(?:[0-9]{2,3})$`)
+	patternMin3Max4NumericText          = regexp.MustCompile(`^(?:[0-9]{3,4})$`)
+	patternMin8Max28NumericText         = regexp.MustCompile(`^(?:[0-9]{8,28})$`)
+	patternPhoneNumber                  = regexp.MustCompile(`^(?:\+[0-9]{1,3}-[0-9()+\-]{1,30})$`)
+	patternUUIDv4Identifier             = regexp.MustCompile(`^(?:[a-f0-9]{8}-[a-f0-9]{4}-4[a-f0-9]{3}-[89ab][a-f0-9]{3}-[a-f0-9]{12})$`)
+)
+
+// Validate checks Document and its children against the schema.
+func (v Document) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("BkToCstmrDbtCdtNtfctn", v.BkToCstmrDbtCdtNtfctn.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks AccountIdentification4Choice and its children against the schema.
+func (v AccountIdentification4Choice) Validate() error {
+	var errs []error
+	if v.IBAN != nil {
+		errs = append(errs, common.PrefixError("IBAN", v.IBAN.Validate()))
+	}
+	if v.Othr != nil {
+		errs = append(errs, common.PrefixError("Othr", v.Othr.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"IBAN", "Othr"}, []bool{v.IBAN != nil, v.Othr != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks AccountInterest4 and its children against the schema.
+func (v AccountInterest4) Validate() error {
+	var errs []error
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Rate", common.CheckOccurs(len(v.Rate), 0, -1)))
+	for i := range v.Rate {
+		errs = append(errs, common.PrefixError("Rate"+"["+strconv.Itoa(i)+"]", v.Rate[i].Validate()))
+	}
+	if v.FrToDt != nil {
+		errs = append(errs, common.PrefixError("FrToDt", v.FrToDt.Validate()))
+	}
+	if v.Rsn != nil {
+		errs = append(errs, common.PrefixError("Rsn", v.Rsn.Validate()))
+	}
+	if v.Tax != nil {
+		errs = append(errs, common.PrefixError("Tax", v.Tax.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks AccountNotification17 and its children against the schema.
+func (v AccountNotification17) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.NtfctnPgntn != nil {
+		errs = append(errs, common.PrefixError("NtfctnPgntn", v.NtfctnPgntn.Validate()))
+	}
+	if v.ElctrncSeqNb != nil {
+		errs = append(errs, common.PrefixError("ElctrncSeqNb", v.ElctrncSeqNb.Validate()))
+	}
+	if v.RptgSeq != nil {
+		errs = append(errs, common.PrefixError("RptgSeq", v.RptgSeq.Validate()))
+	}
+	if v.LglSeqNb != nil {
+		errs = append(errs, common.PrefixError("LglSeqNb", v.LglSeqNb.Validate()))
+	}
+	if v.CreDtTm != nil {
+		errs = append(errs, common.PrefixError("CreDtTm", v.CreDtTm.Validate()))
+	}
+	if v.FrToDt != nil {
+		errs = append(errs, common.PrefixError("FrToDt", v.FrToDt.Validate()))
+	}
+	if v.CpyDplctInd != nil {
+		errs = append(errs, common.PrefixError("CpyDplctInd", v.CpyDplctInd.Validate()))
+	}
+	if v.RptgSrc != nil {
+		errs = append(errs, common.PrefixError("RptgSrc", v.RptgSrc.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Acct", v.Acct.Validate()))
+	if v.RltdAcct != nil {
+		errs = append(errs, common.PrefixError("RltdAcct", v.RltdAcct.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Intrst", common.CheckOccurs(len(v.Intrst), 0, -1)))
+	for i := range v.Intrst {
+		errs = append(errs, common.PrefixError("Intrst"+"["+strconv.Itoa(i)+"]", v.Intrst[i].Validate()))
+	}
+	if v.TxsSummry != nil {
+		errs = append(errs, common.PrefixError("TxsSummry", v.TxsSummry.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Ntry", common.CheckOccurs(len(v.Ntry), 0, -1)))
+	for i := range v.Ntry {
+		errs = append(errs, common.PrefixError("Ntry"+"["+strconv.Itoa(i)+"]", v.Ntry[i].Validate()))
+	}
+	if v.AddtlNtfctnInf != nil {
+		errs = append(errs, common.PrefixError("AddtlNtfctnInf", v.AddtlNtfctnInf.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks AccountSchemeName1Choice and its children against the schema.
+func (v AccountSchemeName1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks ActiveCurrencyAndAmount and its children against the schema.
+func (v ActiveCurrencyAndAmount) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("@Ccy", v.Ccy.Validate()))
+	if err := common.CheckDecimal(v.Text, 18, 5, "0"); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks ActiveOrHistoricCurrencyAnd13DecimalAmount and its children against the schema.
+func (v ActiveOrHistoricCurrencyAnd13DecimalAmount) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("@Ccy", v.Ccy.Validate()))
+	if err := common.CheckDecimal(v.Text, 18, 13, "0"); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks ActiveOrHistoricCurrencyAndAmount and its children against the schema.
+func (v ActiveOrHistoricCurrencyAndAmount) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("@Ccy", v.Ccy.Validate()))
+	if err := common.CheckDecimal(v.Text, 18, 5, "0"); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks ActiveOrHistoricCurrencyAndAmountRange2 and its children against the schema.
+func (v ActiveOrHistoricCurrencyAndAmountRange2) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	if v.CdtDbtInd != nil {
+		errs = append(errs, common.PrefixError("CdtDbtInd", v.CdtDbtInd.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Ccy", v.Ccy.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks AddressType3Choice and its children against the schema.
+func (v AddressType3Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks AmountAndCurrencyExchange3 and its children against the schema.
+func (v AmountAndCurrencyExchange3) Validate() error {
+	var errs []error
+	if v.InstdAmt != nil {
+		errs = append(errs, common.PrefixError("InstdAmt", v.InstdAmt.Validate()))
+	}
+	if v.TxAmt != nil {
+		errs = append(errs, common.PrefixError("TxAmt", v.TxAmt.Validate()))
+	}
+	if v.CntrValAmt != nil {
+		errs = append(errs, common.PrefixError("CntrValAmt", v.CntrValAmt.Validate()))
+	}
+	if v.AnncdPstngAmt != nil {
+		errs = append(errs, common.PrefixError("AnncdPstngAmt", v.AnncdPstngAmt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("PrtryAmt", common.CheckOccurs(len(v.PrtryAmt), 0, -1)))
+	for i := range v.PrtryAmt {
+		errs = append(errs, common.PrefixError("PrtryAmt"+"["+strconv.Itoa(i)+"]", v.PrtryAmt[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks AmountAndCurrencyExchangeDetails3 and its children against the schema.
+func (v AmountAndCurrencyExchangeDetails3) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	if v.CcyXchg != nil {
+		errs = append(errs, common.PrefixError("CcyXchg", v.CcyXchg.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks AmountAndCurrencyExchangeDetails4 and its children against the schema.
+func (v AmountAndCurrencyExchangeDetails4) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	if v.CcyXchg != nil {
+		errs = append(errs, common.PrefixError("CcyXchg", v.CcyXchg.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks AmountAndDirection35 and its children against the schema.
+func (v AmountAndDirection35) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	errs = append(errs, common.PrefixError("CdtDbtInd", v.CdtDbtInd.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks AmountRangeBoundary1 and its children against the schema.
+func (v AmountRangeBoundary1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("BdryAmt", v.BdryAmt.Validate()))
+	errs = append(errs, common.PrefixError("Incl", v.Incl.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks BankToCustomerDebitCreditNotificationV08 and its children against the schema.
+func (v BankToCustomerDebitCreditNotificationV08) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("GrpHdr", v.GrpHdr.Validate()))
+	errs = append(errs, common.PrefixError("Ntfctn", common.CheckOccurs(len(v.Ntfctn), 1, -1)))
+	for i := range v.Ntfctn {
+		errs = append(errs, common.PrefixError("Ntfctn"+"["+strconv.Itoa(i)+"]", v.Ntfctn[i].Validate()))
+	}
+	errs = append(errs, common.PrefixError("SplmtryData", common.CheckOccurs(len(v.SplmtryData), 0, -1)))
+	for i := range v.SplmtryData {
+		errs = append(errs, common.PrefixError("SplmtryData"+"["+strconv.Itoa(i)+"]", v.SplmtryData[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks BankTransactionCodeStructure4 and its children against the schema.
+func (v BankTransactionCodeStructure4) Validate() error {
+	var errs []error
+	if v.Domn != nil {
+		errs = append(errs, common.PrefixError("Domn", v.Domn.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks BankTransactionCodeStructure5 and its children against the schema.
+func (v BankTransactionCodeStructure5) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	errs = append(errs, common.PrefixError("Fmly", v.Fmly.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks BankTransactionCodeStructure6 and its children against the schema.
+func (v BankTransactionCodeStructure6) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	errs = append(errs, common.PrefixError("SubFmlyCd", v.SubFmlyCd.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks BatchInformation2 and its children against the schema.
+func (v BatchInformation2) Validate() error {
+	var errs []error
+	if v.MsgId != nil {
+		errs = append(errs, common.PrefixError("MsgId", v.MsgId.Validate()))
+	}
+	if v.PmtInfId != nil {
+		errs = append(errs, common.PrefixError("PmtInfId", v.PmtInfId.Validate()))
+	}
+	if v.NbOfTxs != nil {
+		errs = append(errs, common.PrefixError("NbOfTxs", v.NbOfTxs.Validate()))
+	}
+	if v.TtlAmt != nil {
+		errs = append(errs, common.PrefixError("TtlAmt", v.TtlAmt.Validate()))
+	}
+	if v.CdtDbtInd != nil {
+		errs = append(errs, common.PrefixError("CdtDbtInd", v.CdtDbtInd.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks BranchAndFinancialInstitutionIdentification6 and its children against the schema.
+func (v BranchAndFinancialInstitutionIdentification6) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("FinInstnId", v.FinInstnId.Validate()))
+	if v.BrnchId != nil {
+		errs = append(errs, common.PrefixError("BrnchId", v.BrnchId.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks BranchData3 and its children against the schema.
+func (v BranchData3) Validate() error {
+	var errs []error
+	if v.Id != nil {
+		errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	}
+	if v.LEI != nil {
+		errs = append(errs, common.PrefixError("LEI", v.LEI.Validate()))
+	}
+	if v.Nm != nil {
+		errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	}
+	if v.PstlAdr != nil {
+		errs = append(errs, common.PrefixError("PstlAdr", v.PstlAdr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks CardAggregated2 and its children against the schema.
+func (v CardAggregated2) Validate() error {
+	var errs []error
+	if v.AddtlSvc != nil {
+		errs = append(errs, common.PrefixError("AddtlSvc", v.AddtlSvc.Validate()))
+	}
+	if v.TxCtgy != nil {
+		errs = append(errs, common.PrefixError("TxCtgy", v.TxCtgy.Validate()))
+	}
+	if v.SaleRcncltnId != nil {
+		errs = append(errs, common.PrefixError("SaleRcncltnId", v.SaleRcncltnId.Validate()))
+	}
+	if v.SeqNbRg != nil {
+		errs = append(errs, common.PrefixError("SeqNbRg", v.SeqNbRg.Validate()))
+	}
+	if v.TxDtRg != nil {
+		errs = append(errs, common.PrefixError("TxDtRg", v.TxDtRg.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks CardEntry4 and its children against the schema.
+func (v CardEntry4) Validate() error {
+	var errs []error
+	if v.Card != nil {
+		errs = append(errs, common.PrefixError("Card", v.Card.Validate()))
+	}
+	if v.POI != nil {
+		errs = append(errs, common.PrefixError("POI", v.POI.Validate()))
+	}
+	if v.AggtdNtry != nil {
+		errs = append(errs, common.PrefixError("AggtdNtry", v.AggtdNtry.Validate()))
+	}
+	if v.PrePdAcct != nil {
+		errs = append(errs, common.PrefixError("PrePdAcct", v.PrePdAcct.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks CardIndividualTransaction2 and its children against the schema.
+func (v CardIndividualTransaction2) Validate() error {
+	var errs []error
+	if v.ICCRltdData != nil {
+		errs = append(errs, common.PrefixError("ICCRltdData", v.ICCRltdData.Validate()))
+	}
+	if v.PmtCntxt != nil {
+		errs = append(errs, common.PrefixError("PmtCntxt", v.PmtCntxt.Validate()))
+	}
+	if v.AddtlSvc != nil {
+		errs = append(errs, common.PrefixError("AddtlSvc", v.AddtlSvc.Validate()))
+	}
+	if v.TxCtgy != nil {
+		errs = append(errs, common.PrefixError("TxCtgy", v.TxCtgy.Validate()))
+	}
+	if v.SaleRcncltnId != nil {
+		errs = append(errs, common.PrefixError("SaleRcncltnId", v.SaleRcncltnId.Validate()))
+	}
+	if v.SaleRefNb != nil {
+		errs = append(errs, common.PrefixError("SaleRefNb", v.SaleRefNb.Validate()))
+	}
+	if v.RePresntmntRsn != nil {
+		errs = append(errs, common.PrefixError("RePresntmntRsn", v.RePresntmntRsn.Validate()))
+	}
+	if v.SeqNb != nil {
+		errs = append(errs, common.PrefixError("SeqNb", v.SeqNb.Validate()))
+	}
+	if v.TxId != nil {
+		errs = append(errs, common.PrefixError("TxId", v.TxId.Validate()))
+	}
+	if v.Pdct != nil {
+		errs = append(errs, common.PrefixError("Pdct", v.Pdct.Validate()))
+	}
+	if v.VldtnDt != nil {
+		errs = append(errs, common.PrefixError("VldtnDt", v.VldtnDt.Validate()))
+	}
+	if v.VldtnSeqNb != nil {
+		errs = append(errs, common.PrefixError("VldtnSeqNb", v.VldtnSeqNb.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks CardSecurityInformation1 and its children against the schema.
+func (v CardSecurityInformation1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("CSCMgmt", v.CSCMgmt.Validate()))
+	if v.CSCVal != nil {
+		errs = append(errs, common.PrefixError("CSCVal", v.CSCVal.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks CardSequenceNumberRange1 and its children against the schema.
+func (v CardSequenceNumberRange1) Validate() error {
+	var errs []error
+	if v.FrstTx != nil {
+		errs = append(errs, common.PrefixError("FrstTx", v.FrstTx.Validate()))
+	}
+	if v.LastTx != nil {
+		errs = append(errs, common.PrefixError("LastTx", v.LastTx.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks CardTransaction17 and its children against the schema.
+func (v CardTransaction17) Validate() error {
+	var errs []error
+	if v.Card != nil {
+		errs = append(errs, common.PrefixError("Card", v.Card.Validate()))
+	}
+	if v.POI != nil {
+		errs = append(errs, common.PrefixError("POI", v.POI.Validate()))
+	}
+	if v.Tx != nil {
+		errs = append(errs, common.PrefixError("Tx", v.Tx.Validate()))
+	}
+	if v.PrePdAcct != nil {
+		errs = append(errs, common.PrefixError("PrePdAcct", v.PrePdAcct.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks CardTransaction3Choice and its children against the schema.
+func (v CardTransaction3Choice) Validate() error {
+	var errs []error
+	if v.Aggtd != nil {
+		errs = append(errs, common.PrefixError("Aggtd", v.Aggtd.Validate()))
+	}
+	if v.Indv != nil {
+		errs = append(errs, common.PrefixError("Indv", v.Indv.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Aggtd", "Indv"}, []bool{v.Aggtd != nil, v.Indv != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks CardholderAuthentication2 and its children against the schema.
+func (v CardholderAuthentication2) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("AuthntcnMtd", v.AuthntcnMtd.Validate()))
+	errs = append(errs, common.PrefixError("AuthntcnNtty", v.AuthntcnNtty.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks CashAccount38 and its children against the schema.
+func (v CashAccount38) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.Ccy != nil {
+		errs = append(errs, common.PrefixError("Ccy", v.Ccy.Validate()))
+	}
+	if v.Nm != nil {
+		errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	}
+	if v.Prxy != nil {
+		errs = append(errs, common.PrefixError("Prxy", v.Prxy.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks CashAccount39 and its children against the schema.
+func (v CashAccount39) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.Ccy != nil {
+		errs = append(errs, common.PrefixError("Ccy", v.Ccy.Validate()))
+	}
+	if v.Nm != nil {
+		errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	}
+	if v.Prxy != nil {
+		errs = append(errs, common.PrefixError("Prxy", v.Prxy.Validate()))
+	}
+	if v.Ownr != nil {
+		errs = append(errs, common.PrefixError("Ownr", v.Ownr.Validate()))
+	}
+	if v.Svcr != nil {
+		errs = append(errs, common.PrefixError("Svcr", v.Svcr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks CashAccountType2Choice and its children against the schema.
+func (v CashAccountType2Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks CashAvailability1 and its children against the schema.
+func (v CashAvailability1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Dt", v.Dt.Validate()))
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	errs = append(errs, common.PrefixError("CdtDbtInd", v.CdtDbtInd.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks CashAvailabilityDate1Choice and its children against the schema.
+func (v CashAvailabilityDate1Choice) Validate() error {
+	var errs []error
+	if v.NbOfDays != nil {
+		errs = append(errs, common.PrefixError("NbOfDays", v.NbOfDays.Validate()))
+	}
+	if v.ActlDt != nil {
+		errs = append(errs, common.PrefixError("ActlDt", v.ActlDt.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"NbOfDays", "ActlDt"}, []bool{v.NbOfDays != nil, v.ActlDt != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks CashDeposit1 and its children against the schema.
+func (v CashDeposit1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("NoteDnmtn", v.NoteDnmtn.Validate()))
+	errs = append(errs, common.PrefixError("NbOfNotes", v.NbOfNotes.Validate()))
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks ChargeType3Choice and its children against the schema.
+func (v ChargeType3Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks Charges6 and its children against the schema.
+func (v Charges6) Validate() error {
+	var errs []error
+	if v.TtlChrgsAndTaxAmt != nil {
+		errs = append(errs, common.PrefixError("TtlChrgsAndTaxAmt", v.TtlChrgsAndTaxAmt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Rcrd", common.CheckOccurs(len(v.Rcrd), 0, -1)))
+	for i := range v.Rcrd {
+		errs = append(errs, common.PrefixError("Rcrd"+"["+strconv.Itoa(i)+"]", v.Rcrd[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks ChargesRecord3 and its children against the schema.
+func (v ChargesRecord3) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	if v.CdtDbtInd != nil {
+		errs = append(errs, common.PrefixError("CdtDbtInd", v.CdtDbtInd.Validate()))
+	}
+	if v.ChrgInclInd != nil {
+		errs = append(errs, common.PrefixError("ChrgInclInd", v.ChrgInclInd.Validate()))
+	}
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.Rate != nil {
+		errs = append(errs, common.PrefixError("Rate", v.Rate.Validate()))
+	}
+	if v.Br != nil {
+		errs = append(errs, common.PrefixError("Br", v.Br.Validate()))
+	}
+	if v.Agt != nil {
+		errs = append(errs, common.PrefixError("Agt", v.Agt.Validate()))
+	}
+	if v.Tax != nil {
+		errs = append(errs, common.PrefixError("Tax", v.Tax.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks ClearingSystemIdentification2Choice and its children against the schema.
+func (v ClearingSystemIdentification2Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks ClearingSystemMemberIdentification2 and its children against the schema.
+func (v ClearingSystemMemberIdentification2) Validate() error {
+	var errs []error
+	if v.ClrSysId != nil {
+		errs = append(errs, common.PrefixError("ClrSysId", v.ClrSysId.Validate()))
+	}
+	errs = append(errs, common.PrefixError("MmbId", v.MmbId.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks Contact4 and its children against the schema.
+func (v Contact4) Validate() error {
+	var errs []error
+	if v.NmPrfx != nil {
+		errs = append(errs, common.PrefixError("NmPrfx", v.NmPrfx.Validate()))
+	}
+	if v.Nm != nil {
+		errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	}
+	if v.PhneNb != nil {
+		errs = append(errs, common.PrefixError("PhneNb", v.PhneNb.Validate()))
+	}
+	if v.MobNb != nil {
+		errs = append(errs, common.PrefixError("MobNb", v.MobNb.Validate()))
+	}
+	if v.FaxNb != nil {
+		errs = append(errs, common.PrefixError("FaxNb", v.FaxNb.Validate()))
+	}
+	if v.EmailAdr != nil {
+		errs = append(errs, common.PrefixError("EmailAdr", v.EmailAdr.Validate()))
+	}
+	if v.EmailPurp != nil {
+		errs = append(errs, common.PrefixError("EmailPurp", v.EmailPurp.Validate()))
+	}
+	if v.JobTitl != nil {
+		errs = append(errs, common.PrefixError("JobTitl", v.JobTitl.Validate()))
+	}
+	if v.Rspnsblty != nil {
+		errs = append(errs, common.PrefixError("Rspnsblty", v.Rspnsblty.Validate()))
+	}
+	if v.Dept != nil {
+		errs = append(errs, common.PrefixError("Dept", v.Dept.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Othr", common.CheckOccurs(len(v.Othr), 0, -1)))
+	for i := range v.Othr {
+		errs = append(errs, common.PrefixError("Othr"+"["+strconv.Itoa(i)+"]", v.Othr[i].Validate()))
+	}
+	if v.PrefrdMtd != nil {
+		errs = append(errs, common.PrefixError("PrefrdMtd", v.PrefrdMtd.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks CorporateAction9 and its children against the schema.
+func (v CorporateAction9) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("EvtTp", v.EvtTp.Validate()))
+	errs = append(errs, common.PrefixError("EvtId", v.EvtId.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks CreditorReferenceInformation2 and its children against the schema.
+func (v CreditorReferenceInformation2) Validate() error {
+	var errs []error
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.Ref != nil {
+		errs = append(errs, common.PrefixError("Ref", v.Ref.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks CreditorReferenceType1Choice and its children against the schema.
+func (v CreditorReferenceType1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks CreditorReferenceType2 and its children against the schema.
+func (v CreditorReferenceType2) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("CdOrPrtry", v.CdOrPrtry.Validate()))
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks CurrencyExchange5 and its children against the schema.
+func (v CurrencyExchange5) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("SrcCcy", v.SrcCcy.Validate()))
+	if v.TrgtCcy != nil {
+		errs = append(errs, common.PrefixError("TrgtCcy", v.TrgtCcy.Validate()))
+	}
+	if v.UnitCcy != nil {
+		errs = append(errs, common.PrefixError("UnitCcy", v.UnitCcy.Validate()))
+	}
+	errs = append(errs, common.PrefixError("XchgRate", v.XchgRate.Validate()))
+	if v.CtrctId != nil {
+		errs = append(errs, common.PrefixError("CtrctId", v.CtrctId.Validate()))
+	}
+	if v.QtnDt != nil {
+		errs = append(errs, common.PrefixError("QtnDt", v.QtnDt.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks DateAndDateTime2Choice and its children against the schema.
+func (v DateAndDateTime2Choice) Validate() error {
+	var errs []error
+	if v.Dt != nil {
+		errs = append(errs, common.PrefixError("Dt", v.Dt.Validate()))
+	}
+	if v.DtTm != nil {
+		errs = append(errs, common.PrefixError("DtTm", v.DtTm.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Dt", "DtTm"}, []bool{v.Dt != nil, v.DtTm != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks DateAndPlaceOfBirth1 and its children against the schema.
+func (v DateAndPlaceOfBirth1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("BirthDt", v.BirthDt.Validate()))
+	if v.PrvcOfBirth != nil {
+		errs = append(errs, common.PrefixError("PrvcOfBirth", v.PrvcOfBirth.Validate()))
+	}
+	errs = append(errs, common.PrefixError("CityOfBirth", v.CityOfBirth.Validate()))
+	errs = append(errs, common.PrefixError("CtryOfBirth", v.CtryOfBirth.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks DateOrDateTimePeriod1Choice and its children against the schema.
+func (v DateOrDateTimePeriod1Choice) Validate() error {
+	var errs []error
+	if v.Dt != nil {
+		errs = append(errs, common.PrefixError("Dt", v.Dt.Validate()))
+	}
+	if v.DtTm != nil {
+		errs = append(errs, common.PrefixError("DtTm", v.DtTm.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Dt", "DtTm"}, []bool{v.Dt != nil, v.DtTm != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks DatePeriod2 and its children against the schema.
+func (v DatePeriod2) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("FrDt", v.FrDt.Validate()))
+	errs = append(errs, common.PrefixError("ToDt", v.ToDt.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks DateTimePeriod1 and its children against the schema.
+func (v DateTimePeriod1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("FrDtTm", v.FrDtTm.Validate()))
+	errs = append(errs, common.PrefixError("ToDtTm", v.ToDtTm.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks DiscountAmountAndType1 and its children against the schema.
+func (v DiscountAmountAndType1) Validate() error {
+	var errs []error
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks DiscountAmountType1Choice and its children against the schema.
+func (v DiscountAmountType1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks DisplayCapabilities1 and its children against the schema.
+func (v DisplayCapabilities1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("DispTp", v.DispTp.Validate()))
+	errs = append(errs, common.PrefixError("NbOfLines", v.NbOfLines.Validate()))
+	errs = append(errs, common.PrefixError("LineWidth", v.LineWidth.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks DocumentAdjustment1 and its children against the schema.
+func (v DocumentAdjustment1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	if v.CdtDbtInd != nil {
+		errs = append(errs, common.PrefixError("CdtDbtInd", v.CdtDbtInd.Validate()))
+	}
+	if v.Rsn != nil {
+		errs = append(errs, common.PrefixError("Rsn", v.Rsn.Validate()))
+	}
+	if v.AddtlInf != nil {
+		errs = append(errs, common.PrefixError("AddtlInf", v.AddtlInf.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks DocumentLineIdentification1 and its children against the schema.
+func (v DocumentLineIdentification1) Validate() error {
+	var errs []error
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.Nb != nil {
+		errs = append(errs, common.PrefixError("Nb", v.Nb.Validate()))
+	}
+	if v.RltdDt != nil {
+		errs = append(errs, common.PrefixError("RltdDt", v.RltdDt.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks DocumentLineInformation1 and its children against the schema.
+func (v DocumentLineInformation1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", common.CheckOccurs(len(v.Id), 1, -1)))
+	for i := range v.Id {
+		errs = append(errs, common.PrefixError("Id"+"["+strconv.Itoa(i)+"]", v.Id[i].Validate()))
+	}
+	if v.Desc != nil {
+		errs = append(errs, common.PrefixError("Desc", v.Desc.Validate()))
+	}
+	if v.Amt != nil {
+		errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks DocumentLineType1 and its children against the schema.
+func (v DocumentLineType1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("CdOrPrtry", v.CdOrPrtry.Validate()))
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks DocumentLineType1Choice and its children against the schema.
+func (v DocumentLineType1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks EntryDetails9 and its children against the schema.
+func (v EntryDetails9) Validate() error {
+	var errs []error
+	if v.Btch != nil {
+		errs = append(errs, common.PrefixError("Btch", v.Btch.Validate()))
+	}
+	errs = append(errs, common.PrefixError("TxDtls", common.CheckOccurs(len(v.TxDtls), 0, -1)))
+	for i := range v.TxDtls {
+		errs = append(errs, common.PrefixError("TxDtls"+"["+strconv.Itoa(i)+"]", v.TxDtls[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks EntryStatus1Choice and its children against the schema.
+func (v EntryStatus1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks EntryTransaction10 and its children against the schema.
+func (v EntryTransaction10) Validate() error {
+	var errs []error
+	if v.Refs != nil {
+		errs = append(errs, common.PrefixError("Refs", v.Refs.Validate()))
+	}
+	if v.Amt != nil {
+		errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	}
+	if v.CdtDbtInd != nil {
+		errs = append(errs, common.PrefixError("CdtDbtInd", v.CdtDbtInd.Validate()))
+	}
+	if v.AmtDtls != nil {
+		errs = append(errs, common.PrefixError("AmtDtls", v.AmtDtls.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Avlbty", common.CheckOccurs(len(v.Avlbty), 0, -1)))
+	for i := range v.Avlbty {
+		errs = append(errs, common.PrefixError("Avlbty"+"["+strconv.Itoa(i)+"]", v.Avlbty[i].Validate()))
+	}
+	if v.BkTxCd != nil {
+		errs = append(errs, common.PrefixError("BkTxCd", v.BkTxCd.Validate()))
+	}
+	if v.Chrgs != nil {
+		errs = append(errs, common.PrefixError("Chrgs", v.Chrgs.Validate()))
+	}
+	if v.Intrst != nil {
+		errs = append(errs, common.PrefixError("Intrst", v.Intrst.Validate()))
+	}
+	if v.RltdPties != nil {
+		errs = append(errs, common.PrefixError("RltdPties", v.RltdPties.Validate()))
+	}
+	if v.RltdAgts != nil {
+		errs = append(errs, common.PrefixError("RltdAgts", v.RltdAgts.Validate()))
+	}
+	if v.LclInstrm != nil {
+		errs = append(errs, common.PrefixError("LclInstrm", v.LclInstrm.Validate()))
+	}
+	if v.Purp != nil {
+		errs = append(errs, common.PrefixError("Purp", v.Purp.Validate()))
+	}
+	errs = append(errs, common.PrefixError("RltdRmtInf", common.CheckOccurs(len(v.RltdRmtInf), 0, 10)))
+	for i := range v.RltdRmtInf {
+		errs = append(errs, common.PrefixError("RltdRmtInf"+"["+strconv.Itoa(i)+"]", v.RltdRmtInf[i].Validate()))
+	}
+	if v.RmtInf != nil {
+		errs = append(errs, common.PrefixError("RmtInf", v.RmtInf.Validate()))
+	}
+	if v.RltdDts != nil {
+		errs = append(errs, common.PrefixError("RltdDts", v.RltdDts.Validate()))
+	}
+	if v.RltdPric != nil {
+		errs = append(errs, common.PrefixError("RltdPric", v.RltdPric.Validate()))
+	}
+	errs = append(errs, common.PrefixError("RltdQties", common.CheckOccurs(len(v.RltdQties), 0, -1)))
+	for i := range v.RltdQties {
+		errs = append(errs, common.PrefixError("RltdQties"+"["+strconv.Itoa(i)+"]", v.RltdQties[i].Validate()))
+	}
+	if v.FinInstrmId != nil {
+		errs = append(errs, common.PrefixError("FinInstrmId", v.FinInstrmId.Validate()))
+	}
+	if v.Tax != nil {
+		errs = append(errs, common.PrefixError("Tax", v.Tax.Validate()))
+	}
+	if v.RtrInf != nil {
+		errs = append(errs, common.PrefixError("RtrInf", v.RtrInf.Validate()))
+	}
+	if v.CorpActn != nil {
+		errs = append(errs, common.PrefixError("CorpActn", v.CorpActn.Validate()))
+	}
+	if v.SfkpgAcct != nil {
+		errs = append(errs, common.PrefixError("SfkpgAcct", v.SfkpgAcct.Validate()))
+	}
+	errs = append(errs, common.PrefixError("CshDpst", common.CheckOccurs(len(v.CshDpst), 0, -1)))
+	for i := range v.CshDpst {
+		errs = append(errs, common.PrefixError("CshDpst"+"["+strconv.Itoa(i)+"]", v.CshDpst[i].Validate()))
+	}
+	if v.CardTx != nil {
+		errs = append(errs, common.PrefixError("CardTx", v.CardTx.Validate()))
+	}
+	if v.AddtlTxInf != nil {
+		errs = append(errs, common.PrefixError("AddtlTxInf", v.AddtlTxInf.Validate()))
+	}
+	errs = append(errs, common.PrefixError("SplmtryData", common.CheckOccurs(len(v.SplmtryData), 0, -1)))
+	for i := range v.SplmtryData {
+		errs = append(errs, common.PrefixError("SplmtryData"+"["+strconv.Itoa(i)+"]", v.SplmtryData[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks FinancialIdentificationSchemeName1Choice and its children against the schema.
+func (v FinancialIdentificationSchemeName1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks FinancialInstitutionIdentification18 and its children against the schema.
+func (v FinancialInstitutionIdentification18) Validate() error {
+	var errs []error
+	if v.BICFI != nil {
+		errs = append(errs, common.PrefixError("BICFI", v.BICFI.Validate()))
+	}
+	if v.ClrSysMmbId != nil {
+		errs = append(errs, common.PrefixError("ClrSysMmbId", v.ClrSysMmbId.Validate()))
+	}
+	if v.LEI != nil {
+		errs = append(errs, common.PrefixError("LEI", v.LEI.Validate()))
+	}
+	if v.Nm != nil {
+		errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	}
+	if v.PstlAdr != nil {
+		errs = append(errs, common.PrefixError("PstlAdr", v.PstlAdr.Validate()))
+	}
+	if v.Othr != nil {
+		errs = append(errs, common.PrefixError("Othr", v.Othr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks FinancialInstrumentQuantity1Choice and its children against the schema.
+func (v FinancialInstrumentQuantity1Choice) Validate() error {
+	var errs []error
+	if v.Unit != nil {
+		errs = append(errs, common.PrefixError("Unit", v.Unit.Validate()))
+	}
+	if v.FaceAmt != nil {
+		errs = append(errs, common.PrefixError("FaceAmt", v.FaceAmt.Validate()))
+	}
+	if v.AmtsdVal != nil {
+		errs = append(errs, common.PrefixError("AmtsdVal", v.AmtsdVal.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Unit", "FaceAmt", "AmtsdVal"}, []bool{v.Unit != nil, v.FaceAmt != nil, v.AmtsdVal != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks FromToAmountRange1 and its children against the schema.
+func (v FromToAmountRange1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("FrAmt", v.FrAmt.Validate()))
+	errs = append(errs, common.PrefixError("ToAmt", v.ToAmt.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks Garnishment3 and its children against the schema.
+func (v Garnishment3) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	if v.Grnshee != nil {
+		errs = append(errs, common.PrefixError("Grnshee", v.Grnshee.Validate()))
+	}
+	if v.GrnshmtAdmstr != nil {
+		errs = append(errs, common.PrefixError("GrnshmtAdmstr", v.GrnshmtAdmstr.Validate()))
+	}
+	if v.RefNb != nil {
+		errs = append(errs, common.PrefixError("RefNb", v.RefNb.Validate()))
+	}
+	if v.Dt != nil {
+		errs = append(errs, common.PrefixError("Dt", v.Dt.Validate()))
+	}
+	if v.RmtdAmt != nil {
+		errs = append(errs, common.PrefixError("RmtdAmt", v.RmtdAmt.Validate()))
+	}
+	if v.FmlyMdclInsrncInd != nil {
+		errs = append(errs, common.PrefixError("FmlyMdclInsrncInd", v.FmlyMdclInsrncInd.Validate()))
+	}
+	if v.MplyeeTermntnInd != nil {
+		errs = append(errs, common.PrefixError("MplyeeTermntnInd", v.MplyeeTermntnInd.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GarnishmentType1 and its children against the schema.
+func (v GarnishmentType1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("CdOrPrtry", v.CdOrPrtry.Validate()))
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GarnishmentType1Choice and its children against the schema.
+func (v GarnishmentType1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks GenericAccountIdentification1 and its children against the schema.
+func (v GenericAccountIdentification1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.SchmeNm != nil {
+		errs = append(errs, common.PrefixError("SchmeNm", v.SchmeNm.Validate()))
+	}
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GenericFinancialIdentification1 and its children against the schema.
+func (v GenericFinancialIdentification1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.SchmeNm != nil {
+		errs = append(errs, common.PrefixError("SchmeNm", v.SchmeNm.Validate()))
+	}
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GenericIdentification1 and its children against the schema.
+func (v GenericIdentification1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.SchmeNm != nil {
+		errs = append(errs, common.PrefixError("SchmeNm", v.SchmeNm.Validate()))
+	}
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GenericIdentification3 and its children against the schema.
+func (v GenericIdentification3) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GenericIdentification30 and its children against the schema.
+func (v GenericIdentification30) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	if v.SchmeNm != nil {
+		errs = append(errs, common.PrefixError("SchmeNm", v.SchmeNm.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GenericIdentification32 and its children against the schema.
+func (v GenericIdentification32) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	if v.ShrtNm != nil {
+		errs = append(errs, common.PrefixError("ShrtNm", v.ShrtNm.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GenericOrganisationIdentification1 and its children against the schema.
+func (v GenericOrganisationIdentification1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.SchmeNm != nil {
+		errs = append(errs, common.PrefixError("SchmeNm", v.SchmeNm.Validate()))
+	}
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GenericPersonIdentification1 and its children against the schema.
+func (v GenericPersonIdentification1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.SchmeNm != nil {
+		errs = append(errs, common.PrefixError("SchmeNm", v.SchmeNm.Validate()))
+	}
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks GroupHeader81 and its children against the schema.
+func (v GroupHeader81) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("MsgId", v.MsgId.Validate()))
+	errs = append(errs, common.PrefixError("CreDtTm", v.CreDtTm.Validate()))
+	if v.MsgRcpt != nil {
+		errs = append(errs, common.PrefixError("MsgRcpt", v.MsgRcpt.Validate()))
+	}
+	if v.MsgPgntn != nil {
+		errs = append(errs, common.PrefixError("MsgPgntn", v.MsgPgntn.Validate()))
+	}
+	if v.OrgnlBizQry != nil {
+		errs = append(errs, common.PrefixError("OrgnlBizQry", v.OrgnlBizQry.Validate()))
+	}
+	if v.AddtlInf != nil {
+		errs = append(errs, common.PrefixError("AddtlInf", v.AddtlInf.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks IdentificationSource3Choice and its children against the schema.
+func (v IdentificationSource3Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks ImpliedCurrencyAmountRange1Choice and its children against the schema.
+func (v ImpliedCurrencyAmountRange1Choice) Validate() error {
+	var errs []error
+	if v.FrAmt != nil {
+		errs = append(errs, common.PrefixError("FrAmt", v.FrAmt.Validate()))
+	}
+	if v.ToAmt != nil {
+		errs = append(errs, common.PrefixError("ToAmt", v.ToAmt.Validate()))
+	}
+	if v.FrToAmt != nil {
+		errs = append(errs, common.PrefixError("FrToAmt", v.FrToAmt.Validate()))
+	}
+	if v.EQAmt != nil {
+		errs = append(errs, common.PrefixError("EQAmt", v.EQAmt.Validate()))
+	}
+	if v.NEQAmt != nil {
+		errs = append(errs, common.PrefixError("NEQAmt", v.NEQAmt.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"FrAmt", "ToAmt", "FrToAmt", "EQAmt", "NEQAmt"}, []bool{v.FrAmt != nil, v.ToAmt != nil, v.FrToAmt != nil, v.EQAmt != nil, v.NEQAmt != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks InterestRecord2 and its children against the schema.
+func (v InterestRecord2) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	errs = append(errs, common.PrefixError("CdtDbtInd", v.CdtDbtInd.Validate()))
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.Rate != nil {
+		errs = append(errs, common.PrefixError("Rate", v.Rate.Validate()))
+	}
+	if v.FrToDt != nil {
+		errs = append(errs, common.PrefixError("FrToDt", v.FrToDt.Validate()))
+	}
+	if v.Rsn != nil {
+		errs = append(errs, common.PrefixError("Rsn", v.Rsn.Validate()))
+	}
+	if v.Tax != nil {
+		errs = append(errs, common.PrefixError("Tax", v.Tax.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks InterestType1Choice and its children against the schema.
+func (v InterestType1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks LocalInstrument2Choice and its children against the schema.
+func (v LocalInstrument2Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks MessageIdentification2 and its children against the schema.
+func (v MessageIdentification2) Validate() error {
+	var errs []error
+	if v.MsgNmId != nil {
+		errs = append(errs, common.PrefixError("MsgNmId", v.MsgNmId.Validate()))
+	}
+	if v.MsgId != nil {
+		errs = append(errs, common.PrefixError("MsgId", v.MsgId.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks NameAndAddress16 and its children against the schema.
+func (v NameAndAddress16) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	errs = append(errs, common.PrefixError("Adr", v.Adr.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks NumberAndSumOfTransactions1 and its children against the schema.
+func (v NumberAndSumOfTransactions1) Validate() error {
+	var errs []error
+	if v.NbOfNtries != nil {
+		errs = append(errs, common.PrefixError("NbOfNtries", v.NbOfNtries.Validate()))
+	}
+	if v.Sum != nil {
+		errs = append(errs, common.PrefixError("Sum", v.Sum.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks NumberAndSumOfTransactions4 and its children against the schema.
+func (v NumberAndSumOfTransactions4) Validate() error {
+	var errs []error
+	if v.NbOfNtries != nil {
+		errs = append(errs, common.PrefixError("NbOfNtries", v.NbOfNtries.Validate()))
+	}
+	if v.Sum != nil {
+		errs = append(errs, common.PrefixError("Sum", v.Sum.Validate()))
+	}
+	if v.TtlNetNtry != nil {
+		errs = append(errs, common.PrefixError("TtlNetNtry", v.TtlNetNtry.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks OrganisationIdentification29 and its children against the schema.
+func (v OrganisationIdentification29) Validate() error {
+	var errs []error
+	if v.AnyBIC != nil {
+		errs = append(errs, common.PrefixError("AnyBIC", v.AnyBIC.Validate()))
+	}
+	if v.LEI != nil {
+		errs = append(errs, common.PrefixError("LEI", v.LEI.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Othr", common.CheckOccurs(len(v.Othr), 0, -1)))
+	for i := range v.Othr {
+		errs = append(errs, common.PrefixError("Othr"+"["+strconv.Itoa(i)+"]", v.Othr[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks OrganisationIdentificationSchemeName1Choice and its children against the schema.
+func (v OrganisationIdentificationSchemeName1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks OriginalAndCurrentQuantities1 and its children against the schema.
+func (v OriginalAndCurrentQuantities1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("FaceAmt", v.FaceAmt.Validate()))
+	errs = append(errs, common.PrefixError("AmtsdVal", v.AmtsdVal.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks OriginalBusinessQuery1 and its children against the schema.
+func (v OriginalBusinessQuery1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("MsgId", v.MsgId.Validate()))
+	if v.MsgNmId != nil {
+		errs = append(errs, common.PrefixError("MsgNmId", v.MsgNmId.Validate()))
+	}
+	if v.CreDtTm != nil {
+		errs = append(errs, common.PrefixError("CreDtTm", v.CreDtTm.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks OtherContact1 and its children against the schema.
+func (v OtherContact1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("ChanlTp", v.ChanlTp.Validate()))
+	if v.Id != nil {
+		errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks OtherIdentification1 and its children against the schema.
+func (v OtherIdentification1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.Sfx != nil {
+		errs = append(errs, common.PrefixError("Sfx", v.Sfx.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks Pagination1 and its children against the schema.
+func (v Pagination1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("PgNb", v.PgNb.Validate()))
+	errs = append(errs, common.PrefixError("LastPgInd", v.LastPgInd.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks Party38Choice and its children against the schema.
+func (v Party38Choice) Validate() error {
+	var errs []error
+	if v.OrgId != nil {
+		errs = append(errs, common.PrefixError("OrgId", v.OrgId.Validate()))
+	}
+	if v.PrvtId != nil {
+		errs = append(errs, common.PrefixError("PrvtId", v.PrvtId.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"OrgId", "PrvtId"}, []bool{v.OrgId != nil, v.PrvtId != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks Party40Choice and its children against the schema.
+func (v Party40Choice) Validate() error {
+	var errs []error
+	if v.Pty != nil {
+		errs = append(errs, common.PrefixError("Pty", v.Pty.Validate()))
+	}
+	if v.Agt != nil {
+		errs = append(errs, common.PrefixError("Agt", v.Agt.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Pty", "Agt"}, []bool{v.Pty != nil, v.Agt != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks PartyIdentification135 and its children against the schema.
+func (v PartyIdentification135) Validate() error {
+	var errs []error
+	if v.Nm != nil {
+		errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	}
+	if v.PstlAdr != nil {
+		errs = append(errs, common.PrefixError("PstlAdr", v.PstlAdr.Validate()))
+	}
+	if v.Id != nil {
+		errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	}
+	if v.CtryOfRes != nil {
+		errs = append(errs, common.PrefixError("CtryOfRes", v.CtryOfRes.Validate()))
+	}
+	if v.CtctDtls != nil {
+		errs = append(errs, common.PrefixError("CtctDtls", v.CtctDtls.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks PaymentCard4 and its children against the schema.
+func (v PaymentCard4) Validate() error {
+	var errs []error
+	if v.PlainCardData != nil {
+		errs = append(errs, common.PrefixError("PlainCardData", v.PlainCardData.Validate()))
+	}
+	if v.CardCtryCd != nil {
+		errs = append(errs, common.PrefixError("CardCtryCd", v.CardCtryCd.Validate()))
+	}
+	if v.CardBrnd != nil {
+		errs = append(errs, common.PrefixError("CardBrnd", v.CardBrnd.Validate()))
+	}
+	if v.AddtlCardData != nil {
+		errs = append(errs, common.PrefixError("AddtlCardData", v.AddtlCardData.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks PaymentContext3 and its children against the schema.
+func (v PaymentContext3) Validate() error {
+	var errs []error
+	if v.CardPres != nil {
+		errs = append(errs, common.PrefixError("CardPres", v.CardPres.Validate()))
+	}
+	if v.CrdhldrPres != nil {
+		errs = append(errs, common.PrefixError("CrdhldrPres", v.CrdhldrPres.Validate()))
+	}
+	if v.OnLineCntxt != nil {
+		errs = append(errs, common.PrefixError("OnLineCntxt", v.OnLineCntxt.Validate()))
+	}
+	if v.AttndncCntxt != nil {
+		errs = append(errs, common.PrefixError("AttndncCntxt", v.AttndncCntxt.Validate()))
+	}
+	if v.TxEnvt != nil {
+		errs = append(errs, common.PrefixError("TxEnvt", v.TxEnvt.Validate()))
+	}
+	if v.TxChanl != nil {
+		errs = append(errs, common.PrefixError("TxChanl", v.TxChanl.Validate()))
+	}
+	if v.AttndntMsgCpbl != nil {
+		errs = append(errs, common.PrefixError("AttndntMsgCpbl", v.AttndntMsgCpbl.Validate()))
+	}
+	if v.AttndntLang != nil {
+		errs = append(errs, common.PrefixError("AttndntLang", v.AttndntLang.Validate()))
+	}
+	errs = append(errs, common.PrefixError("CardDataNtryMd", v.CardDataNtryMd.Validate()))
+	if v.FllbckInd != nil {
+		errs = append(errs, common.PrefixError("FllbckInd", v.FllbckInd.Validate()))
+	}
+	if v.AuthntcnMtd != nil {
+		errs = append(errs, common.PrefixError("AuthntcnMtd", v.AuthntcnMtd.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks PaymentReturnReason5 and its children against the schema.
+func (v PaymentReturnReason5) Validate() error {
+	var errs []error
+	if v.OrgnlBkTxCd != nil {
+		errs = append(errs, common.PrefixError("OrgnlBkTxCd", v.OrgnlBkTxCd.Validate()))
+	}
+	if v.Orgtr != nil {
+		errs = append(errs, common.PrefixError("Orgtr", v.Orgtr.Validate()))
+	}
+	if v.Rsn != nil {
+		errs = append(errs, common.PrefixError("Rsn", v.Rsn.Validate()))
+	}
+	errs = append(errs, common.PrefixError("AddtlInf", common.CheckOccurs(len(v.AddtlInf), 0, -1)))
+	for i := range v.AddtlInf {
+		errs = append(errs, common.PrefixError("AddtlInf"+"["+strconv.Itoa(i)+"]", v.AddtlInf[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks PersonIdentification13 and its children against the schema.
+func (v PersonIdentification13) Validate() error {
+	var errs []error
+	if v.DtAndPlcOfBirth != nil {
+		errs = append(errs, common.PrefixError("DtAndPlcOfBirth", v.DtAndPlcOfBirth.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Othr", common.CheckOccurs(len(v.Othr), 0, -1)))
+	for i := range v.Othr {
+		errs = append(errs, common.PrefixError("Othr"+"["+strconv.Itoa(i)+"]", v.Othr[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks PersonIdentificationSchemeName1Choice and its children against the schema.
+func (v PersonIdentificationSchemeName1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks PlainCardData1 and its children against the schema.
+func (v PlainCardData1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("PAN", v.PAN.Validate()))
+	if v.CardSeqNb != nil {
+		errs = append(errs, common.PrefixError("CardSeqNb", v.CardSeqNb.Validate()))
+	}
+	if v.FctvDt != nil {
+		errs = append(errs, common.PrefixError("FctvDt", v.FctvDt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("XpryDt", v.XpryDt.Validate()))
+	if v.SvcCd != nil {
+		errs = append(errs, common.PrefixError("SvcCd", v.SvcCd.Validate()))
+	}
+	errs = append(errs, common.PrefixError("TrckData", common.CheckOccurs(len(v.TrckData), 0, -1)))
+	for i := range v.TrckData {
+		errs = append(errs, common.PrefixError("TrckData"+"["+strconv.Itoa(i)+"]", v.TrckData[i].Validate()))
+	}
+	if v.CardSctyCd != nil {
+		errs = append(errs, common.PrefixError("CardSctyCd", v.CardSctyCd.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks PointOfInteraction1 and its children against the schema.
+func (v PointOfInteraction1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.SysNm != nil {
+		errs = append(errs, common.PrefixError("SysNm", v.SysNm.Validate()))
+	}
+	if v.GrpId != nil {
+		errs = append(errs, common.PrefixError("GrpId", v.GrpId.Validate()))
+	}
+	if v.Cpblties != nil {
+		errs = append(errs, common.PrefixError("Cpblties", v.Cpblties.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Cmpnt", common.CheckOccurs(len(v.Cmpnt), 0, -1)))
+	for i := range v.Cmpnt {
+		errs = append(errs, common.PrefixError("Cmpnt"+"["+strconv.Itoa(i)+"]", v.Cmpnt[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks PointOfInteractionCapabilities1 and its children against the schema.
+func (v PointOfInteractionCapabilities1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("CardRdngCpblties", common.CheckOccurs(len(v.CardRdngCpblties), 0, -1)))
+	for i := range v.CardRdngCpblties {
+		errs = append(errs, common.PrefixError("CardRdngCpblties"+"["+strconv.Itoa(i)+"]", v.CardRdngCpblties[i].Validate()))
+	}
+	errs = append(errs, common.PrefixError("CrdhldrVrfctnCpblties", common.CheckOccurs(len(v.CrdhldrVrfctnCpblties), 0, -1)))
+	for i := range v.CrdhldrVrfctnCpblties {
+		errs = append(errs, common.PrefixError("CrdhldrVrfctnCpblties"+"["+strconv.Itoa(i)+"]", v.CrdhldrVrfctnCpblties[i].Validate()))
+	}
+	if v.OnLineCpblties != nil {
+		errs = append(errs, common.PrefixError("OnLineCpblties", v.OnLineCpblties.Validate()))
+	}
+	errs = append(errs, common.PrefixError("DispCpblties", common.CheckOccurs(len(v.DispCpblties), 0, -1)))
+	for i := range v.DispCpblties {
+		errs = append(errs, common.PrefixError("DispCpblties"+"["+strconv.Itoa(i)+"]", v.DispCpblties[i].Validate()))
+	}
+	if v.PrtLineWidth != nil {
+		errs = append(errs, common.PrefixError("PrtLineWidth", v.PrtLineWidth.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks PointOfInteractionComponent1 and its children against the schema.
+func (v PointOfInteractionComponent1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("POICmpntTp", v.POICmpntTp.Validate()))
+	if v.ManfctrId != nil {
+		errs = append(errs, common.PrefixError("ManfctrId", v.ManfctrId.Validate()))
+	}
+	if v.Mdl != nil {
+		errs = append(errs, common.PrefixError("Mdl", v.Mdl.Validate()))
+	}
+	if v.VrsnNb != nil {
+		errs = append(errs, common.PrefixError("VrsnNb", v.VrsnNb.Validate()))
+	}
+	if v.SrlNb != nil {
+		errs = append(errs, common.PrefixError("SrlNb", v.SrlNb.Validate()))
+	}
+	errs = append(errs, common.PrefixError("ApprvlNb", common.CheckOccurs(len(v.ApprvlNb), 0, -1)))
+	for i := range v.ApprvlNb {
+		errs = append(errs, common.PrefixError("ApprvlNb"+"["+strconv.Itoa(i)+"]", v.ApprvlNb[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks PostalAddress24 and its children against the schema.
+func (v PostalAddress24) Validate() error {
+	var errs []error
+	if v.AdrTp != nil {
+		errs = append(errs, common.PrefixError("AdrTp", v.AdrTp.Validate()))
+	}
+	if v.Dept != nil {
+		errs = append(errs, common.PrefixError("Dept", v.Dept.Validate()))
+	}
+	if v.SubDept != nil {
+		errs = append(errs, common.PrefixError("SubDept", v.SubDept.Validate()))
+	}
+	if v.StrtNm != nil {
+		errs = append(errs, common.PrefixError("StrtNm", v.StrtNm.Validate()))
+	}
+	if v.BldgNb != nil {
+		errs = append(errs, common.PrefixError("BldgNb", v.BldgNb.Validate()))
+	}
+	if v.BldgNm != nil {
+		errs = append(errs, common.PrefixError("BldgNm", v.BldgNm.Validate()))
+	}
+	if v.Flr != nil {
+		errs = append(errs, common.PrefixError("Flr", v.Flr.Validate()))
+	}
+	if v.PstBx != nil {
+		errs = append(errs, common.PrefixError("PstBx", v.PstBx.Validate()))
+	}
+	if v.Room != nil {
+		errs = append(errs, common.PrefixError("Room", v.Room.Validate()))
+	}
+	if v.PstCd != nil {
+		errs = append(errs, common.PrefixError("PstCd", v.PstCd.Validate()))
+	}
+	if v.TwnNm != nil {
+		errs = append(errs, common.PrefixError("TwnNm", v.TwnNm.Validate()))
+	}
+	if v.TwnLctnNm != nil {
+		errs = append(errs, common.PrefixError("TwnLctnNm", v.TwnLctnNm.Validate()))
+	}
+	if v.DstrctNm != nil {
+		errs = append(errs, common.PrefixError("DstrctNm", v.DstrctNm.Validate()))
+	}
+	if v.CtrySubDvsn != nil {
+		errs = append(errs, common.PrefixError("CtrySubDvsn", v.CtrySubDvsn.Validate()))
+	}
+	if v.Ctry != nil {
+		errs = append(errs, common.PrefixError("Ctry", v.Ctry.Validate()))
+	}
+	errs = append(errs, common.PrefixError("AdrLine", common.CheckOccurs(len(v.AdrLine), 0, 7)))
+	for i := range v.AdrLine {
+		errs = append(errs, common.PrefixError("AdrLine"+"["+strconv.Itoa(i)+"]", v.AdrLine[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks Price7 and its children against the schema.
+func (v Price7) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	errs = append(errs, common.PrefixError("Val", v.Val.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks PriceRateOrAmount3Choice and its children against the schema.
+func (v PriceRateOrAmount3Choice) Validate() error {
+	var errs []error
+	if v.Rate != nil {
+		errs = append(errs, common.PrefixError("Rate", v.Rate.Validate()))
+	}
+	if v.Amt != nil {
+		errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Rate", "Amt"}, []bool{v.Rate != nil, v.Amt != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks Product2 and its children against the schema.
+func (v Product2) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("PdctCd", v.PdctCd.Validate()))
+	if v.UnitOfMeasr != nil {
+		errs = append(errs, common.PrefixError("UnitOfMeasr", v.UnitOfMeasr.Validate()))
+	}
+	if v.PdctQty != nil {
+		errs = append(errs, common.PrefixError("PdctQty", v.PdctQty.Validate()))
+	}
+	if v.UnitPric != nil {
+		errs = append(errs, common.PrefixError("UnitPric", v.UnitPric.Validate()))
+	}
+	if v.PdctAmt != nil {
+		errs = append(errs, common.PrefixError("PdctAmt", v.PdctAmt.Validate()))
+	}
+	if v.TaxTp != nil {
+		errs = append(errs, common.PrefixError("TaxTp", v.TaxTp.Validate()))
+	}
+	if v.AddtlPdctInf != nil {
+		errs = append(errs, common.PrefixError("AddtlPdctInf", v.AddtlPdctInf.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks ProprietaryAgent4 and its children against the schema.
+func (v ProprietaryAgent4) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	errs = append(errs, common.PrefixError("Agt", v.Agt.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks ProprietaryBankTransactionCodeStructure1 and its children against the schema.
+func (v ProprietaryBankTransactionCodeStructure1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks ProprietaryDate3 and its children against the schema.
+func (v ProprietaryDate3) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	errs = append(errs, common.PrefixError("Dt", v.Dt.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks ProprietaryParty5 and its children against the schema.
+func (v ProprietaryParty5) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	errs = append(errs, common.PrefixError("Pty", v.Pty.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks ProprietaryPrice2 and its children against the schema.
+func (v ProprietaryPrice2) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	errs = append(errs, common.PrefixError("Pric", v.Pric.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks ProprietaryQuantity1 and its children against the schema.
+func (v ProprietaryQuantity1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	errs = append(errs, common.PrefixError("Qty", v.Qty.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks ProprietaryReference1 and its children against the schema.
+func (v ProprietaryReference1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	errs = append(errs, common.PrefixError("Ref", v.Ref.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks ProxyAccountIdentification1 and its children against the schema.
+func (v ProxyAccountIdentification1) Validate() error {
+	var errs []error
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks ProxyAccountType1Choice and its children against the schema.
+func (v ProxyAccountType1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks Purpose2Choice and its children against the schema.
+func (v Purpose2Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks Rate4 and its children against the schema.
+func (v Rate4) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	if v.VldtyRg != nil {
+		errs = append(errs, common.PrefixError("VldtyRg", v.VldtyRg.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks RateType4Choice and its children against the schema.
+func (v RateType4Choice) Validate() error {
+	var errs []error
+	if v.Pctg != nil {
+		errs = append(errs, common.PrefixError("Pctg", v.Pctg.Validate()))
+	}
+	if v.Othr != nil {
+		errs = append(errs, common.PrefixError("Othr", v.Othr.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Pctg", "Othr"}, []bool{v.Pctg != nil, v.Othr != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks ReferredDocumentInformation7 and its children against the schema.
+func (v ReferredDocumentInformation7) Validate() error {
+	var errs []error
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.Nb != nil {
+		errs = append(errs, common.PrefixError("Nb", v.Nb.Validate()))
+	}
+	if v.RltdDt != nil {
+		errs = append(errs, common.PrefixError("RltdDt", v.RltdDt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("LineDtls", common.CheckOccurs(len(v.LineDtls), 0, -1)))
+	for i := range v.LineDtls {
+		errs = append(errs, common.PrefixError("LineDtls"+"["+strconv.Itoa(i)+"]", v.LineDtls[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks ReferredDocumentType3Choice and its children against the schema.
+func (v ReferredDocumentType3Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks ReferredDocumentType4 and its children against the schema.
+func (v ReferredDocumentType4) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("CdOrPrtry", v.CdOrPrtry.Validate()))
+	if v.Issr != nil {
+		errs = append(errs, common.PrefixError("Issr", v.Issr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks RemittanceAmount2 and its children against the schema.
+func (v RemittanceAmount2) Validate() error {
+	var errs []error
+	if v.DuePyblAmt != nil {
+		errs = append(errs, common.PrefixError("DuePyblAmt", v.DuePyblAmt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("DscntApldAmt", common.CheckOccurs(len(v.DscntApldAmt), 0, -1)))
+	for i := range v.DscntApldAmt {
+		errs = append(errs, common.PrefixError("DscntApldAmt"+"["+strconv.Itoa(i)+"]", v.DscntApldAmt[i].Validate()))
+	}
+	if v.CdtNoteAmt != nil {
+		errs = append(errs, common.PrefixError("CdtNoteAmt", v.CdtNoteAmt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("TaxAmt", common.CheckOccurs(len(v.TaxAmt), 0, -1)))
+	for i := range v.TaxAmt {
+		errs = append(errs, common.PrefixError("TaxAmt"+"["+strconv.Itoa(i)+"]", v.TaxAmt[i].Validate()))
+	}
+	errs = append(errs, common.PrefixError("AdjstmntAmtAndRsn", common.CheckOccurs(len(v.AdjstmntAmtAndRsn), 0, -1)))
+	for i := range v.AdjstmntAmtAndRsn {
+		errs = append(errs, common.PrefixError("AdjstmntAmtAndRsn"+"["+strconv.Itoa(i)+"]", v.AdjstmntAmtAndRsn[i].Validate()))
+	}
+	if v.RmtdAmt != nil {
+		errs = append(errs, common.PrefixError("RmtdAmt", v.RmtdAmt.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks RemittanceAmount3 and its children against the schema.
+func (v RemittanceAmount3) Validate() error {
+	var errs []error
+	if v.DuePyblAmt != nil {
+		errs = append(errs, common.PrefixError("DuePyblAmt", v.DuePyblAmt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("DscntApldAmt", common.CheckOccurs(len(v.DscntApldAmt), 0, -1)))
+	for i := range v.DscntApldAmt {
+		errs = append(errs, common.PrefixError("DscntApldAmt"+"["+strconv.Itoa(i)+"]", v.DscntApldAmt[i].Validate()))
+	}
+	if v.CdtNoteAmt != nil {
+		errs = append(errs, common.PrefixError("CdtNoteAmt", v.CdtNoteAmt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("TaxAmt", common.CheckOccurs(len(v.TaxAmt), 0, -1)))
+	for i := range v.TaxAmt {
+		errs = append(errs, common.PrefixError("TaxAmt"+"["+strconv.Itoa(i)+"]", v.TaxAmt[i].Validate()))
+	}
+	errs = append(errs, common.PrefixError("AdjstmntAmtAndRsn", common.CheckOccurs(len(v.AdjstmntAmtAndRsn), 0, -1)))
+	for i := range v.AdjstmntAmtAndRsn {
+		errs = append(errs, common.PrefixError("AdjstmntAmtAndRsn"+"["+strconv.Itoa(i)+"]", v.AdjstmntAmtAndRsn[i].Validate()))
+	}
+	if v.RmtdAmt != nil {
+		errs = append(errs, common.PrefixError("RmtdAmt", v.RmtdAmt.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks RemittanceInformation16 and its children against the schema.
+func (v RemittanceInformation16) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Ustrd", common.CheckOccurs(len(v.Ustrd), 0, -1)))
+	for i := range v.Ustrd {
+		errs = append(errs, common.PrefixError("Ustrd"+"["+strconv.Itoa(i)+"]", v.Ustrd[i].Validate()))
+	}
+	errs = append(errs, common.PrefixError("Strd", common.CheckOccurs(len(v.Strd), 0, -1)))
+	for i := range v.Strd {
+		errs = append(errs, common.PrefixError("Strd"+"["+strconv.Itoa(i)+"]", v.Strd[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks RemittanceLocation7 and its children against the schema.
+func (v RemittanceLocation7) Validate() error {
+	var errs []error
+	if v.RmtId != nil {
+		errs = append(errs, common.PrefixError("RmtId", v.RmtId.Validate()))
+	}
+	errs = append(errs, common.PrefixError("RmtLctnDtls", common.CheckOccurs(len(v.RmtLctnDtls), 0, -1)))
+	for i := range v.RmtLctnDtls {
+		errs = append(errs, common.PrefixError("RmtLctnDtls"+"["+strconv.Itoa(i)+"]", v.RmtLctnDtls[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks RemittanceLocationData1 and its children against the schema.
+func (v RemittanceLocationData1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Mtd", v.Mtd.Validate()))
+	if v.ElctrncAdr != nil {
+		errs = append(errs, common.PrefixError("ElctrncAdr", v.ElctrncAdr.Validate()))
+	}
+	if v.PstlAdr != nil {
+		errs = append(errs, common.PrefixError("PstlAdr", v.PstlAdr.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks ReportEntry10 and its children against the schema.
+func (v ReportEntry10) Validate() error {
+	var errs []error
+	if v.NtryRef != nil {
+		errs = append(errs, common.PrefixError("NtryRef", v.NtryRef.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	errs = append(errs, common.PrefixError("CdtDbtInd", v.CdtDbtInd.Validate()))
+	if v.RvslInd != nil {
+		errs = append(errs, common.PrefixError("RvslInd", v.RvslInd.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Sts", v.Sts.Validate()))
+	if v.BookgDt != nil {
+		errs = append(errs, common.PrefixError("BookgDt", v.BookgDt.Validate()))
+	}
+	if v.ValDt != nil {
+		errs = append(errs, common.PrefixError("ValDt", v.ValDt.Validate()))
+	}
+	if v.AcctSvcrRef != nil {
+		errs = append(errs, common.PrefixError("AcctSvcrRef", v.AcctSvcrRef.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Avlbty", common.CheckOccurs(len(v.Avlbty), 0, -1)))
+	for i := range v.Avlbty {
+		errs = append(errs, common.PrefixError("Avlbty"+"["+strconv.Itoa(i)+"]", v.Avlbty[i].Validate()))
+	}
+	errs = append(errs, common.PrefixError("BkTxCd", v.BkTxCd.Validate()))
+	if v.ComssnWvrInd != nil {
+		errs = append(errs, common.PrefixError("ComssnWvrInd", v.ComssnWvrInd.Validate()))
+	}
+	if v.AddtlInfInd != nil {
+		errs = append(errs, common.PrefixError("AddtlInfInd", v.AddtlInfInd.Validate()))
+	}
+	if v.AmtDtls != nil {
+		errs = append(errs, common.PrefixError("AmtDtls", v.AmtDtls.Validate()))
+	}
+	if v.Chrgs != nil {
+		errs = append(errs, common.PrefixError("Chrgs", v.Chrgs.Validate()))
+	}
+	if v.TechInptChanl != nil {
+		errs = append(errs, common.PrefixError("TechInptChanl", v.TechInptChanl.Validate()))
+	}
+	if v.Intrst != nil {
+		errs = append(errs, common.PrefixError("Intrst", v.Intrst.Validate()))
+	}
+	if v.CardTx != nil {
+		errs = append(errs, common.PrefixError("CardTx", v.CardTx.Validate()))
+	}
+	errs = append(errs, common.PrefixError("NtryDtls", common.CheckOccurs(len(v.NtryDtls), 0, -1)))
+	for i := range v.NtryDtls {
+		errs = append(errs, common.PrefixError("NtryDtls"+"["+strconv.Itoa(i)+"]", v.NtryDtls[i].Validate()))
+	}
+	if v.AddtlNtryInf != nil {
+		errs = append(errs, common.PrefixError("AddtlNtryInf", v.AddtlNtryInf.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks ReportingSource1Choice and its children against the schema.
+func (v ReportingSource1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks ReturnReason5Choice and its children against the schema.
+func (v ReturnReason5Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks SecuritiesAccount19 and its children against the schema.
+func (v SecuritiesAccount19) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.Nm != nil {
+		errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks SecurityIdentification19 and its children against the schema.
+func (v SecurityIdentification19) Validate() error {
+	var errs []error
+	if v.ISIN != nil {
+		errs = append(errs, common.PrefixError("ISIN", v.ISIN.Validate()))
+	}
+	errs = append(errs, common.PrefixError("OthrId", common.CheckOccurs(len(v.OthrId), 0, -1)))
+	for i := range v.OthrId {
+		errs = append(errs, common.PrefixError("OthrId"+"["+strconv.Itoa(i)+"]", v.OthrId[i].Validate()))
+	}
+	if v.Desc != nil {
+		errs = append(errs, common.PrefixError("Desc", v.Desc.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks SequenceRange1 and its children against the schema.
+func (v SequenceRange1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("FrSeq", v.FrSeq.Validate()))
+	errs = append(errs, common.PrefixError("ToSeq", v.ToSeq.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks SequenceRange1Choice and its children against the schema.
+func (v SequenceRange1Choice) Validate() error {
+	var errs []error
+	if v.FrSeq != nil {
+		errs = append(errs, common.PrefixError("FrSeq", v.FrSeq.Validate()))
+	}
+	if v.ToSeq != nil {
+		errs = append(errs, common.PrefixError("ToSeq", v.ToSeq.Validate()))
+	}
+	errs = append(errs, common.PrefixError("FrToSeq", common.CheckOccurs(len(v.FrToSeq), 0, -1)))
+	for i := range v.FrToSeq {
+		errs = append(errs, common.PrefixError("FrToSeq"+"["+strconv.Itoa(i)+"]", v.FrToSeq[i].Validate()))
+	}
+	errs = append(errs, common.PrefixError("EQSeq", common.CheckOccurs(len(v.EQSeq), 0, -1)))
+	for i := range v.EQSeq {
+		errs = append(errs, common.PrefixError("EQSeq"+"["+strconv.Itoa(i)+"]", v.EQSeq[i].Validate()))
+	}
+	errs = append(errs, common.PrefixError("NEQSeq", common.CheckOccurs(len(v.NEQSeq), 0, -1)))
+	for i := range v.NEQSeq {
+		errs = append(errs, common.PrefixError("NEQSeq"+"["+strconv.Itoa(i)+"]", v.NEQSeq[i].Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"FrSeq", "ToSeq", "FrToSeq", "EQSeq", "NEQSeq"}, []bool{v.FrSeq != nil, v.ToSeq != nil, len(v.FrToSeq) > 0, len(v.EQSeq) > 0, len(v.NEQSeq) > 0}))
+	return errors.Join(errs...)
+}
+
+// Validate checks StructuredRemittanceInformation16 and its children against the schema.
+func (v StructuredRemittanceInformation16) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("RfrdDocInf", common.CheckOccurs(len(v.RfrdDocInf), 0, -1)))
+	for i := range v.RfrdDocInf {
+		errs = append(errs, common.PrefixError("RfrdDocInf"+"["+strconv.Itoa(i)+"]", v.RfrdDocInf[i].Validate()))
+	}
+	if v.RfrdDocAmt != nil {
+		errs = append(errs, common.PrefixError("RfrdDocAmt", v.RfrdDocAmt.Validate()))
+	}
+	if v.CdtrRefInf != nil {
+		errs = append(errs, common.PrefixError("CdtrRefInf", v.CdtrRefInf.Validate()))
+	}
+	if v.Invcr != nil {
+		errs = append(errs, common.PrefixError("Invcr", v.Invcr.Validate()))
+	}
+	if v.Invcee != nil {
+		errs = append(errs, common.PrefixError("Invcee", v.Invcee.Validate()))
+	}
+	if v.TaxRmt != nil {
+		errs = append(errs, common.PrefixError("TaxRmt", v.TaxRmt.Validate()))
+	}
+	if v.GrnshmtRmt != nil {
+		errs = append(errs, common.PrefixError("GrnshmtRmt", v.GrnshmtRmt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("AddtlRmtInf", common.CheckOccurs(len(v.AddtlRmtInf), 0, 3)))
+	for i := range v.AddtlRmtInf {
+		errs = append(errs, common.PrefixError("AddtlRmtInf"+"["+strconv.Itoa(i)+"]", v.AddtlRmtInf[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks SupplementaryData1 and its children against the schema.
+func (v SupplementaryData1) Validate() error {
+	var errs []error
+	if v.PlcAndNm != nil {
+		errs = append(errs, common.PrefixError("PlcAndNm", v.PlcAndNm.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Envlp", v.Envlp.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks SupplementaryDataEnvelope1 and its children against the schema.
+func (v SupplementaryDataEnvelope1) Validate() error {
+	return nil
+}
+
+// Validate checks TaxAmount2 and its children against the schema.
+func (v TaxAmount2) Validate() error {
+	var errs []error
+	if v.Rate != nil {
+		errs = append(errs, common.PrefixError("Rate", v.Rate.Validate()))
+	}
+	if v.TaxblBaseAmt != nil {
+		errs = append(errs, common.PrefixError("TaxblBaseAmt", v.TaxblBaseAmt.Validate()))
+	}
+	if v.TtlAmt != nil {
+		errs = append(errs, common.PrefixError("TtlAmt", v.TtlAmt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Dtls", common.CheckOccurs(len(v.Dtls), 0, -1)))
+	for i := range v.Dtls {
+		errs = append(errs, common.PrefixError("Dtls"+"["+strconv.Itoa(i)+"]", v.Dtls[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxAmountAndType1 and its children against the schema.
+func (v TaxAmountAndType1) Validate() error {
+	var errs []error
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxAmountType1Choice and its children against the schema.
+func (v TaxAmountType1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxAuthorisation1 and its children against the schema.
+func (v TaxAuthorisation1) Validate() error {
+	var errs []error
+	if v.Titl != nil {
+		errs = append(errs, common.PrefixError("Titl", v.Titl.Validate()))
+	}
+	if v.Nm != nil {
+		errs = append(errs, common.PrefixError("Nm", v.Nm.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxCharges2 and its children against the schema.
+func (v TaxCharges2) Validate() error {
+	var errs []error
+	if v.Id != nil {
+		errs = append(errs, common.PrefixError("Id", v.Id.Validate()))
+	}
+	if v.Rate != nil {
+		errs = append(errs, common.PrefixError("Rate", v.Rate.Validate()))
+	}
+	if v.Amt != nil {
+		errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxInformation7 and its children against the schema.
+func (v TaxInformation7) Validate() error {
+	var errs []error
+	if v.Cdtr != nil {
+		errs = append(errs, common.PrefixError("Cdtr", v.Cdtr.Validate()))
+	}
+	if v.Dbtr != nil {
+		errs = append(errs, common.PrefixError("Dbtr", v.Dbtr.Validate()))
+	}
+	if v.UltmtDbtr != nil {
+		errs = append(errs, common.PrefixError("UltmtDbtr", v.UltmtDbtr.Validate()))
+	}
+	if v.AdmstnZone != nil {
+		errs = append(errs, common.PrefixError("AdmstnZone", v.AdmstnZone.Validate()))
+	}
+	if v.RefNb != nil {
+		errs = append(errs, common.PrefixError("RefNb", v.RefNb.Validate()))
+	}
+	if v.Mtd != nil {
+		errs = append(errs, common.PrefixError("Mtd", v.Mtd.Validate()))
+	}
+	if v.TtlTaxblBaseAmt != nil {
+		errs = append(errs, common.PrefixError("TtlTaxblBaseAmt", v.TtlTaxblBaseAmt.Validate()))
+	}
+	if v.TtlTaxAmt != nil {
+		errs = append(errs, common.PrefixError("TtlTaxAmt", v.TtlTaxAmt.Validate()))
+	}
+	if v.Dt != nil {
+		errs = append(errs, common.PrefixError("Dt", v.Dt.Validate()))
+	}
+	if v.SeqNb != nil {
+		errs = append(errs, common.PrefixError("SeqNb", v.SeqNb.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Rcrd", common.CheckOccurs(len(v.Rcrd), 0, -1)))
+	for i := range v.Rcrd {
+		errs = append(errs, common.PrefixError("Rcrd"+"["+strconv.Itoa(i)+"]", v.Rcrd[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxInformation8 and its children against the schema.
+func (v TaxInformation8) Validate() error {
+	var errs []error
+	if v.Cdtr != nil {
+		errs = append(errs, common.PrefixError("Cdtr", v.Cdtr.Validate()))
+	}
+	if v.Dbtr != nil {
+		errs = append(errs, common.PrefixError("Dbtr", v.Dbtr.Validate()))
+	}
+	if v.AdmstnZone != nil {
+		errs = append(errs, common.PrefixError("AdmstnZone", v.AdmstnZone.Validate()))
+	}
+	if v.RefNb != nil {
+		errs = append(errs, common.PrefixError("RefNb", v.RefNb.Validate()))
+	}
+	if v.Mtd != nil {
+		errs = append(errs, common.PrefixError("Mtd", v.Mtd.Validate()))
+	}
+	if v.TtlTaxblBaseAmt != nil {
+		errs = append(errs, common.PrefixError("TtlTaxblBaseAmt", v.TtlTaxblBaseAmt.Validate()))
+	}
+	if v.TtlTaxAmt != nil {
+		errs = append(errs, common.PrefixError("TtlTaxAmt", v.TtlTaxAmt.Validate()))
+	}
+	if v.Dt != nil {
+		errs = append(errs, common.PrefixError("Dt", v.Dt.Validate()))
+	}
+	if v.SeqNb != nil {
+		errs = append(errs, common.PrefixError("SeqNb", v.SeqNb.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Rcrd", common.CheckOccurs(len(v.Rcrd), 0, -1)))
+	for i := range v.Rcrd {
+		errs = append(errs, common.PrefixError("Rcrd"+"["+strconv.Itoa(i)+"]", v.Rcrd[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxParty1 and its children against the schema.
+func (v TaxParty1) Validate() error {
+	var errs []error
+	if v.TaxId != nil {
+		errs = append(errs, common.PrefixError("TaxId", v.TaxId.Validate()))
+	}
+	if v.RegnId != nil {
+		errs = append(errs, common.PrefixError("RegnId", v.RegnId.Validate()))
+	}
+	if v.TaxTp != nil {
+		errs = append(errs, common.PrefixError("TaxTp", v.TaxTp.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxParty2 and its children against the schema.
+func (v TaxParty2) Validate() error {
+	var errs []error
+	if v.TaxId != nil {
+		errs = append(errs, common.PrefixError("TaxId", v.TaxId.Validate()))
+	}
+	if v.RegnId != nil {
+		errs = append(errs, common.PrefixError("RegnId", v.RegnId.Validate()))
+	}
+	if v.TaxTp != nil {
+		errs = append(errs, common.PrefixError("TaxTp", v.TaxTp.Validate()))
+	}
+	if v.Authstn != nil {
+		errs = append(errs, common.PrefixError("Authstn", v.Authstn.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxPeriod2 and its children against the schema.
+func (v TaxPeriod2) Validate() error {
+	var errs []error
+	if v.Yr != nil {
+		errs = append(errs, common.PrefixError("Yr", v.Yr.Validate()))
+	}
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.FrToDt != nil {
+		errs = append(errs, common.PrefixError("FrToDt", v.FrToDt.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxRecord2 and its children against the schema.
+func (v TaxRecord2) Validate() error {
+	var errs []error
+	if v.Tp != nil {
+		errs = append(errs, common.PrefixError("Tp", v.Tp.Validate()))
+	}
+	if v.Ctgy != nil {
+		errs = append(errs, common.PrefixError("Ctgy", v.Ctgy.Validate()))
+	}
+	if v.CtgyDtls != nil {
+		errs = append(errs, common.PrefixError("CtgyDtls", v.CtgyDtls.Validate()))
+	}
+	if v.DbtrSts != nil {
+		errs = append(errs, common.PrefixError("DbtrSts", v.DbtrSts.Validate()))
+	}
+	if v.CertId != nil {
+		errs = append(errs, common.PrefixError("CertId", v.CertId.Validate()))
+	}
+	if v.FrmsCd != nil {
+		errs = append(errs, common.PrefixError("FrmsCd", v.FrmsCd.Validate()))
+	}
+	if v.Prd != nil {
+		errs = append(errs, common.PrefixError("Prd", v.Prd.Validate()))
+	}
+	if v.TaxAmt != nil {
+		errs = append(errs, common.PrefixError("TaxAmt", v.TaxAmt.Validate()))
+	}
+	if v.AddtlInf != nil {
+		errs = append(errs, common.PrefixError("AddtlInf", v.AddtlInf.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TaxRecordDetails2 and its children against the schema.
+func (v TaxRecordDetails2) Validate() error {
+	var errs []error
+	if v.Prd != nil {
+		errs = append(errs, common.PrefixError("Prd", v.Prd.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Amt", v.Amt.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks TechnicalInputChannel1Choice and its children against the schema.
+func (v TechnicalInputChannel1Choice) Validate() error {
+	var errs []error
+	if v.Cd != nil {
+		errs = append(errs, common.PrefixError("Cd", v.Cd.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Cd", "Prtry"}, []bool{v.Cd != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks TotalTransactions6 and its children against the schema.
+func (v TotalTransactions6) Validate() error {
+	var errs []error
+	if v.TtlNtries != nil {
+		errs = append(errs, common.PrefixError("TtlNtries", v.TtlNtries.Validate()))
+	}
+	if v.TtlCdtNtries != nil {
+		errs = append(errs, common.PrefixError("TtlCdtNtries", v.TtlCdtNtries.Validate()))
+	}
+	if v.TtlDbtNtries != nil {
+		errs = append(errs, common.PrefixError("TtlDbtNtries", v.TtlDbtNtries.Validate()))
+	}
+	errs = append(errs, common.PrefixError("TtlNtriesPerBkTxCd", common.CheckOccurs(len(v.TtlNtriesPerBkTxCd), 0, -1)))
+	for i := range v.TtlNtriesPerBkTxCd {
+		errs = append(errs, common.PrefixError("TtlNtriesPerBkTxCd"+"["+strconv.Itoa(i)+"]", v.TtlNtriesPerBkTxCd[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TotalsPerBankTransactionCode5 and its children against the schema.
+func (v TotalsPerBankTransactionCode5) Validate() error {
+	var errs []error
+	if v.NbOfNtries != nil {
+		errs = append(errs, common.PrefixError("NbOfNtries", v.NbOfNtries.Validate()))
+	}
+	if v.Sum != nil {
+		errs = append(errs, common.PrefixError("Sum", v.Sum.Validate()))
+	}
+	if v.TtlNetNtry != nil {
+		errs = append(errs, common.PrefixError("TtlNetNtry", v.TtlNetNtry.Validate()))
+	}
+	if v.CdtNtries != nil {
+		errs = append(errs, common.PrefixError("CdtNtries", v.CdtNtries.Validate()))
+	}
+	if v.DbtNtries != nil {
+		errs = append(errs, common.PrefixError("DbtNtries", v.DbtNtries.Validate()))
+	}
+	if v.FcstInd != nil {
+		errs = append(errs, common.PrefixError("FcstInd", v.FcstInd.Validate()))
+	}
+	errs = append(errs, common.PrefixError("BkTxCd", v.BkTxCd.Validate()))
+	errs = append(errs, common.PrefixError("Avlbty", common.CheckOccurs(len(v.Avlbty), 0, -1)))
+	for i := range v.Avlbty {
+		errs = append(errs, common.PrefixError("Avlbty"+"["+strconv.Itoa(i)+"]", v.Avlbty[i].Validate()))
+	}
+	if v.Dt != nil {
+		errs = append(errs, common.PrefixError("Dt", v.Dt.Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TrackData1 and its children against the schema.
+func (v TrackData1) Validate() error {
+	var errs []error
+	if v.TrckNb != nil {
+		errs = append(errs, common.PrefixError("TrckNb", v.TrckNb.Validate()))
+	}
+	errs = append(errs, common.PrefixError("TrckVal", v.TrckVal.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks TransactionAgents5 and its children against the schema.
+func (v TransactionAgents5) Validate() error {
+	var errs []error
+	if v.InstgAgt != nil {
+		errs = append(errs, common.PrefixError("InstgAgt", v.InstgAgt.Validate()))
+	}
+	if v.InstdAgt != nil {
+		errs = append(errs, common.PrefixError("InstdAgt", v.InstdAgt.Validate()))
+	}
+	if v.DbtrAgt != nil {
+		errs = append(errs, common.PrefixError("DbtrAgt", v.DbtrAgt.Validate()))
+	}
+	if v.CdtrAgt != nil {
+		errs = append(errs, common.PrefixError("CdtrAgt", v.CdtrAgt.Validate()))
+	}
+	if v.IntrmyAgt1 != nil {
+		errs = append(errs, common.PrefixError("IntrmyAgt1", v.IntrmyAgt1.Validate()))
+	}
+	if v.IntrmyAgt2 != nil {
+		errs = append(errs, common.PrefixError("IntrmyAgt2", v.IntrmyAgt2.Validate()))
+	}
+	if v.IntrmyAgt3 != nil {
+		errs = append(errs, common.PrefixError("IntrmyAgt3", v.IntrmyAgt3.Validate()))
+	}
+	if v.RcvgAgt != nil {
+		errs = append(errs, common.PrefixError("RcvgAgt", v.RcvgAgt.Validate()))
+	}
+	if v.DlvrgAgt != nil {
+		errs = append(errs, common.PrefixError("DlvrgAgt", v.DlvrgAgt.Validate()))
+	}
+	if v.IssgAgt != nil {
+		errs = append(errs, common.PrefixError("IssgAgt", v.IssgAgt.Validate()))
+	}
+	if v.SttlmPlc != nil {
+		errs = append(errs, common.PrefixError("SttlmPlc", v.SttlmPlc.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Prtry", common.CheckOccurs(len(v.Prtry), 0, -1)))
+	for i := range v.Prtry {
+		errs = append(errs, common.PrefixError("Prtry"+"["+strconv.Itoa(i)+"]", v.Prtry[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TransactionDates3 and its children against the schema.
+func (v TransactionDates3) Validate() error {
+	var errs []error
+	if v.AccptncDtTm != nil {
+		errs = append(errs, common.PrefixError("AccptncDtTm", v.AccptncDtTm.Validate()))
+	}
+	if v.TradActvtyCtrctlSttlmDt != nil {
+		errs = append(errs, common.PrefixError("TradActvtyCtrctlSttlmDt", v.TradActvtyCtrctlSttlmDt.Validate()))
+	}
+	if v.TradDt != nil {
+		errs = append(errs, common.PrefixError("TradDt", v.TradDt.Validate()))
+	}
+	if v.IntrBkSttlmDt != nil {
+		errs = append(errs, common.PrefixError("IntrBkSttlmDt", v.IntrBkSttlmDt.Validate()))
+	}
+	if v.StartDt != nil {
+		errs = append(errs, common.PrefixError("StartDt", v.StartDt.Validate()))
+	}
+	if v.EndDt != nil {
+		errs = append(errs, common.PrefixError("EndDt", v.EndDt.Validate()))
+	}
+	if v.TxDtTm != nil {
+		errs = append(errs, common.PrefixError("TxDtTm", v.TxDtTm.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Prtry", common.CheckOccurs(len(v.Prtry), 0, -1)))
+	for i := range v.Prtry {
+		errs = append(errs, common.PrefixError("Prtry"+"["+strconv.Itoa(i)+"]", v.Prtry[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TransactionIdentifier1 and its children against the schema.
+func (v TransactionIdentifier1) Validate() error {
+	var errs []error
+	errs = append(errs, common.PrefixError("TxDtTm", v.TxDtTm.Validate()))
+	errs = append(errs, common.PrefixError("TxRef", v.TxRef.Validate()))
+	return errors.Join(errs...)
+}
+
+// Validate checks TransactionInterest4 and its children against the schema.
+func (v TransactionInterest4) Validate() error {
+	var errs []error
+	if v.TtlIntrstAndTaxAmt != nil {
+		errs = append(errs, common.PrefixError("TtlIntrstAndTaxAmt", v.TtlIntrstAndTaxAmt.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Rcrd", common.CheckOccurs(len(v.Rcrd), 0, -1)))
+	for i := range v.Rcrd {
+		errs = append(errs, common.PrefixError("Rcrd"+"["+strconv.Itoa(i)+"]", v.Rcrd[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TransactionParties6 and its children against the schema.
+func (v TransactionParties6) Validate() error {
+	var errs []error
+	if v.InitgPty != nil {
+		errs = append(errs, common.PrefixError("InitgPty", v.InitgPty.Validate()))
+	}
+	if v.Dbtr != nil {
+		errs = append(errs, common.PrefixError("Dbtr", v.Dbtr.Validate()))
+	}
+	if v.DbtrAcct != nil {
+		errs = append(errs, common.PrefixError("DbtrAcct", v.DbtrAcct.Validate()))
+	}
+	if v.UltmtDbtr != nil {
+		errs = append(errs, common.PrefixError("UltmtDbtr", v.UltmtDbtr.Validate()))
+	}
+	if v.Cdtr != nil {
+		errs = append(errs, common.PrefixError("Cdtr", v.Cdtr.Validate()))
+	}
+	if v.CdtrAcct != nil {
+		errs = append(errs, common.PrefixError("CdtrAcct", v.CdtrAcct.Validate()))
+	}
+	if v.UltmtCdtr != nil {
+		errs = append(errs, common.PrefixError("UltmtCdtr", v.UltmtCdtr.Validate()))
+	}
+	if v.TradgPty != nil {
+		errs = append(errs, common.PrefixError("TradgPty", v.TradgPty.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Prtry", common.CheckOccurs(len(v.Prtry), 0, -1)))
+	for i := range v.Prtry {
+		errs = append(errs, common.PrefixError("Prtry"+"["+strconv.Itoa(i)+"]", v.Prtry[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks TransactionPrice4Choice and its children against the schema.
+func (v TransactionPrice4Choice) Validate() error {
+	var errs []error
+	if v.DealPric != nil {
+		errs = append(errs, common.PrefixError("DealPric", v.DealPric.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Prtry", common.CheckOccurs(len(v.Prtry), 0, -1)))
+	for i := range v.Prtry {
+		errs = append(errs, common.PrefixError("Prtry"+"["+strconv.Itoa(i)+"]", v.Prtry[i].Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"DealPric", "Prtry"}, []bool{v.DealPric != nil, len(v.Prtry) > 0}))
+	return errors.Join(errs...)
+}
+
+// Validate checks TransactionQuantities3Choice and its children against the schema.
+func (v TransactionQuantities3Choice) Validate() error {
+	var errs []error
+	if v.Qty != nil {
+		errs = append(errs, common.PrefixError("Qty", v.Qty.Validate()))
+	}
+	if v.OrgnlAndCurFaceAmt != nil {
+		errs = append(errs, common.PrefixError("OrgnlAndCurFaceAmt", v.OrgnlAndCurFaceAmt.Validate()))
+	}
+	if v.Prtry != nil {
+		errs = append(errs, common.PrefixError("Prtry", v.Prtry.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Qty", "OrgnlAndCurFaceAmt", "Prtry"}, []bool{v.Qty != nil, v.OrgnlAndCurFaceAmt != nil, v.Prtry != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks TransactionReferences6 and its children against the schema.
+func (v TransactionReferences6) Validate() error {
+	var errs []error
+	if v.MsgId != nil {
+		errs = append(errs, common.PrefixError("MsgId", v.MsgId.Validate()))
+	}
+	if v.AcctSvcrRef != nil {
+		errs = append(errs, common.PrefixError("AcctSvcrRef", v.AcctSvcrRef.Validate()))
+	}
+	if v.PmtInfId != nil {
+		errs = append(errs, common.PrefixError("PmtInfId", v.PmtInfId.Validate()))
+	}
+	if v.InstrId != nil {
+		errs = append(errs, common.PrefixError("InstrId", v.InstrId.Validate()))
+	}
+	if v.EndToEndId != nil {
+		errs = append(errs, common.PrefixError("EndToEndId", v.EndToEndId.Validate()))
+	}
+	if v.UETR != nil {
+		errs = append(errs, common.PrefixError("UETR", v.UETR.Validate()))
+	}
+	if v.TxId != nil {
+		errs = append(errs, common.PrefixError("TxId", v.TxId.Validate()))
+	}
+	if v.MndtId != nil {
+		errs = append(errs, common.PrefixError("MndtId", v.MndtId.Validate()))
+	}
+	if v.ChqNb != nil {
+		errs = append(errs, common.PrefixError("ChqNb", v.ChqNb.Validate()))
+	}
+	if v.ClrSysRef != nil {
+		errs = append(errs, common.PrefixError("ClrSysRef", v.ClrSysRef.Validate()))
+	}
+	if v.AcctOwnrTxId != nil {
+		errs = append(errs, common.PrefixError("AcctOwnrTxId", v.AcctOwnrTxId.Validate()))
+	}
+	if v.AcctSvcrTxId != nil {
+		errs = append(errs, common.PrefixError("AcctSvcrTxId", v.AcctSvcrTxId.Validate()))
+	}
+	if v.MktInfrstrctrTxId != nil {
+		errs = append(errs, common.PrefixError("MktInfrstrctrTxId", v.MktInfrstrctrTxId.Validate()))
+	}
+	if v.PrcgId != nil {
+		errs = append(errs, common.PrefixError("PrcgId", v.PrcgId.Validate()))
+	}
+	errs = append(errs, common.PrefixError("Prtry", common.CheckOccurs(len(v.Prtry), 0, -1)))
+	for i := range v.Prtry {
+		errs = append(errs, common.PrefixError("Prtry"+"["+strconv.Itoa(i)+"]", v.Prtry[i].Validate()))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks YieldedOrValueType1Choice and its children against the schema.
+func (v YieldedOrValueType1Choice) Validate() error {
+	var errs []error
+	if v.Yldd != nil {
+		errs = append(errs, common.PrefixError("Yldd", v.Yldd.Validate()))
+	}
+	if v.ValTp != nil {
+		errs = append(errs, common.PrefixError("ValTp", v.ValTp.Validate()))
+	}
+	errs = append(errs, common.CheckChoice([]string{"Yldd", "ValTp"}, []bool{v.Yldd != nil, v.ValTp != nil}))
+	return errors.Join(errs...)
+}
+
+// Validate checks the facets of ActiveCurrencyAndAmountSimpleType.
+func (v ActiveCurrencyAndAmountSimpleType) Validate() error {
+	if err := common.CheckDecimal(strconv.FormatFloat(float64(v), 'f', -1, 64), 18, 5, "0"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ActiveCurrencyCode.
+func (v ActiveCurrencyCode) Validate() error {
+	if err := common.CheckPattern(string(v), patternActiveCurrencyCode); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ActiveOrHistoricCurrencyAnd13DecimalAmountSimpleType.
+func (v ActiveOrHistoricCurrencyAnd13DecimalAmountSimpleType) Validate() error {
+	if err := common.CheckDecimal(strconv.FormatFloat(float64(v), 'f', -1, 64), 18, 13, "0"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ActiveOrHistoricCurrencyAndAmountSimpleType.
+func (v ActiveOrHistoricCurrencyAndAmountSimpleType) Validate() error {
+	if err := common.CheckDecimal(strconv.FormatFloat(float64(v), 'f', -1, 64), 18, 5, "0"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ActiveOrHistoricCurrencyCode.
+func (v ActiveOrHistoricCurrencyCode) Validate() error {
+	if err := common.CheckPattern(string(v), patternActiveOrHistoricCurrencyCode); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of AddressType2Code.
+func (v AddressType2Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "ADDR", "PBOX", "HOME", "BIZZ", "MLTO", "DLVY"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of AnyBICDec2014Identifier.
+func (v AnyBICDec2014Identifier) Validate() error {
+	if err := common.CheckPattern(string(v), patternAnyBICDec2014Identifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of AttendanceContext1Code.
+func (v AttendanceContext1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "ATTD", "SATT", "UATT"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of AuthenticationEntity1Code.
+func (v AuthenticationEntity1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "ICCD", "AGNT", "MERC"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of AuthenticationMethod1Code.
+func (v AuthenticationMethod1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "UKNW", "BYPS", "NPIN", "FPIN", "CPSG", "PPSG", "MANU", "MERC", "SCRT", "SNCT", "SCNL"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of BICFIDec2014Identifier.
+func (v BICFIDec2014Identifier) Validate() error {
+	if err := common.CheckPattern(string(v), patternBICFIDec2014Identifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of BaseOneRate.
+func (v BaseOneRate) Validate() error {
+	if err := common.CheckDecimal(strconv.FormatFloat(float64(v), 'f', -1, 64), 11, 10, ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of CSCManagement1Code.
+func (v CSCManagement1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "PRST", "BYPS", "UNRD", "NCSC"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of CardDataReading1Code.
+func (v CardDataReading1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "TAGC", "PHYS", "BRCD", "MGST", "CICC", "DFLE", "CTLS", "ECTL"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of CardPaymentServiceType2Code.
+func (v CardPaymentServiceType2Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "AGGR", "DCCV", "GRTT", "INSP", "LOYT", "NRES", "PUCO", "RECP", "SOAF", "UNAF", "VCAU"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of CardholderVerificationCapability1Code.
+func (v CardholderVerificationCapability1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "MNSG", "NPIN", "FCPN", "FEPN", "FDSG", "FBIO", "MNVR", "FBIG", "APKI", "PKIS", "CHDT", "SCEC"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ChargeBearerType1Code.
+func (v ChargeBearerType1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "DEBT", "CRED", "SHAR", "SLEV"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ChargeIncludedIndicator.
+func (v ChargeIncludedIndicator) Validate() error {
+	return nil
+}
+
+// Validate checks the facets of CopyDuplicate1Code.
+func (v CopyDuplicate1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "CODU", "COPY", "DUPL"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of CountryCode.
+func (v CountryCode) Validate() error {
+	if err := common.CheckPattern(string(v), patternCountryCode); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of CreditDebitCode.
+func (v CreditDebitCode) Validate() error {
+	if err := common.CheckEnumeration(string(v), "CRDT", "DBIT"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of DecimalNumber.
+func (v DecimalNumber) Validate() error {
+	if err := common.CheckDecimal(strconv.FormatFloat(float64(v), 'f', -1, 64), 18, 17, ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of DocumentType3Code.
+func (v DocumentType3Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "RADM", "RPIN", "FXDR", "DISP", "PUOR", "SCOR"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of DocumentType6Code.
+func (v DocumentType6Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "MSIN", "CNFA", "DNFA", "CINV", "CREN", "DEBN", "HIRI", "SBIN", "CMCN", "SOAC", "DISP", "BOLD", "VCHR", "AROI", "TSUT", "PUOR"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Exact1NumericText.
+func (v Exact1NumericText) Validate() error {
+	if err := common.CheckPattern(string(v), patternExact1NumericText); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Exact3NumericText.
+func (v Exact3NumericText) Validate() error {
+	if err := common.CheckPattern(string(v), patternExact3NumericText); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Exact4AlphaNumericText.
+func (v Exact4AlphaNumericText) Validate() error {
+	if err := common.CheckPattern(string(v), patternExact4AlphaNumericText); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalAccountIdentification1Code.
+func (v ExternalAccountIdentification1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalBankTransactionDomain1Code.
+func (v ExternalBankTransactionDomain1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalBankTransactionFamily1Code.
+func (v ExternalBankTransactionFamily1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalBankTransactionSubFamily1Code.
+func (v ExternalBankTransactionSubFamily1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalCardTransactionCategory1Code.
+func (v ExternalCardTransactionCategory1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalCashAccountType1Code.
+func (v ExternalCashAccountType1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalChargeType1Code.
+func (v ExternalChargeType1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalClearingSystemIdentification1Code.
+func (v ExternalClearingSystemIdentification1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 5); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalDiscountAmountType1Code.
+func (v ExternalDiscountAmountType1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalDocumentLineType1Code.
+func (v ExternalDocumentLineType1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalEntryStatus1Code.
+func (v ExternalEntryStatus1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalFinancialInstitutionIdentification1Code.
+func (v ExternalFinancialInstitutionIdentification1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalFinancialInstrumentIdentificationType1Code.
+func (v ExternalFinancialInstrumentIdentificationType1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalGarnishmentType1Code.
+func (v ExternalGarnishmentType1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalLocalInstrument1Code.
+func (v ExternalLocalInstrument1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 35); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalOrganisationIdentification1Code.
+func (v ExternalOrganisationIdentification1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalPersonIdentification1Code.
+func (v ExternalPersonIdentification1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalProxyAccountType1Code.
+func (v ExternalProxyAccountType1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalPurpose1Code.
+func (v ExternalPurpose1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalRePresentmentReason1Code.
+func (v ExternalRePresentmentReason1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalReportingSource1Code.
+func (v ExternalReportingSource1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalReturnReason1Code.
+func (v ExternalReturnReason1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalTaxAmountType1Code.
+func (v ExternalTaxAmountType1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ExternalTechnicalInputChannel1Code.
+func (v ExternalTechnicalInputChannel1Code) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of IBAN2007Identifier.
+func (v IBAN2007Identifier) Validate() error {
+	if err := common.CheckPattern(string(v), patternIBAN2007Identifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ISINOct2015Identifier.
+func (v ISINOct2015Identifier) Validate() error {
+	if err := common.CheckPattern(string(v), patternISINOct2015Identifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ISO2ALanguageCode.
+func (v ISO2ALanguageCode) Validate() error {
+	if err := common.CheckPattern(string(v), patternISO2ALanguageCode); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of ISOYearMonth.
+func (v ISOYearMonth) Validate() error {
+	return nil
+}
+
+// Validate checks the facets of ImpliedCurrencyAndAmount.
+func (v ImpliedCurrencyAndAmount) Validate() error {
+	if err := common.CheckDecimal(strconv.FormatFloat(float64(v), 'f', -1, 64), 18, 5, "0"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of InterestType1Code.
+func (v InterestType1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "INDY", "OVRN"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of LEIIdentifier.
+func (v LEIIdentifier) Validate() error {
+	if err := common.CheckPattern(string(v), patternLEIIdentifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max1025Text.
+func (v Max1025Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 1025); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max105Text.
+func (v Max105Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 105); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max128Text.
+func (v Max128Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 128); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max140Text.
+func (v Max140Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 140); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max15NumericText.
+func (v Max15NumericText) Validate() error {
+	if err := common.CheckPattern(string(v), patternMax15NumericText); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max15PlusSignedNumericText.
+func (v Max15PlusSignedNumericText) Validate() error {
+	if err := common.CheckPattern(string(v), patternMax15PlusSignedNumericText); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max16Text.
+func (v Max16Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 16); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max2048Text.
+func (v Max2048Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 2048); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max34Text.
+func (v Max34Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 34); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max350Text.
+func (v Max350Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 350); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max35Text.
+func (v Max35Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 35); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max3NumericText.
+func (v Max3NumericText) Validate() error {
+	if err := common.CheckPattern(string(v), patternMax3NumericText); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max4Text.
+func (v Max4Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max500Text.
+func (v Max500Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 500); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max5NumericText.
+func (v Max5NumericText) Validate() error {
+	if err := common.CheckPattern(string(v), patternMax5NumericText); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Max70Text.
+func (v Max70Text) Validate() error {
+	if err := common.CheckLength(string(v), 1, 70); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Min2Max3NumericText.
+func (v Min2Max3NumericText) Validate() error {
+	if err := common.CheckPattern(string(v), patternMin2Max3NumericText); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Min3Max4NumericText.
+func (v Min3Max4NumericText) Validate() error {
+	if err := common.CheckPattern(string(v), patternMin3Max4NumericText); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Min8Max28NumericText.
+func (v Min8Max28NumericText) Validate() error {
+	if err := common.CheckPattern(string(v), patternMin8Max28NumericText); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of NamePrefix2Code.
+func (v NamePrefix2Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "DOCT", "MADM", "MISS", "MIST", "MIKS"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of NonNegativeDecimalNumber.
+func (v NonNegativeDecimalNumber) Validate() error {
+	if err := common.CheckDecimal(strconv.FormatFloat(float64(v), 'f', -1, 64), 18, 17, "0"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of Number.
+func (v Number) Validate() error {
+	if err := common.CheckDecimal(strconv.FormatFloat(float64(v), 'f', -1, 64), 18, 0, ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of OnLineCapability1Code.
+func (v OnLineCapability1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "OFLN", "ONLN", "SMON"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of POIComponentType1Code.
+func (v POIComponentType1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "SOFT", "EMVK", "EMVO", "MRIT", "CHIT", "SECM", "PEDV"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of PartyType3Code.
+func (v PartyType3Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "OPOI", "MERC", "ACCP", "ITAG", "ACQR", "CISS", "DLIS"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of PartyType4Code.
+func (v PartyType4Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "MERC", "ACCP", "ITAG", "ACQR", "CISS", "TAXH"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of PercentageRate.
+func (v PercentageRate) Validate() error {
+	if err := common.CheckDecimal(strconv.FormatFloat(float64(v), 'f', -1, 64), 11, 10, ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of PhoneNumber.
+func (v PhoneNumber) Validate() error {
+	if err := common.CheckPattern(string(v), patternPhoneNumber); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of PreferredContactMethod1Code.
+func (v PreferredContactMethod1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "LETT", "MAIL", "PHON", "FAXX", "CELL"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of PriceValueType1Code.
+func (v PriceValueType1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "DISC", "PREM", "PARV"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of RemittanceLocationMethod2Code.
+func (v RemittanceLocationMethod2Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "FAXI", "EDIC", "URID", "EMAL", "POST", "SMSM"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of TaxRecordPeriod1Code.
+func (v TaxRecordPeriod1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "MM01", "MM02", "MM03", "MM04", "MM05", "MM06", "MM07", "MM08", "MM09", "MM10", "MM11", "MM12", "QTR1", "QTR2", "QTR3", "QTR4", "HLF1", "HLF2"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of TransactionChannel1Code.
+func (v TransactionChannel1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "MAIL", "TLPH", "ECOM", "TVPY"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of TransactionEnvironment1Code.
+func (v TransactionEnvironment1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "MERC", "PRIV", "PUBL"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of TrueFalseIndicator.
+func (v TrueFalseIndicator) Validate() error {
+	return nil
+}
+
+// Validate checks the facets of UUIDv4Identifier.
+func (v UUIDv4Identifier) Validate() error {
+	if err := common.CheckPattern(string(v), patternUUIDv4Identifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of UnitOfMeasure1Code.
+func (v UnitOfMeasure1Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "PIEC", "TONS", "FOOT", "GBGA", "USGA", "GRAM", "INCH", "KILO", "PUND", "METR", "CMET", "MMET", "LITR", "CELI", "MILI", "GBOU", "USOU", "GBQA", "USQA", "GBPI", "USPI", "MILE", "KMET", "YARD", "SQKI", "HECT", "ARES", "SMET", "SCMT", "SMIL", "SQMI", "SQYA", "SQFO", "SQIN", "ACRE"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of UserInterface2Code.
+func (v UserInterface2Code) Validate() error {
+	if err := common.CheckEnumeration(string(v), "MDSP", "CDSP"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate checks the facets of YesNoIndicator.
+func (v YesNoIndicator) Validate() error {
+	return nil
+}
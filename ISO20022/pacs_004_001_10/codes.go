@@ -0,0 +1,305 @@
+// Code generated by scripts/gen_codes.go; DO NOT EDIT.
+
+package pacs_004_001_10
+
+// Codes of the ExternalReturnReason1Code external code list used by FedNow.
+const (
+	ExternalReturnReason1CodeAc03 ExternalReturnReason1Code = "AC03" // InvalidCreditorAccountNumber
+	ExternalReturnReason1CodeAc04 ExternalReturnReason1Code = "AC04" // ClosedAccountNumber
+	ExternalReturnReason1CodeAc06 ExternalReturnReason1Code = "AC06" // BlockedAccount
+	ExternalReturnReason1CodeAc07 ExternalReturnReason1Code = "AC07" // ClosedCreditorAccountNumber
+	ExternalReturnReason1CodeAg01 ExternalReturnReason1Code = "AG01" // TransactionForbidden
+	ExternalReturnReason1CodeAm09 ExternalReturnReason1Code = "AM09" // WrongAmount
+	ExternalReturnReason1CodeBe04 ExternalReturnReason1Code = "BE04" // MissingCreditorAddress
+	ExternalReturnReason1CodeCust ExternalReturnReason1Code = "CUST" // RequestedByCustomer
+	ExternalReturnReason1CodeDupl ExternalReturnReason1Code = "DUPL" // DuplicatePayment
+	ExternalReturnReason1CodeFocr ExternalReturnReason1Code = "FOCR" // FollowingCancellationRequest
+	ExternalReturnReason1CodeFr01 ExternalReturnReason1Code = "FR01" // Fraud
+	ExternalReturnReason1CodeMd07 ExternalReturnReason1Code = "MD07" // EndCustomerDeceased
+	ExternalReturnReason1CodeNarr ExternalReturnReason1Code = "NARR" // Narrative
+	ExternalReturnReason1CodeRr04 ExternalReturnReason1Code = "RR04" // RegulatoryReason
+	ExternalReturnReason1CodeUpay ExternalReturnReason1Code = "UPAY" // UnduePayment
+)
+
+// IsValid reports whether c is one of the ExternalReturnReason1Code codes used by FedNow.
+func (c ExternalReturnReason1Code) IsValid() bool {
+	switch c {
+	case ExternalReturnReason1CodeAc03,
+		ExternalReturnReason1CodeAc04,
+		ExternalReturnReason1CodeAc06,
+		ExternalReturnReason1CodeAc07,
+		ExternalReturnReason1CodeAg01,
+		ExternalReturnReason1CodeAm09,
+		ExternalReturnReason1CodeBe04,
+		ExternalReturnReason1CodeCust,
+		ExternalReturnReason1CodeDupl,
+		ExternalReturnReason1CodeFocr,
+		ExternalReturnReason1CodeFr01,
+		ExternalReturnReason1CodeMd07,
+		ExternalReturnReason1CodeNarr,
+		ExternalReturnReason1CodeRr04,
+		ExternalReturnReason1CodeUpay:
+		return true
+	}
+	return false
+}
+
+// Codes of the ExternalCashClearingSystem1Code external code list used by FedNow.
+const (
+	ExternalCashClearingSystem1CodeFdn ExternalCashClearingSystem1Code = "FDN" // FedNow
+)
+
+// IsValid reports whether c is one of the ExternalCashClearingSystem1Code codes used by FedNow.
+func (c ExternalCashClearingSystem1Code) IsValid() bool {
+	switch c {
+	case ExternalCashClearingSystem1CodeFdn:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the AddressType2Code codes.
+func (c AddressType2Code) IsValid() bool {
+	switch c {
+	case AddressType2CodeAddr,
+		AddressType2CodePbox,
+		AddressType2CodeHome,
+		AddressType2CodeBizz,
+		AddressType2CodeMlto,
+		AddressType2CodeDlvy:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the Authorisation1Code codes.
+func (c Authorisation1Code) IsValid() bool {
+	switch c {
+	case Authorisation1CodeAuth,
+		Authorisation1CodeFdet,
+		Authorisation1CodeFsum,
+		Authorisation1CodeIlev:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the ChargeBearerType1Code codes.
+func (c ChargeBearerType1Code) IsValid() bool {
+	switch c {
+	case ChargeBearerType1CodeDebt,
+		ChargeBearerType1CodeCred,
+		ChargeBearerType1CodeShar,
+		ChargeBearerType1CodeSlev:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the ClearingChannel2Code codes.
+func (c ClearingChannel2Code) IsValid() bool {
+	switch c {
+	case ClearingChannel2CodeRtgs,
+		ClearingChannel2CodeRtns,
+		ClearingChannel2CodeMpns,
+		ClearingChannel2CodeBook:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the CreditDebitCode codes.
+func (c CreditDebitCode) IsValid() bool {
+	switch c {
+	case CreditDebitCodeCrdt,
+		CreditDebitCodeDbit:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the DocumentType3Code codes.
+func (c DocumentType3Code) IsValid() bool {
+	switch c {
+	case DocumentType3CodeRadm,
+		DocumentType3CodeRpin,
+		DocumentType3CodeFxdr,
+		DocumentType3CodeDisp,
+		DocumentType3CodePuor,
+		DocumentType3CodeScor:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the DocumentType6Code codes.
+func (c DocumentType6Code) IsValid() bool {
+	switch c {
+	case DocumentType6CodeMsin,
+		DocumentType6CodeCnfa,
+		DocumentType6CodeDnfa,
+		DocumentType6CodeCinv,
+		DocumentType6CodeCren,
+		DocumentType6CodeDebn,
+		DocumentType6CodeHiri,
+		DocumentType6CodeSbin,
+		DocumentType6CodeCmcn,
+		DocumentType6CodeSoac,
+		DocumentType6CodeDisp,
+		DocumentType6CodeBold,
+		DocumentType6CodeVchr,
+		DocumentType6CodeAroi,
+		DocumentType6CodeTsut,
+		DocumentType6CodePuor:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the Frequency6Code codes.
+func (c Frequency6Code) IsValid() bool {
+	switch c {
+	case Frequency6CodeYear,
+		Frequency6CodeMnth,
+		Frequency6CodeQurt,
+		Frequency6CodeMian,
+		Frequency6CodeWeek,
+		Frequency6CodeDail,
+		Frequency6CodeAdho,
+		Frequency6CodeInda,
+		Frequency6CodeFrtn:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the Instruction4Code codes.
+func (c Instruction4Code) IsValid() bool {
+	switch c {
+	case Instruction4CodePhoa,
+		Instruction4CodeTela:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the MandateClassification1Code codes.
+func (c MandateClassification1Code) IsValid() bool {
+	switch c {
+	case MandateClassification1CodeFixe,
+		MandateClassification1CodeUsgb,
+		MandateClassification1CodeVari:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the NamePrefix2Code codes.
+func (c NamePrefix2Code) IsValid() bool {
+	switch c {
+	case NamePrefix2CodeDoct,
+		NamePrefix2CodeMadm,
+		NamePrefix2CodeMiss,
+		NamePrefix2CodeMist,
+		NamePrefix2CodeMiks:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the PaymentMethod4Code codes.
+func (c PaymentMethod4Code) IsValid() bool {
+	switch c {
+	case PaymentMethod4CodeChk,
+		PaymentMethod4CodeTrf,
+		PaymentMethod4CodeDd,
+		PaymentMethod4CodeTra:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the PreferredContactMethod1Code codes.
+func (c PreferredContactMethod1Code) IsValid() bool {
+	switch c {
+	case PreferredContactMethod1CodeLett,
+		PreferredContactMethod1CodeMail,
+		PreferredContactMethod1CodePhon,
+		PreferredContactMethod1CodeFaxx,
+		PreferredContactMethod1CodeCell:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the Priority2Code codes.
+func (c Priority2Code) IsValid() bool {
+	switch c {
+	case Priority2CodeHigh,
+		Priority2CodeNorm:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the Priority3Code codes.
+func (c Priority3Code) IsValid() bool {
+	switch c {
+	case Priority3CodeUrgt,
+		Priority3CodeHigh,
+		Priority3CodeNorm:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the SequenceType3Code codes.
+func (c SequenceType3Code) IsValid() bool {
+	switch c {
+	case SequenceType3CodeFrst,
+		SequenceType3CodeRcur,
+		SequenceType3CodeFnal,
+		SequenceType3CodeOoff,
+		SequenceType3CodeRpre:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the SettlementMethod1Code codes.
+func (c SettlementMethod1Code) IsValid() bool {
+	switch c {
+	case SettlementMethod1CodeInda,
+		SettlementMethod1CodeInga,
+		SettlementMethod1CodeCove,
+		SettlementMethod1CodeClrg:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the TaxRecordPeriod1Code codes.
+func (c TaxRecordPeriod1Code) IsValid() bool {
+	switch c {
+	case TaxRecordPeriod1CodeMm01,
+		TaxRecordPeriod1CodeMm02,
+		TaxRecordPeriod1CodeMm03,
+		TaxRecordPeriod1CodeMm04,
+		TaxRecordPeriod1CodeMm05,
+		TaxRecordPeriod1CodeMm06,
+		TaxRecordPeriod1CodeMm07,
+		TaxRecordPeriod1CodeMm08,
+		TaxRecordPeriod1CodeMm09,
+		TaxRecordPeriod1CodeMm10,
+		TaxRecordPeriod1CodeMm11,
+		TaxRecordPeriod1CodeMm12,
+		TaxRecordPeriod1CodeQtr1,
+		TaxRecordPeriod1CodeQtr2,
+		TaxRecordPeriod1CodeQtr3,
+		TaxRecordPeriod1CodeQtr4,
+		TaxRecordPeriod1CodeHlf1,
+		TaxRecordPeriod1CodeHlf2:
+		return true
+	}
+	return false
+}
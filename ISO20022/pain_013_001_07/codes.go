@@ -0,0 +1,219 @@
+// Code generated by scripts/gen_codes.go; DO NOT EDIT.
+
+package pain_013_001_07
+
+// IsValid reports whether c is one of the AddressType2Code codes.
+func (c AddressType2Code) IsValid() bool {
+	switch c {
+	case AddressType2CodeAddr,
+		AddressType2CodePbox,
+		AddressType2CodeHome,
+		AddressType2CodeBizz,
+		AddressType2CodeMlto,
+		AddressType2CodeDlvy:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the ChargeBearerType1Code codes.
+func (c ChargeBearerType1Code) IsValid() bool {
+	switch c {
+	case ChargeBearerType1CodeDebt,
+		ChargeBearerType1CodeCred,
+		ChargeBearerType1CodeShar,
+		ChargeBearerType1CodeSlev:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the ChequeDelivery1Code codes.
+func (c ChequeDelivery1Code) IsValid() bool {
+	switch c {
+	case ChequeDelivery1CodeMldb,
+		ChequeDelivery1CodeMlcd,
+		ChequeDelivery1CodeMlfa,
+		ChequeDelivery1CodeCrdb,
+		ChequeDelivery1CodeCrcd,
+		ChequeDelivery1CodeCrfa,
+		ChequeDelivery1CodePudb,
+		ChequeDelivery1CodePucd,
+		ChequeDelivery1CodePufa,
+		ChequeDelivery1CodeRgdb,
+		ChequeDelivery1CodeRgcd,
+		ChequeDelivery1CodeRgfa:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the ChequeType2Code codes.
+func (c ChequeType2Code) IsValid() bool {
+	switch c {
+	case ChequeType2CodeCchq,
+		ChequeType2CodeCcch,
+		ChequeType2CodeBchq,
+		ChequeType2CodeDrft,
+		ChequeType2CodeEldr:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the CreditDebitCode codes.
+func (c CreditDebitCode) IsValid() bool {
+	switch c {
+	case CreditDebitCodeCrdt,
+		CreditDebitCodeDbit:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the DocumentType3Code codes.
+func (c DocumentType3Code) IsValid() bool {
+	switch c {
+	case DocumentType3CodeRadm,
+		DocumentType3CodeRpin,
+		DocumentType3CodeFxdr,
+		DocumentType3CodeDisp,
+		DocumentType3CodePuor,
+		DocumentType3CodeScor:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the DocumentType6Code codes.
+func (c DocumentType6Code) IsValid() bool {
+	switch c {
+	case DocumentType6CodeMsin,
+		DocumentType6CodeCnfa,
+		DocumentType6CodeDnfa,
+		DocumentType6CodeCinv,
+		DocumentType6CodeCren,
+		DocumentType6CodeDebn,
+		DocumentType6CodeHiri,
+		DocumentType6CodeSbin,
+		DocumentType6CodeCmcn,
+		DocumentType6CodeSoac,
+		DocumentType6CodeDisp,
+		DocumentType6CodeBold,
+		DocumentType6CodeVchr,
+		DocumentType6CodeAroi,
+		DocumentType6CodeTsut,
+		DocumentType6CodePuor:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the Instruction3Code codes.
+func (c Instruction3Code) IsValid() bool {
+	switch c {
+	case Instruction3CodeChqb,
+		Instruction3CodeHold,
+		Instruction3CodePhob,
+		Instruction3CodeTelb:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the NamePrefix2Code codes.
+func (c NamePrefix2Code) IsValid() bool {
+	switch c {
+	case NamePrefix2CodeDoct,
+		NamePrefix2CodeMadm,
+		NamePrefix2CodeMiss,
+		NamePrefix2CodeMist,
+		NamePrefix2CodeMiks:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the PaymentMethod7Code codes.
+func (c PaymentMethod7Code) IsValid() bool {
+	switch c {
+	case PaymentMethod7CodeChk,
+		PaymentMethod7CodeTrf:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the PreferredContactMethod1Code codes.
+func (c PreferredContactMethod1Code) IsValid() bool {
+	switch c {
+	case PreferredContactMethod1CodeLett,
+		PreferredContactMethod1CodeMail,
+		PreferredContactMethod1CodePhon,
+		PreferredContactMethod1CodeFaxx,
+		PreferredContactMethod1CodeCell:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the Priority2Code codes.
+func (c Priority2Code) IsValid() bool {
+	switch c {
+	case Priority2CodeHigh,
+		Priority2CodeNorm:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the RegulatoryReportingType1Code codes.
+func (c RegulatoryReportingType1Code) IsValid() bool {
+	switch c {
+	case RegulatoryReportingType1CodeCred,
+		RegulatoryReportingType1CodeDebt,
+		RegulatoryReportingType1CodeBoth:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the RemittanceLocationMethod2Code codes.
+func (c RemittanceLocationMethod2Code) IsValid() bool {
+	switch c {
+	case RemittanceLocationMethod2CodeFaxi,
+		RemittanceLocationMethod2CodeEdic,
+		RemittanceLocationMethod2CodeUrid,
+		RemittanceLocationMethod2CodeEmal,
+		RemittanceLocationMethod2CodePost,
+		RemittanceLocationMethod2CodeSmsm:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the TaxRecordPeriod1Code codes.
+func (c TaxRecordPeriod1Code) IsValid() bool {
+	switch c {
+	case TaxRecordPeriod1CodeMm01,
+		TaxRecordPeriod1CodeMm02,
+		TaxRecordPeriod1CodeMm03,
+		TaxRecordPeriod1CodeMm04,
+		TaxRecordPeriod1CodeMm05,
+		TaxRecordPeriod1CodeMm06,
+		TaxRecordPeriod1CodeMm07,
+		TaxRecordPeriod1CodeMm08,
+		TaxRecordPeriod1CodeMm09,
+		TaxRecordPeriod1CodeMm10,
+		TaxRecordPeriod1CodeMm11,
+		TaxRecordPeriod1CodeMm12,
+		TaxRecordPeriod1CodeQtr1,
+		TaxRecordPeriod1CodeQtr2,
+		TaxRecordPeriod1CodeQtr3,
+		TaxRecordPeriod1CodeQtr4,
+		TaxRecordPeriod1CodeHlf1,
+		TaxRecordPeriod1CodeHlf2:
+		return true
+	}
+	return false
+}
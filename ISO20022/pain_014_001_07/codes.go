@@ -0,0 +1,269 @@
+// Code generated by scripts/gen_codes.go; DO NOT EDIT.
+
+package pain_014_001_07
+
+// Codes of the ExternalPaymentTransactionStatus1Code external code list used by FedNow.
+const (
+	ExternalPaymentTransactionStatus1CodeAccc ExternalPaymentTransactionStatus1Code = "ACCC" // AcceptedSettlementCompletedCreditorAccount
+	ExternalPaymentTransactionStatus1CodeAccp ExternalPaymentTransactionStatus1Code = "ACCP" // AcceptedCustomerProfile
+	ExternalPaymentTransactionStatus1CodeAcsc ExternalPaymentTransactionStatus1Code = "ACSC" // AcceptedSettlementCompleted
+	ExternalPaymentTransactionStatus1CodeAcsp ExternalPaymentTransactionStatus1Code = "ACSP" // AcceptedSettlementInProcess
+	ExternalPaymentTransactionStatus1CodeActc ExternalPaymentTransactionStatus1Code = "ACTC" // AcceptedTechnicalValidation
+	ExternalPaymentTransactionStatus1CodeAcwc ExternalPaymentTransactionStatus1Code = "ACWC" // AcceptedWithChange
+	ExternalPaymentTransactionStatus1CodeAcwp ExternalPaymentTransactionStatus1Code = "ACWP" // AcceptedWithoutPosting
+	ExternalPaymentTransactionStatus1CodeBlck ExternalPaymentTransactionStatus1Code = "BLCK" // Blocked
+	ExternalPaymentTransactionStatus1CodePdng ExternalPaymentTransactionStatus1Code = "PDNG" // Pending
+	ExternalPaymentTransactionStatus1CodeRcvd ExternalPaymentTransactionStatus1Code = "RCVD" // Received
+	ExternalPaymentTransactionStatus1CodeRjct ExternalPaymentTransactionStatus1Code = "RJCT" // Rejected
+)
+
+// IsValid reports whether c is one of the ExternalPaymentTransactionStatus1Code codes used by FedNow.
+func (c ExternalPaymentTransactionStatus1Code) IsValid() bool {
+	switch c {
+	case ExternalPaymentTransactionStatus1CodeAccc,
+		ExternalPaymentTransactionStatus1CodeAccp,
+		ExternalPaymentTransactionStatus1CodeAcsc,
+		ExternalPaymentTransactionStatus1CodeAcsp,
+		ExternalPaymentTransactionStatus1CodeActc,
+		ExternalPaymentTransactionStatus1CodeAcwc,
+		ExternalPaymentTransactionStatus1CodeAcwp,
+		ExternalPaymentTransactionStatus1CodeBlck,
+		ExternalPaymentTransactionStatus1CodePdng,
+		ExternalPaymentTransactionStatus1CodeRcvd,
+		ExternalPaymentTransactionStatus1CodeRjct:
+		return true
+	}
+	return false
+}
+
+// Codes of the ExternalStatusReason1Code external code list used by FedNow.
+const (
+	ExternalStatusReason1CodeAc02 ExternalStatusReason1Code = "AC02" // InvalidDebtorAccountNumber
+	ExternalStatusReason1CodeAc03 ExternalStatusReason1Code = "AC03" // InvalidCreditorAccountNumber
+	ExternalStatusReason1CodeAc04 ExternalStatusReason1Code = "AC04" // ClosedAccountNumber
+	ExternalStatusReason1CodeAc06 ExternalStatusReason1Code = "AC06" // BlockedAccount
+	ExternalStatusReason1CodeAc07 ExternalStatusReason1Code = "AC07" // ClosedCreditorAccountNumber
+	ExternalStatusReason1CodeAc10 ExternalStatusReason1Code = "AC10" // InvalidDebtorAccountCurrency
+	ExternalStatusReason1CodeAc11 ExternalStatusReason1Code = "AC11" // InvalidCreditorAccountCurrency
+	ExternalStatusReason1CodeAc13 ExternalStatusReason1Code = "AC13" // InvalidDebtorAccountType
+	ExternalStatusReason1CodeAc14 ExternalStatusReason1Code = "AC14" // InvalidCreditorAccountType
+	ExternalStatusReason1CodeAg01 ExternalStatusReason1Code = "AG01" // TransactionForbidden
+	ExternalStatusReason1CodeAg03 ExternalStatusReason1Code = "AG03" // TransactionNotSupported
+	ExternalStatusReason1CodeAgnt ExternalStatusReason1Code = "AGNT" // IncorrectAgent
+	ExternalStatusReason1CodeAm02 ExternalStatusReason1Code = "AM02" // NotAllowedAmount
+	ExternalStatusReason1CodeAm04 ExternalStatusReason1Code = "AM04" // InsufficientFunds
+	ExternalStatusReason1CodeAm05 ExternalStatusReason1Code = "AM05" // Duplication
+	ExternalStatusReason1CodeAm09 ExternalStatusReason1Code = "AM09" // WrongAmount
+	ExternalStatusReason1CodeAm12 ExternalStatusReason1Code = "AM12" // InvalidAmount
+	ExternalStatusReason1CodeBe04 ExternalStatusReason1Code = "BE04" // MissingCreditorAddress
+	ExternalStatusReason1CodeBe07 ExternalStatusReason1Code = "BE07" // MissingDebtorAddress
+	ExternalStatusReason1CodeBe10 ExternalStatusReason1Code = "BE10" // InvalidDebtorCountry
+	ExternalStatusReason1CodeBe11 ExternalStatusReason1Code = "BE11" // InvalidCreditorCountry
+	ExternalStatusReason1CodeBe16 ExternalStatusReason1Code = "BE16" // InvalidDebtorIdentificationCode
+	ExternalStatusReason1CodeBe17 ExternalStatusReason1Code = "BE17" // InvalidCreditorIdentificationCode
+	ExternalStatusReason1CodeCust ExternalStatusReason1Code = "CUST" // RequestedByCustomer
+	ExternalStatusReason1CodeDs24 ExternalStatusReason1Code = "DS24" // WaitingTimeExpired
+	ExternalStatusReason1CodeDt04 ExternalStatusReason1Code = "DT04" // FutureDateNotSupported
+	ExternalStatusReason1CodeDupl ExternalStatusReason1Code = "DUPL" // DuplicatePayment
+	ExternalStatusReason1CodeFf02 ExternalStatusReason1Code = "FF02" // SyntaxError
+	ExternalStatusReason1CodeFf03 ExternalStatusReason1Code = "FF03" // InvalidPaymentTypeInformation
+	ExternalStatusReason1CodeFf08 ExternalStatusReason1Code = "FF08" // InvalidEndToEndId
+	ExternalStatusReason1CodeMd07 ExternalStatusReason1Code = "MD07" // EndCustomerDeceased
+	ExternalStatusReason1CodeNarr ExternalStatusReason1Code = "NARR" // Narrative
+	ExternalStatusReason1CodeRc01 ExternalStatusReason1Code = "RC01" // BankIdentifierIncorrect
+	ExternalStatusReason1CodeRc02 ExternalStatusReason1Code = "RC02" // InvalidBankIdentifier
+	ExternalStatusReason1CodeRc03 ExternalStatusReason1Code = "RC03" // InvalidDebtorBankIdentifier
+	ExternalStatusReason1CodeRc04 ExternalStatusReason1Code = "RC04" // InvalidCreditorBankIdentifier
+	ExternalStatusReason1CodeRr04 ExternalStatusReason1Code = "RR04" // RegulatoryReason
+	ExternalStatusReason1CodeTm01 ExternalStatusReason1Code = "TM01" // InvalidCutOffTime
+	ExternalStatusReason1Code1100 ExternalStatusReason1Code = "1100" // AnyOtherReasons
+	ExternalStatusReason1Code9909 ExternalStatusReason1Code = "9909" // CentralSystemMalfunction
+	ExternalStatusReason1Code9910 ExternalStatusReason1Code = "9910" // InstructedAgentSignedOff
+	ExternalStatusReason1Code9912 ExternalStatusReason1Code = "9912" // RecipientConnectionNotAvailable
+)
+
+// IsValid reports whether c is one of the ExternalStatusReason1Code codes used by FedNow.
+func (c ExternalStatusReason1Code) IsValid() bool {
+	switch c {
+	case ExternalStatusReason1CodeAc02,
+		ExternalStatusReason1CodeAc03,
+		ExternalStatusReason1CodeAc04,
+		ExternalStatusReason1CodeAc06,
+		ExternalStatusReason1CodeAc07,
+		ExternalStatusReason1CodeAc10,
+		ExternalStatusReason1CodeAc11,
+		ExternalStatusReason1CodeAc13,
+		ExternalStatusReason1CodeAc14,
+		ExternalStatusReason1CodeAg01,
+		ExternalStatusReason1CodeAg03,
+		ExternalStatusReason1CodeAgnt,
+		ExternalStatusReason1CodeAm02,
+		ExternalStatusReason1CodeAm04,
+		ExternalStatusReason1CodeAm05,
+		ExternalStatusReason1CodeAm09,
+		ExternalStatusReason1CodeAm12,
+		ExternalStatusReason1CodeBe04,
+		ExternalStatusReason1CodeBe07,
+		ExternalStatusReason1CodeBe10,
+		ExternalStatusReason1CodeBe11,
+		ExternalStatusReason1CodeBe16,
+		ExternalStatusReason1CodeBe17,
+		ExternalStatusReason1CodeCust,
+		ExternalStatusReason1CodeDs24,
+		ExternalStatusReason1CodeDt04,
+		ExternalStatusReason1CodeDupl,
+		ExternalStatusReason1CodeFf02,
+		ExternalStatusReason1CodeFf03,
+		ExternalStatusReason1CodeFf08,
+		ExternalStatusReason1CodeMd07,
+		ExternalStatusReason1CodeNarr,
+		ExternalStatusReason1CodeRc01,
+		ExternalStatusReason1CodeRc02,
+		ExternalStatusReason1CodeRc03,
+		ExternalStatusReason1CodeRc04,
+		ExternalStatusReason1CodeRr04,
+		ExternalStatusReason1CodeTm01,
+		ExternalStatusReason1Code1100,
+		ExternalStatusReason1Code9909,
+		ExternalStatusReason1Code9910,
+		ExternalStatusReason1Code9912:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the AddressType2Code codes.
+func (c AddressType2Code) IsValid() bool {
+	switch c {
+	case AddressType2CodeAddr,
+		AddressType2CodePbox,
+		AddressType2CodeHome,
+		AddressType2CodeBizz,
+		AddressType2CodeMlto,
+		AddressType2CodeDlvy:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the CreditDebitCode codes.
+func (c CreditDebitCode) IsValid() bool {
+	switch c {
+	case CreditDebitCodeCrdt,
+		CreditDebitCodeDbit:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the DocumentType3Code codes.
+func (c DocumentType3Code) IsValid() bool {
+	switch c {
+	case DocumentType3CodeRadm,
+		DocumentType3CodeRpin,
+		DocumentType3CodeFxdr,
+		DocumentType3CodeDisp,
+		DocumentType3CodePuor,
+		DocumentType3CodeScor:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the DocumentType6Code codes.
+func (c DocumentType6Code) IsValid() bool {
+	switch c {
+	case DocumentType6CodeMsin,
+		DocumentType6CodeCnfa,
+		DocumentType6CodeDnfa,
+		DocumentType6CodeCinv,
+		DocumentType6CodeCren,
+		DocumentType6CodeDebn,
+		DocumentType6CodeHiri,
+		DocumentType6CodeSbin,
+		DocumentType6CodeCmcn,
+		DocumentType6CodeSoac,
+		DocumentType6CodeDisp,
+		DocumentType6CodeBold,
+		DocumentType6CodeVchr,
+		DocumentType6CodeAroi,
+		DocumentType6CodeTsut,
+		DocumentType6CodePuor:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the NamePrefix2Code codes.
+func (c NamePrefix2Code) IsValid() bool {
+	switch c {
+	case NamePrefix2CodeDoct,
+		NamePrefix2CodeMadm,
+		NamePrefix2CodeMiss,
+		NamePrefix2CodeMist,
+		NamePrefix2CodeMiks:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the PaymentMethod4Code codes.
+func (c PaymentMethod4Code) IsValid() bool {
+	switch c {
+	case PaymentMethod4CodeChk,
+		PaymentMethod4CodeTrf,
+		PaymentMethod4CodeDd,
+		PaymentMethod4CodeTra:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the PreferredContactMethod1Code codes.
+func (c PreferredContactMethod1Code) IsValid() bool {
+	switch c {
+	case PreferredContactMethod1CodeLett,
+		PreferredContactMethod1CodeMail,
+		PreferredContactMethod1CodePhon,
+		PreferredContactMethod1CodeFaxx,
+		PreferredContactMethod1CodeCell:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the Priority2Code codes.
+func (c Priority2Code) IsValid() bool {
+	switch c {
+	case Priority2CodeHigh,
+		Priority2CodeNorm:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether c is one of the TaxRecordPeriod1Code codes.
+func (c TaxRecordPeriod1Code) IsValid() bool {
+	switch c {
+	case TaxRecordPeriod1CodeMm01,
+		TaxRecordPeriod1CodeMm02,
+		TaxRecordPeriod1CodeMm03,
+		TaxRecordPeriod1CodeMm04,
+		TaxRecordPeriod1CodeMm05,
+		TaxRecordPeriod1CodeMm06,
+		TaxRecordPeriod1CodeMm07,
+		TaxRecordPeriod1CodeMm08,
+		TaxRecordPeriod1CodeMm09,
+		TaxRecordPeriod1CodeMm10,
+		TaxRecordPeriod1CodeMm11,
+		TaxRecordPeriod1CodeMm12,
+		TaxRecordPeriod1CodeQtr1,
+		TaxRecordPeriod1CodeQtr2,
+		TaxRecordPeriod1CodeQtr3,
+		TaxRecordPeriod1CodeQtr4,
+		TaxRecordPeriod1CodeHlf1,
+		TaxRecordPeriod1CodeHlf2:
+		return true
+	}
+	return false
+}
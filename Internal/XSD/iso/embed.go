@@ -0,0 +1,10 @@
+// Package iso embeds the ISO 20022 message XSDs in this directory, from which
+// the models in ISO20022 are generated.
+package iso
+
+import "embed"
+
+// FS holds the *.xsd files of this directory.
+//
+//go:embed *.xsd
+var FS embed.FS
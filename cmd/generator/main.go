@@ -1,7 +1,6 @@
 package main
 
 import (
-	"encoding/json"
 	"flag"
 	"fmt"
 	"io/ioutil"
@@ -9,8 +8,6 @@ import (
 
 	"github.com/mbanq/iso20022-go/pkg/fednow"
 	"github.com/mbanq/iso20022-go/pkg/fednow/config"
-	"github.com/mbanq/iso20022-go/pkg/fednow/pacs"
-	"github.com/mbanq/iso20022-go/pkg/fednow/pain"
 )
 
 func main() {
@@ -40,41 +37,22 @@ func main() {
 		return
 	}
 
-	var fednowMessage fednow.FedNowMessage
+	// Reject bad input before json.Unmarshal zero-fills missing fields.
+	if err := fednow.Validate(*messageId, jsonFile); err != nil {
+		fmt.Printf("Invalid payload for %s:\n%v\n", *messageId, err)
+		os.Exit(1)
+	}
 
-	switch *messageId {
-	case "pacs.008.001.08":
-		var msg pacs.FedNowMessageCCT
-		if err := json.Unmarshal(jsonFile, &msg); err != nil {
-			fmt.Printf("Error unmarshalling json for pacs.008: %s\n", err)
-			return
-		}
-		fednowMessage = msg
-	case "pacs.002.001.10":
-		var msg pacs.FedNowMessageACK
-		if err := json.Unmarshal(jsonFile, &msg); err != nil {
-			fmt.Printf("Error unmarshalling json for pacs.002: %s\n", err)
-			return
-		}
-		fednowMessage = msg
-	case "pacs.004.001.10":
-		var msg pacs.FedNowMessageRtn
-		if err := json.Unmarshal(jsonFile, &msg); err != nil {
-			fmt.Printf("Error unmarshalling json for pacs.004: %s\n", err)
-			return
-		}
-		fednowMessage = msg
-	case "pain.013.001.07":
-		var msg pain.FedNowMessageRFP
-		if err := json.Unmarshal(jsonFile, &msg); err != nil {
-			fmt.Printf("Error unmarshalling json for pain.013: %s\n", err)
-			return
-		}
-		fednowMessage = msg
-	default:
+	registered, ok := fednow.Lookup(*messageId)
+	if !ok {
 		fmt.Printf("unsupported message type: %s\n", *messageId)
 		return
 	}
+	fednowMessage, err := registered.Unmarshal(jsonFile)
+	if err != nil {
+		fmt.Printf("Error unmarshalling json for %s: %s\n", *messageId, err)
+		return
+	}
 
 	xmlData, err := fednow.Generate(*xsdPath, *messageId, config, fednowMessage)
 	if err != nil {
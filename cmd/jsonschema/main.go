@@ -0,0 +1,48 @@
+// Command jsonschema writes the JSON Schema of every registered FedNow payload type to
+// a directory, one <messageType>.schema.json file per message.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/mbanq/iso20022-go/pkg/fednow"
+	"github.com/mbanq/iso20022-go/pkg/fednow/jsonschema"
+	"github.com/mbanq/iso20022-go/pkg/xsd"
+)
+
+func main() {
+	xsdDir := flag.String("xsd", "Internal/XSD/iso", "Directory of the ISO XSD files")
+	outDir := flag.String("out", "schemas", "Directory to write the schemas to")
+	flag.Parse()
+
+	xsds, err := xsd.LoadDir(*xsdDir)
+	if err != nil {
+		fmt.Printf("failed to load XSDs: %s\n", err)
+		os.Exit(1)
+	}
+	if err := os.MkdirAll(*outDir, 0o755); err != nil {
+		fmt.Printf("failed to create output directory: %s\n", err)
+		os.Exit(1)
+	}
+
+	generator := jsonschema.NewGenerator(xsds)
+	for _, m := range fednow.Registered() {
+		if m.Payload == nil {
+			continue
+		}
+		data, err := jsonschema.Marshal(generator.Generate(m.MessageType, m.Payload))
+		if err != nil {
+			fmt.Printf("failed to marshal %s schema: %s\n", m.MessageType, err)
+			os.Exit(1)
+		}
+		path := filepath.Join(*outDir, jsonschema.FileName(m.MessageType))
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			fmt.Printf("failed to write %s: %s\n", path, err)
+			os.Exit(1)
+		}
+		fmt.Printf("wrote %s\n", path)
+	}
+}
@@ -0,0 +1,253 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// PostalAddress mirrors the ISO 20022 PostalAddress24 component. It is shared
+// by every message package so that a single address shape (and a single
+// validation policy) applies regardless of the underlying generated model.
+type PostalAddress struct {
+	AddressType        *AddressType `json:"AddressType,omitempty"`
+	Department         *string      `json:"Department,omitempty"`
+	SubDepartment      *string      `json:"SubDepartment,omitempty"`
+	StreetName         *string      `json:"StreetName"`
+	BuildingNumber     *string      `json:"BuildingNumber"`
+	BuildingName       *string      `json:"BuildingName,omitempty"`
+	Floor              *string      `json:"Floor,omitempty"`
+	PostBox            *string      `json:"PostBox"`
+	Room               *string      `json:"Room,omitempty"`
+	PostalCode         *string      `json:"PostalCode"`
+	TownName           *string      `json:"TownName"`
+	TownLocationName   *string      `json:"TownLocationName,omitempty"`
+	DistrictName       *string      `json:"DistrictName,omitempty"`
+	CountrySubdivision *string      `json:"CountrySubDivision"`
+	Country            *string      `json:"Country"`
+	AddressLine        []string     `json:"AddressLine,omitempty"`
+
+	// PostalBox is the name pain.013 payloads gave PostBox before the address
+	// model was shared. UnmarshalJSON moves it to PostBox.
+	//
+	// Deprecated: use PostBox.
+	PostalBox *string `json:"PostalBox,omitempty"`
+}
+
+// UnmarshalJSON decodes an address, accepting the pain.013 spelling
+// "PostalBox" for "PostBox".
+func (address *PostalAddress) UnmarshalJSON(data []byte) error {
+	type plain PostalAddress
+	if err := json.Unmarshal(data, (*plain)(address)); err != nil {
+		return err
+	}
+	if address.PostBox == nil {
+		address.PostBox = address.PostalBox
+	}
+	address.PostalBox = nil
+	return nil
+}
+
+// AddressType mirrors the AddressType3Choice component: either an ISO
+// AddressType2Code or a proprietary identification.
+type AddressType struct {
+	Code        *string                `json:"Code,omitempty"`
+	Proprietary *GenericIdentification `json:"Proprietary,omitempty"`
+}
+
+// GenericIdentification mirrors the GenericIdentification30 component.
+type GenericIdentification struct {
+	Id         string  `json:"Id"`
+	Issuer     string  `json:"Issuer"`
+	SchemeName *string `json:"SchemeName,omitempty"`
+}
+
+// AddressValidationMode selects how a PostalAddress is expected to be populated.
+type AddressValidationMode string
+
+const (
+	// AddressStructured requires discrete address elements and forbids AdrLine.
+	AddressStructured AddressValidationMode = "structured"
+
+	// AddressUnstructured requires AdrLine and forbids discrete elements other
+	// than Country.
+	AddressUnstructured AddressValidationMode = "unstructured"
+
+	// AddressHybrid requires TownName and Country and allows up to two
+	// AdrLine entries alongside the discrete elements.
+	AddressHybrid AddressValidationMode = "hybrid"
+)
+
+const (
+	maxAddressLines       = 7
+	maxHybridAddressLines = 2
+)
+
+// AddressPolicy configures address validation. The zero value is the
+// structured policy with the fields FedNow has historically required.
+type AddressPolicy struct {
+	Mode AddressValidationMode `json:"mode,omitempty"`
+	// RequiredFields overrides the default required fields for the mode. Names
+	// are the PostalAddress field names (e.g. "StreetName", "Country").
+	RequiredFields []string `json:"requiredFields,omitempty"`
+}
+
+// DefaultAddressPolicy is the policy applied when none is configured.
+var DefaultAddressPolicy = AddressPolicy{Mode: AddressStructured}
+
+var defaultRequiredAddressFields = map[AddressValidationMode][]string{
+	AddressStructured:   {"StreetName", "TownName", "CountrySubdivision", "PostalCode", "Country"},
+	AddressUnstructured: nil,
+	AddressHybrid:       {"TownName", "Country"},
+}
+
+var addressTypeCodes = map[string]bool{
+	"ADDR": true,
+	"PBOX": true,
+	"HOME": true,
+	"BIZZ": true,
+	"MLTO": true,
+	"DLVY": true,
+}
+
+type addressField struct {
+	name   string
+	maxLen int
+	get    func(PostalAddress) *string
+}
+
+// addressFields lists the discrete PostalAddress24 elements in schema order
+// together with their maximum lengths.
+var addressFields = []addressField{
+	{"Department", 70, func(a PostalAddress) *string { return a.Department }},
+	{"SubDepartment", 70, func(a PostalAddress) *string { return a.SubDepartment }},
+	{"StreetName", 70, func(a PostalAddress) *string { return a.StreetName }},
+	{"BuildingNumber", 16, func(a PostalAddress) *string { return a.BuildingNumber }},
+	{"BuildingName", 35, func(a PostalAddress) *string { return a.BuildingName }},
+	{"Floor", 70, func(a PostalAddress) *string { return a.Floor }},
+	{"PostBox", 16, func(a PostalAddress) *string { return a.PostBox }},
+	{"Room", 70, func(a PostalAddress) *string { return a.Room }},
+	{"PostalCode", 16, func(a PostalAddress) *string { return a.PostalCode }},
+	{"TownName", 35, func(a PostalAddress) *string { return a.TownName }},
+	{"TownLocationName", 35, func(a PostalAddress) *string { return a.TownLocationName }},
+	{"DistrictName", 35, func(a PostalAddress) *string { return a.DistrictName }},
+	{"CountrySubdivision", 35, func(a PostalAddress) *string { return a.CountrySubdivision }},
+	{"Country", 2, func(a PostalAddress) *string { return a.Country }},
+}
+
+// ValidateAddress validates the address against DefaultAddressPolicy.
+func (address PostalAddress) ValidateAddress() error {
+	return address.Validate(DefaultAddressPolicy)
+}
+
+// Validate checks the address against the given policy. All problems are
+// reported in a single error rather than stopping at the first one.
+func (address PostalAddress) Validate(policy AddressPolicy) error {
+	mode := policy.Mode
+	if mode == "" {
+		mode = AddressStructured
+	}
+	required, ok := defaultRequiredAddressFields[mode]
+	if !ok {
+		return fmt.Errorf("unknown address validation mode %q", mode)
+	}
+	if policy.RequiredFields != nil {
+		required = policy.RequiredFields
+	}
+
+	var missingFields []string
+	for _, name := range required {
+		value, known := address.field(name)
+		if !known {
+			return fmt.Errorf("unknown required address field %q", name)
+		}
+		if value == "" {
+			missingFields = append(missingFields, name)
+		}
+	}
+
+	var problems []string
+	for _, f := range addressFields {
+		if v := f.get(address); v != nil && len(*v) > f.maxLen {
+			problems = append(problems, fmt.Sprintf("%s exceeds %d characters", f.name, f.maxLen))
+		}
+	}
+	for i, line := range address.AddressLine {
+		if line == "" || len(line) > 70 {
+			problems = append(problems, fmt.Sprintf("AddressLine[%d] must be 1-70 characters", i))
+		}
+	}
+	if address.AddressType != nil {
+		problems = append(problems, address.AddressType.problems()...)
+	}
+
+	switch mode {
+	case AddressStructured:
+		if len(address.AddressLine) > 0 {
+			problems = append(problems, "AddressLine is not allowed in a structured address")
+		}
+	case AddressUnstructured:
+		if len(address.AddressLine) == 0 {
+			missingFields = append(missingFields, "AddressLine")
+		}
+		if len(address.AddressLine) > maxAddressLines {
+			problems = append(problems, fmt.Sprintf("at most %d AddressLine entries are allowed", maxAddressLines))
+		}
+		for _, f := range addressFields {
+			if f.name == "Country" {
+				continue
+			}
+			if v := f.get(address); v != nil && *v != "" {
+				problems = append(problems, fmt.Sprintf("%s is not allowed in an unstructured address", f.name))
+			}
+		}
+	case AddressHybrid:
+		if len(address.AddressLine) > maxHybridAddressLines {
+			problems = append(problems, fmt.Sprintf("at most %d AddressLine entries are allowed in a hybrid address", maxHybridAddressLines))
+		}
+	}
+
+	if len(missingFields) > 0 {
+		problems = append([]string{fmt.Sprintf("missing required address fields: %s", strings.Join(missingFields, ", "))}, problems...)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// field returns the value of the named discrete field and whether the name is known.
+func (address PostalAddress) field(name string) (string, bool) {
+	if name == "AddressLine" {
+		return strings.Join(address.AddressLine, ""), true
+	}
+	for _, f := range addressFields {
+		if f.name == name {
+			if v := f.get(address); v != nil {
+				return *v, true
+			}
+			return "", true
+		}
+	}
+	return "", false
+}
+
+func (t AddressType) problems() []string {
+	var problems []string
+	if (t.Code == nil) == (t.Proprietary == nil) {
+		problems = append(problems, "AddressType must set exactly one of Code or Proprietary")
+	}
+	if t.Code != nil && !addressTypeCodes[*t.Code] {
+		problems = append(problems, fmt.Sprintf("AddressType.Code %q is not a valid AddressType2Code", *t.Code))
+	}
+	if t.Proprietary != nil {
+		if len(t.Proprietary.Id) != 4 {
+			problems = append(problems, "AddressType.Proprietary.Id must be exactly 4 characters")
+		}
+		if t.Proprietary.Issuer == "" || len(t.Proprietary.Issuer) > 35 {
+			problems = append(problems, "AddressType.Proprietary.Issuer must be 1-35 characters")
+		}
+	}
+	return problems
+}
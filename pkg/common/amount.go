@@ -0,0 +1,257 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	// AmountTotalDigits is the totalDigits facet of ISO 20022 amount types.
+	AmountTotalDigits = 18
+
+	// AmountFractionDigits is the fractionDigits facet of ISO 20022 amount types.
+	AmountFractionDigits = 5
+)
+
+// Amount is an exact decimal value as used by the ISO 20022
+// ActiveCurrencyAndAmount and ActiveOrHistoricCurrencyAndAmount types. The
+// value is coef * 10^-scale; scale keeps the number of decimals the value was
+// written with so that "1000.00" round-trips unchanged.
+//
+// The zero value is 0. Values produced by ParseAmount and by the arithmetic
+// methods always satisfy the totalDigits and fractionDigits facets.
+type Amount struct {
+	coef  int64
+	scale int
+}
+
+// ParseAmount parses a plain decimal string such as "1000.00". Exponents,
+// thousands separators and more than AmountFractionDigits significant
+// decimals are rejected.
+func ParseAmount(s string) (Amount, error) {
+	text := strings.TrimSpace(s)
+	if text == "" {
+		return Amount{}, fmt.Errorf("amount is empty")
+	}
+
+	digits := text
+	negative := false
+	switch digits[0] {
+	case '-':
+		negative = true
+		digits = digits[1:]
+	case '+':
+		digits = digits[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(digits, ".")
+	if intPart == "" && fracPart == "" {
+		return Amount{}, fmt.Errorf("invalid amount %q", s)
+	}
+	for _, part := range []string{intPart, fracPart} {
+		for _, r := range part {
+			if r < '0' || r > '9' {
+				return Amount{}, fmt.Errorf("invalid amount %q", s)
+			}
+		}
+	}
+
+	// Trailing zeros beyond the facet are not significant.
+	for len(fracPart) > AmountFractionDigits && strings.HasSuffix(fracPart, "0") {
+		fracPart = fracPart[:len(fracPart)-1]
+	}
+	if significant := len(strings.TrimRight(fracPart, "0")); significant > AmountFractionDigits {
+		return Amount{}, fmt.Errorf("amount %q has %d fraction digits, at most %d are allowed", s, significant, AmountFractionDigits)
+	}
+
+	coefText := strings.TrimLeft(intPart+fracPart, "0")
+	if total := len(strings.TrimLeft(intPart, "0")) + len(strings.TrimRight(fracPart, "0")); total > AmountTotalDigits {
+		return Amount{}, fmt.Errorf("amount %q has %d digits, at most %d are allowed", s, total, AmountTotalDigits)
+	}
+	// Keep the written scale only while the coefficient still fits the facet.
+	for len(coefText) > AmountTotalDigits {
+		coefText = coefText[:len(coefText)-1]
+		fracPart = fracPart[:len(fracPart)-1]
+	}
+
+	var coef int64
+	for _, r := range coefText {
+		coef = coef*10 + int64(r-'0')
+	}
+	if negative {
+		coef = -coef
+	}
+	return Amount{coef: coef, scale: len(fracPart)}, nil
+}
+
+// MustParseAmount is like ParseAmount but panics on error. It is intended for
+// constants and tests.
+func MustParseAmount(s string) Amount {
+	a, err := ParseAmount(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// String returns the amount with the number of decimals it was written with.
+func (a Amount) String() string {
+	return a.Format(a.scale)
+}
+
+// Format returns the amount with at least the given number of decimals.
+// Significant decimals are never dropped, so an over-precise amount is still
+// rendered exactly; use FractionDigits to reject it beforehand.
+func (a Amount) Format(decimals int) string {
+	coef, scale := a.coef, a.scale
+	for scale > decimals && coef%10 == 0 {
+		coef /= 10
+		scale--
+	}
+
+	sign := ""
+	if coef < 0 {
+		sign = "-"
+		coef = -coef
+	}
+	text := fmt.Sprintf("%0*d", scale+1, coef)
+	if scale < decimals {
+		text += strings.Repeat("0", decimals-scale)
+		scale = decimals
+	}
+	if scale == 0 {
+		return sign + text
+	}
+	return sign + text[:len(text)-scale] + "." + text[len(text)-scale:]
+}
+
+// FractionDigits returns the number of significant decimals.
+func (a Amount) FractionDigits() int {
+	coef, scale := a.coef, a.scale
+	for scale > 0 && coef%10 == 0 {
+		coef /= 10
+		scale--
+	}
+	return scale
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of the amount.
+func (a Amount) Sign() int {
+	switch {
+	case a.coef < 0:
+		return -1
+	case a.coef > 0:
+		return 1
+	}
+	return 0
+}
+
+// IsZero reports whether the amount is 0.
+func (a Amount) IsZero() bool {
+	return a.coef == 0
+}
+
+// Cmp compares a and b and returns -1, 0 or +1.
+func (a Amount) Cmp(b Amount) int {
+	x, y, _ := align(a, b)
+	return x.Cmp(y)
+}
+
+// Add returns a + b. It fails when the result no longer satisfies the
+// totalDigits facet.
+func (a Amount) Add(b Amount) (Amount, error) {
+	x, y, scale := align(a, b)
+	return fromBig(x.Add(x, y), scale)
+}
+
+// Sub returns a - b. It fails when the result no longer satisfies the
+// totalDigits facet.
+func (a Amount) Sub(b Amount) (Amount, error) {
+	x, y, scale := align(a, b)
+	return fromBig(x.Sub(x, y), scale)
+}
+
+// SumAmounts adds the given amounts, e.g. to compute a CtrlSum.
+func SumAmounts(amounts ...Amount) (Amount, error) {
+	var total Amount
+	for _, a := range amounts {
+		var err error
+		if total, err = total.Add(a); err != nil {
+			return Amount{}, err
+		}
+	}
+	return total, nil
+}
+
+// Validate checks that the amount is strictly positive, as required for
+// settlement and instructed amounts.
+func (a Amount) Validate() error {
+	if a.Sign() <= 0 {
+		return fmt.Errorf("amount %s must be greater than zero", a)
+	}
+	return nil
+}
+
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON accepts both a JSON number and a quoted decimal string.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("amount should be a number, got %s", data)
+	}
+	parsed, err := ParseAmount(string(n))
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+func (a Amount) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *Amount) UnmarshalText(text []byte) error {
+	parsed, err := ParseAmount(string(text))
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// align returns both coefficients scaled to the larger of the two scales.
+func align(a, b Amount) (*big.Int, *big.Int, int) {
+	scale := max(a.scale, b.scale)
+	x := big.NewInt(a.coef)
+	y := big.NewInt(b.coef)
+	x.Mul(x, pow10(scale-a.scale))
+	y.Mul(y, pow10(scale-b.scale))
+	return x, y, scale
+}
+
+func fromBig(coef *big.Int, scale int) (Amount, error) {
+	ten := big.NewInt(10)
+	limit := pow10(AmountTotalDigits)
+	mod := new(big.Int)
+	for scale > 0 && new(big.Int).Abs(coef).Cmp(limit) >= 0 {
+		if mod.Mod(coef, ten).Sign() != 0 {
+			break
+		}
+		coef.Quo(coef, ten)
+		scale--
+	}
+	if new(big.Int).Abs(coef).Cmp(limit) >= 0 {
+		return Amount{}, fmt.Errorf("amount exceeds %d digits", AmountTotalDigits)
+	}
+	return Amount{coef: coef.Int64(), scale: scale}, nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -55,6 +55,14 @@ func (i ISODate) MarshalJSON() ([]byte, error) {
 	return json.Marshal(time.Time(i).Format("2006-01-02"))
 }
 
+// Validate reports an unset date.
+func (i ISODate) Validate() error {
+	if time.Time(i).IsZero() {
+		return &FieldError{Reason: "date is not set"}
+	}
+	return nil
+}
+
 type ISODateTime time.Time
 
 func (t *ISODateTime) UnmarshalJSON(data []byte) error {
@@ -78,7 +86,11 @@ func (t ISODateTime) MarshalJSON() ([]byte, error) {
 	return json.Marshal(time.Time(t).Format(time.RFC3339))
 }
 
+// Validate reports an unset date and time.
 func (t ISODateTime) Validate() error {
+	if time.Time(t).IsZero() {
+		return &FieldError{Reason: "date and time is not set"}
+	}
 	return nil
 }
 
@@ -0,0 +1,144 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// FieldError reports a problem with a single field. Path identifies the field
+// in the JSON payload (e.g. "fedNowMessage.amount.currency") so that callers
+// can point at the offending input without parsing the message.
+type FieldError struct {
+	Path   string
+	Value  string
+	Reason string
+}
+
+func (e *FieldError) Error() string {
+	if e.Path == "" {
+		if e.Value == "" {
+			return e.Reason
+		}
+		return fmt.Sprintf("%q %s", e.Value, e.Reason)
+	}
+	if e.Value == "" {
+		return fmt.Sprintf("%s: %s", e.Path, e.Reason)
+	}
+	return fmt.Sprintf("%s %q: %s", e.Path, e.Value, e.Reason)
+}
+
+var (
+	// ErrValidation matches every *ValidationError with errors.Is.
+	ErrValidation = errors.New("validation failed")
+	// ErrDecode matches every *DecodeError with errors.Is.
+	ErrDecode = errors.New("decode failed")
+)
+
+// ValidationError reports input that was rejected while building a message.
+// Its message is that of Err, which stays reachable through errors.As (for
+// example as a *FieldError or a *config.LimitError).
+type ValidationError struct {
+	// MessageType is the message being built, e.g. "pacs.008.001.08".
+	MessageType string
+	// Fields lists the offending fields found in Err.
+	Fields []*FieldError
+	Err    error
+}
+
+// NewValidationError wraps err in a *ValidationError for messageType. A nil
+// err returns nil, and an err that already is a *ValidationError is returned
+// unchanged.
+func NewValidationError(messageType string, err error) error {
+	if err == nil {
+		return nil
+	}
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return err
+	}
+	return &ValidationError{MessageType: messageType, Fields: fieldErrors(err), Err: err}
+}
+
+func (e *ValidationError) Error() string { return e.Err.Error() }
+
+func (e *ValidationError) Unwrap() error { return e.Err }
+
+func (e *ValidationError) Is(target error) bool { return target == ErrValidation }
+
+// fieldErrors collects the *FieldError values in the tree of err.
+func fieldErrors(err error) []*FieldError {
+	if fieldErr, ok := err.(*FieldError); ok {
+		return []*FieldError{fieldErr}
+	}
+	switch x := err.(type) {
+	case interface{ Unwrap() []error }:
+		var fields []*FieldError
+		for _, e := range x.Unwrap() {
+			fields = append(fields, fieldErrors(e)...)
+		}
+		return fields
+	case interface{ Unwrap() error }:
+		if inner := x.Unwrap(); inner != nil {
+			return fieldErrors(inner)
+		}
+	}
+	return nil
+}
+
+// DecodeError reports input that could not be decoded. Line and Column are
+// 1-based and zero when the position is unknown.
+type DecodeError struct {
+	// Format is "xml" or "json".
+	Format string
+	// MessageType is set once the message type is known.
+	MessageType string
+	// Element names the XML element being decoded, e.g. "AppHdr".
+	Element string
+	Line    int
+	Column  int
+	Err     error
+}
+
+// NewJSONDecodeError wraps an encoding/json error for payload in a
+// *DecodeError, locating it from the offset json reports. A nil err returns
+// nil.
+func NewJSONDecodeError(messageType string, payload []byte, err error) error {
+	if err == nil {
+		return nil
+	}
+	var offset int64
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	}
+	decodeErr := &DecodeError{Format: "json", MessageType: messageType, Err: err}
+	if offset > 0 && offset <= int64(len(payload)) {
+		before := payload[:offset]
+		decodeErr.Line = bytes.Count(before, []byte("\n")) + 1
+		decodeErr.Column = int(offset) - bytes.LastIndexByte(before, '\n') - 1
+	}
+	return decodeErr
+}
+
+func (e *DecodeError) Error() string {
+	what := e.Format
+	if e.Element != "" {
+		what = e.Element
+	} else if e.MessageType != "" {
+		what = e.MessageType + " " + e.Format
+	}
+	if e.Line == 0 {
+		return fmt.Sprintf("decoding %s: %v", what, e.Err)
+	}
+	return fmt.Sprintf("decoding %s at line %d, column %d: %v", what, e.Line, e.Column, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error { return e.Err }
+
+func (e *DecodeError) Is(target error) bool { return target == ErrDecode }
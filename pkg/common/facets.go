@@ -0,0 +1,134 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// The helpers below back the Validate methods generated for the ISO 20022
+// models by scripts/gen_validate.go. Each returns a *FieldError without a
+// path; PrefixError adds the element names on the way up.
+
+// CheckLength checks the length facets of value in characters. A negative
+// bound is not checked.
+func CheckLength(value string, minLength, maxLength int) error {
+	n := utf8.RuneCountInString(value)
+	if minLength >= 0 && n < minLength {
+		return &FieldError{Value: value, Reason: fmt.Sprintf("length %d is shorter than %d", n, minLength)}
+	}
+	if maxLength >= 0 && n > maxLength {
+		return &FieldError{Value: value, Reason: fmt.Sprintf("length %d is longer than %d", n, maxLength)}
+	}
+	return nil
+}
+
+// CheckPattern checks value against an anchored pattern facet.
+func CheckPattern(value string, pattern *regexp.Regexp) error {
+	if !pattern.MatchString(value) {
+		return &FieldError{Value: value, Reason: fmt.Sprintf("does not match pattern %s", pattern)}
+	}
+	return nil
+}
+
+// CheckEnumeration checks value against an enumeration facet.
+func CheckEnumeration(value string, allowed ...string) error {
+	for _, a := range allowed {
+		if value == a {
+			return nil
+		}
+	}
+	return &FieldError{Value: value, Reason: "is not an allowed code"}
+}
+
+// CheckDecimal checks that text is an xs:decimal within the totalDigits and
+// fractionDigits facets and not below minInclusive. A negative digit bound or
+// an empty minInclusive is not checked.
+func CheckDecimal(text string, totalDigits, fractionDigits int, minInclusive string) error {
+	value := strings.TrimSpace(text)
+	digits := strings.TrimLeft(value, "+-")
+	intPart, fracPart, _ := strings.Cut(digits, ".")
+	if digits == "" || intPart+fracPart == "" || strings.Trim(intPart+fracPart, "0123456789") != "" {
+		return &FieldError{Value: text, Reason: "is not a decimal number"}
+	}
+	intPart = strings.TrimLeft(intPart, "0")
+	fracPart = strings.TrimRight(fracPart, "0")
+	if fractionDigits >= 0 && len(fracPart) > fractionDigits {
+		return &FieldError{Value: text, Reason: fmt.Sprintf("has more than %d fraction digits", fractionDigits)}
+	}
+	if totalDigits >= 0 && len(intPart)+len(fracPart) > totalDigits {
+		return &FieldError{Value: text, Reason: fmt.Sprintf("has more than %d digits", totalDigits)}
+	}
+	if minInclusive != "" {
+		v, _ := new(big.Rat).SetString(value)
+		limit, _ := new(big.Rat).SetString(minInclusive)
+		if v != nil && limit != nil && v.Cmp(limit) < 0 {
+			return &FieldError{Value: text, Reason: fmt.Sprintf("is less than %s", minInclusive)}
+		}
+	}
+	return nil
+}
+
+// CheckOccurs checks the number of occurrences of a repeated element. A
+// negative maxOccurs means unbounded.
+func CheckOccurs(count, minOccurs, maxOccurs int) error {
+	if count < minOccurs {
+		return &FieldError{Reason: fmt.Sprintf("occurs %d times, at least %d required", count, minOccurs)}
+	}
+	if maxOccurs >= 0 && count > maxOccurs {
+		return &FieldError{Reason: fmt.Sprintf("occurs %d times, at most %d allowed", count, maxOccurs)}
+	}
+	return nil
+}
+
+// CheckChoice checks that exactly one alternative of a choice is present.
+// names and present are parallel.
+func CheckChoice(names []string, present []bool) error {
+	var set []string
+	for i, p := range present {
+		if p {
+			set = append(set, names[i])
+		}
+	}
+	switch len(set) {
+	case 1:
+		return nil
+	case 0:
+		return &FieldError{Reason: fmt.Sprintf("exactly one of %s is required", strings.Join(names, ", "))}
+	}
+	return &FieldError{Reason: fmt.Sprintf("only one of %s is allowed", strings.Join(set, ", "))}
+}
+
+// Required reports a missing required element.
+func Required() error {
+	return &FieldError{Reason: "is required"}
+}
+
+// PrefixError prepends element to the path of every FieldError in err.
+// Errors of other types are turned into a FieldError at element. It returns
+// nil when err is nil.
+func PrefixError(element string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs := joined.Unwrap()
+		prefixed := make([]error, len(errs))
+		for i, e := range errs {
+			prefixed[i] = PrefixError(element, e)
+		}
+		return errors.Join(prefixed...)
+	}
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		path := element
+		if fieldErr.Path != "" {
+			path = element + "." + fieldErr.Path
+		}
+		return &FieldError{Path: path, Value: fieldErr.Value, Reason: fieldErr.Reason}
+	}
+	return &FieldError{Path: element, Reason: err.Error()}
+}
@@ -0,0 +1,60 @@
+package common
+
+import "fmt"
+
+// RoutingNumber is a nine-digit ABA routing transit number (RTN) as used for
+// clearing system member identification on US payment rails.
+type RoutingNumber string
+
+// routingChecksumWeights are the ABA 3-7-1 weights applied to each digit.
+var routingChecksumWeights = [9]int{3, 7, 1, 3, 7, 1, 3, 7, 1}
+
+// Validate checks the length, the Federal Reserve prefix and the 3-7-1
+// checksum of the routing number.
+func (r RoutingNumber) Validate() error {
+	if len(r) != 9 {
+		return fmt.Errorf("routing number must be 9 digits")
+	}
+	sum := 0
+	for i := 0; i < len(r); i++ {
+		c := r[i]
+		if c < '0' || c > '9' {
+			return fmt.Errorf("routing number must be 9 digits")
+		}
+		sum += int(c-'0') * routingChecksumWeights[i]
+	}
+	if !validRoutingPrefix(int(r[0]-'0')*10 + int(r[1]-'0')) {
+		return fmt.Errorf("routing number prefix %s is not a Federal Reserve routing symbol", r[:2])
+	}
+	if sum%10 != 0 {
+		return fmt.Errorf("routing number checksum is invalid")
+	}
+	return nil
+}
+
+// validRoutingPrefix reports whether the first two digits are assigned by the
+// Federal Reserve: 00-12 (banks), 21-32 (thrifts), 61-72 (electronic) and 80
+// (traveler's checks).
+func validRoutingPrefix(prefix int) bool {
+	switch {
+	case prefix <= 12:
+		return true
+	case prefix >= 21 && prefix <= 32:
+		return true
+	case prefix >= 61 && prefix <= 72:
+		return true
+	}
+	return prefix == 80
+}
+
+// ValidateRoutingNumber validates value as a RoutingNumber. path names the
+// field in the error.
+func ValidateRoutingNumber(path, value string) error {
+	if value == "" {
+		return &FieldError{Path: path, Reason: "routing number is required"}
+	}
+	if err := RoutingNumber(value).Validate(); err != nil {
+		return &FieldError{Path: path, Value: value, Reason: err.Error()}
+	}
+	return nil
+}
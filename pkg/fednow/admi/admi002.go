@@ -1,24 +1,67 @@
 package admi
 
 import (
+	"encoding/json"
+	"encoding/xml"
+
 	admi "github.com/mbanq/iso20022-go/ISO20022/admi_002_001_01"
 	head "github.com/mbanq/iso20022-go/ISO20022/head_001_001_02"
 	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow/config"
 )
 
+func BuildAdmi002Struct(message FedNowMessageADM, msgConfig *config.Config) (*admi.Document, error) {
+
+	fedMsg := message.FedNowMsg
+
+	if fedMsg.Reference == "" {
+		return nil, common.NewValidationError("admi.002.001.01", &common.FieldError{Path: "fedNowMessage.reference", Reason: "is required"})
+	}
+	if fedMsg.Reason.RejectionReason == "" {
+		return nil, common.NewValidationError("admi.002.001.01", &common.FieldError{Path: "fedNowMessage.reason.rejectionReason", Reason: "is required"})
+	}
+
+	admiDoc := &admi.Document{
+		XMLName: xml.Name{Space: "urn:iso:std:iso:20022:tech:xsd:admi.002.001.01", Local: "Document"},
+		Admi00200101: admi.Admi00200101{
+			XMLName: xml.Name{Local: "admi.002.001.01"},
+			RltdRef: admi.MessageReference{
+				Ref: admi.Max35Text(fedMsg.Reference),
+			},
+			Rsn: admi.RejectionReason2{
+				RjctgPtyRsn: admi.Max35Text(fedMsg.Reason.RejectionReason),
+				RjctnDtTm:   fedMsg.Reason.RejectionDateTime,
+				ErrLctn:     fedMsg.Reason.ErrorLocation,
+				RsnDesc:     fedMsg.Reason.Description,
+			},
+		},
+	}
+	return admiDoc, nil
+}
+
+func BuildAdmi002(payload []byte, cfg *config.Config) (*admi.Document, error) {
+	var message FedNowMessageADM
+	if err := json.Unmarshal(payload, &message); err != nil {
+		return nil, common.NewJSONDecodeError("admi.002.001.01", payload, err)
+	}
+	return BuildAdmi002Struct(message, cfg)
+}
+
 func ParseAdmi002Struct(admiDoc *admi.Document, appHdr head.BusinessApplicationHeaderV02) (FedNowMessageADM, error) {
 	fedMsg := FedNowMessageADM{
 		FedNowMsg: FedNowADM{
 			CreationDateTime: common.ISODateTime(appHdr.CreDt),
 			Identifier: FedNowIdentifier{
-				BusinessMessageID: appHdr.BizMsgIdr,
-				MessageType:       appHdr.MsgDefIdr,
-				MessageID:         appHdr.BizMsgIdr,
-			},
+				BusinessMessageID: string(appHdr.BizMsgIdr),
+				MessageType:       string(appHdr.MsgDefIdr),
+				MessageID:         string(appHdr.BizMsgIdr),
+			}.WithHeader(appHdr),
 			Reference: admiDoc.Admi00200101.RltdRef.Ref,
 			Reason: RejectionReason{
 				RejectionReason:   admiDoc.Admi00200101.Rsn.RjctgPtyRsn,
 				RejectionDateTime: admiDoc.Admi00200101.Rsn.RjctnDtTm,
+				ErrorLocation:     admiDoc.Admi00200101.Rsn.ErrLctn,
+				Description:       admiDoc.Admi00200101.Rsn.RsnDesc,
 			},
 		},
 	}
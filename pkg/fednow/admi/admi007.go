@@ -56,7 +56,7 @@ func BuildAdmi007Struct(message FedNowMessageRctAck, msgConfig *config.Config) (
 func BuildAdmi007(payload []byte, cfg *config.Config) (*admi_007_001_01.Document, error) {
 	var message FedNowMessageRctAck
 	if err := json.Unmarshal(payload, &message); err != nil {
-		return nil, err
+		return nil, common.NewJSONDecodeError("admi.007.001.01", payload, err)
 	}
 	return BuildAdmi007Struct(message, cfg)
 }
@@ -89,10 +89,10 @@ func ParseAdmi007Struct(admiDoc *admi_007_001_01.Document, appHdr head.BusinessA
 		FedNowMsg: FedNowReceiptAcknowledgement{
 			CreationDateTime: creationDateTime,
 			Identifier: FedNowIdentifier{
-				BusinessMessageID: appHdr.BizMsgIdr,
-				MessageType:       appHdr.MsgDefIdr,
-				MessageID:         head.Max35Text(rctAck.MsgId.MsgId),
-			},
+				BusinessMessageID: string(appHdr.BizMsgIdr),
+				MessageType:       string(appHdr.MsgDefIdr),
+				MessageID:         string(rctAck.MsgId.MsgId),
+			}.WithHeader(appHdr),
 			QueryName: rctAck.MsgId.QryNm,
 			Reports:   reports,
 		},
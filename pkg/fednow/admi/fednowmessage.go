@@ -3,8 +3,8 @@ package admi
 import (
 	admi_002_001_01 "github.com/mbanq/iso20022-go/ISO20022/admi_002_001_01"
 	admi_007_001_01 "github.com/mbanq/iso20022-go/ISO20022/admi_007_001_01"
-	head "github.com/mbanq/iso20022-go/ISO20022/head_001_001_02"
 	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/payment"
 )
 
 type FedNowMessageADM struct {
@@ -23,6 +23,9 @@ type FedNowADM struct {
 type RejectionReason struct {
 	RejectionReason   admi_002_001_01.Max35Text `json:"rejectionReason"`
 	RejectionDateTime *common.ISODateTime       `json:"rejectionDateTime"`
+	// ErrorLocation points at the offending part of the rejected message.
+	ErrorLocation *admi_002_001_01.Max350Text `json:"errorLocation,omitempty"`
+	Description   *admi_002_001_01.Max350Text `json:"description,omitempty"`
 }
 
 type FedNowMessageRctAck struct {
@@ -55,8 +58,5 @@ type ReceiptAcknowledgementHandling struct {
 	Description    *admi_007_001_01.Max140Text          `json:"description,omitempty"`
 }
 
-type FedNowIdentifier struct {
-	BusinessMessageID head.Max35Text `json:"businessMessageId"`
-	MessageType       head.Max35Text `json:"messageType"`
-	MessageID         head.Max35Text `json:"messageId"`
-}
+// FedNowIdentifier is the shared message identifier.
+type FedNowIdentifier = payment.Identifier
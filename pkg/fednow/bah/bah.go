@@ -6,38 +6,146 @@ import (
 	bah "github.com/mbanq/iso20022-go/ISO20022/head_001_001_02"
 	"github.com/mbanq/iso20022-go/pkg/common"
 	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+	"github.com/mbanq/iso20022-go/pkg/payment"
 )
 
+// FedNow market practice, used when the config leaves it blank.
+const (
+	MarketPracticeRegistry = "www2.swift.com/mystandards/#/group/Federal_Reserve_Financial_Services/FedNow_Service"
+	MarketPractice         = "frb.fednow.01"
+)
+
+// Options sets the optional elements of a Business Application Header.
+type Options struct {
+	// CreationDateTime is the CreDt of the header. The zero value stamps the
+	// current time.
+	CreationDateTime time.Time
+	// From and To are the routing numbers of the sender and receiver. They
+	// default to the ispId and frbId of the config.
+	From string
+	To   string
+	// CharSet is the CharSet, e.g. a Unicode chart code.
+	CharSet string
+	// BusinessService is the BizSvc.
+	BusinessService string
+	// BusinessProcessingDate is the BizPrcgDt; the zero value omits it.
+	BusinessProcessingDate time.Time
+	// CopyDuplicate is the CpyDplct: CODU, COPY or DUPL.
+	CopyDuplicate string
+	// PossibleDuplicate sets PssblDplct, flagging a message that may
+	// already have been sent, e.g. a resend after a timeout.
+	PossibleDuplicate bool
+	// Priority is the Prty.
+	Priority string
+	// Related are the Rltd headers, e.g. the header of the request a
+	// response answers.
+	Related []payment.RelatedHeader
+}
+
+// OptionsFor returns the header options carried by the identifier of a
+// payload.
+func OptionsFor(id payment.Identifier) Options {
+	opts := Options{
+		CreationDateTime:  time.Time(id.CreationDateTime),
+		From:              id.From,
+		To:                id.To,
+		CharSet:           id.CharSet,
+		BusinessService:   id.BusinessService,
+		CopyDuplicate:     id.CopyDuplicate,
+		PossibleDuplicate: id.PossibleDuplicate,
+		Priority:          id.Priority,
+		Related:           id.Related,
+	}
+	if id.BusinessProcessingDate != nil {
+		opts.BusinessProcessingDate = time.Time(*id.BusinessProcessingDate)
+	}
+	return opts
+}
+
+// BuildBah builds the Business Application Header of a message created now.
 func BuildBah(messageId string, msgConfig *config.Config, msgType string) (*bah.BusinessApplicationHeaderV02, error) {
+	return BuildBahWith(messageId, msgConfig, msgType, Options{})
+}
+
+// BuildBahWith builds the Business Application Header of a message with opts.
+// The market practice is that of the config, or FedNow's when blank.
+func BuildBahWith(messageId string, msgConfig *config.Config, msgType string, opts Options) (*bah.BusinessApplicationHeaderV02, error) {
+
+	from, to := opts.From, opts.To
+	if from == "" {
+		from = string(msgConfig.IspId)
+	}
+	if to == "" {
+		to = string(msgConfig.FrbId)
+	}
+	fromPath, toPath := "ispId", "frbId"
+	if opts.From != "" {
+		fromPath = "AppHdr.Fr"
+	}
+	if opts.To != "" {
+		toPath = "AppHdr.To"
+	}
+	if err := common.ValidateRoutingNumber(fromPath, from); err != nil {
+		return nil, common.NewValidationError(msgType, err)
+	}
+	if err := common.ValidateRoutingNumber(toPath, to); err != nil {
+		return nil, common.NewValidationError(msgType, err)
+	}
+
+	creDt := opts.CreationDateTime
+	if creDt.IsZero() {
+		creDt = time.Now().In(common.EstLocation)
+	}
 
-	now := time.Now().In(common.EstLocation)
+	regy, id := msgConfig.MarketPracticeRegistry, msgConfig.MarketPractice
+	if regy == "" {
+		regy = MarketPracticeRegistry
+	}
+	if id == "" {
+		id = MarketPractice
+	}
 
 	bahMsg := &bah.BusinessApplicationHeaderV02{
-		Fr: bah.Party44Choice{
-			FIId: &bah.BranchAndFinancialInstitutionIdentification6{
-				FinInstnId: bah.FinancialInstitutionIdentification18{
-					ClrSysMmbId: &bah.ClearingSystemMemberIdentification2{
-						MmbId: bah.Max35Text(msgConfig.IspId),
-					},
-				},
-			},
-		},
-		To: bah.Party44Choice{
-			FIId: &bah.BranchAndFinancialInstitutionIdentification6{
-				FinInstnId: bah.FinancialInstitutionIdentification18{
-					ClrSysMmbId: &bah.ClearingSystemMemberIdentification2{
-						MmbId: bah.Max35Text(msgConfig.FrbId),
-					},
-				},
-			},
-		},
+		Fr:        payment.HeadParty(from),
+		To:        payment.HeadParty(to),
 		BizMsgIdr: bah.Max35Text(messageId),
 		MsgDefIdr: bah.Max35Text(msgType),
 		MktPrctc: &bah.ImplementationSpecification1{
-			Regy: bah.Max350Text("www2.swift.com/mystandards/#/group/Federal_Reserve_Financial_Services/FedNow_Service"),
-			Id:   bah.Max2048Text("frb.fednow.01"),
+			Regy: regy,
+			Id:   id,
 		},
-		CreDt: (common.ISODateTime)(now),
+		CreDt: (common.ISODateTime)(creDt),
+	}
+	if opts.CharSet != "" {
+		charSet := bah.UnicodeChartsCode(opts.CharSet)
+		bahMsg.CharSet = &charSet
+	}
+	if opts.BusinessService != "" {
+		bizSvc := bah.Max35Text(opts.BusinessService)
+		bahMsg.BizSvc = &bizSvc
+	}
+	if !opts.BusinessProcessingDate.IsZero() {
+		bizPrcgDt := common.ISODateTime(opts.BusinessProcessingDate)
+		bahMsg.BizPrcgDt = &bizPrcgDt
+	}
+	if opts.CopyDuplicate != "" {
+		cpyDplct := bah.CopyDuplicate1Code(opts.CopyDuplicate)
+		bahMsg.CpyDplct = &cpyDplct
+	}
+	if opts.PossibleDuplicate {
+		pssblDplct := bah.YesNoIndicator(true)
+		bahMsg.PssblDplct = &pssblDplct
+	}
+	if opts.Priority != "" {
+		prty := bah.BusinessMessagePriorityCode(opts.Priority)
+		bahMsg.Prty = &prty
+	}
+	for _, related := range opts.Related {
+		bahMsg.Rltd = append(bahMsg.Rltd, related.Head())
+	}
+
+	if err := bahMsg.Validate(); err != nil {
+		return nil, common.NewValidationError(msgType, common.PrefixError("AppHdr", err))
 	}
 
 	return bahMsg, nil
@@ -3,60 +3,59 @@ package camt
 import (
 	"encoding/json"
 	"encoding/xml"
+	"fmt"
 	"time"
 
 	camt_029_001_09 "github.com/mbanq/iso20022-go/ISO20022/camt_029_001_09"
 	head "github.com/mbanq/iso20022-go/ISO20022/head_001_001_02"
 	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow/codes"
 	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+	"github.com/mbanq/iso20022-go/pkg/payment"
 )
 
 func BuildCamt029Struct(message FedNowMessageCxlRsp, msgConfig *config.Config) (*camt_029_001_09.Document, error) {
 	fedMsg := message.FedNowMsg
 
-	clearingSystemId := camt_029_001_09.ExternalClearingSystemIdentification1Code(msgConfig.ClearingSystemId)
+	clearingSystemId := string(msgConfig.ClearingSystemId)
+
+	// Routing Number Validation
+	if err := common.ValidateRoutingNumber("fedNowMessage.senderDepositoryInstitution.senderABANumber", fedMsg.SenderDI.SenderABANumber); err != nil {
+		return nil, common.NewValidationError("camt.029.001.09", err)
+	}
+	if err := common.ValidateRoutingNumber("fedNowMessage.receiverDepositoryInstitution.receiverABANumber", fedMsg.ReceiverDI.ReceiverABANumber); err != nil {
+		return nil, common.NewValidationError("camt.029.001.09", err)
+	}
+	if fedMsg.ResolvedCase.CaseID != "" {
+		if err := fedMsg.ResolvedCase.CreatorDI.Validate("fedNowMessage.resolvedCase.creatorDepositoryInstitution"); err != nil {
+			return nil, common.NewValidationError("camt.029.001.09", err)
+		}
+	}
+	// Code Validation
+	if fedMsg.InvestigationStatus.Confirmation != nil {
+		if err := codes.Validate("fedNowMessage.investigationStatus.confirmation", "camt.029.001.09", codes.InvestigationConfirmation, string(*fedMsg.InvestigationStatus.Confirmation)); err != nil {
+			return nil, common.NewValidationError("camt.029.001.09", err)
+		}
+	}
+	if fedMsg.InvestigationStatus.DuplicateOf != nil {
+		if err := fedMsg.InvestigationStatus.DuplicateOf.CreatorDI.Validate("fedNowMessage.investigationStatus.duplicateOf.creatorDepositoryInstitution"); err != nil {
+			return nil, common.NewValidationError("camt.029.001.09", err)
+		}
+	}
 
 	assgnr := camt_029_001_09.Party40Choice{
-		Agt: &camt_029_001_09.BranchAndFinancialInstitutionIdentification6{
-			FinInstnId: camt_029_001_09.FinancialInstitutionIdentification18{
-				ClrSysMmbId: &camt_029_001_09.ClearingSystemMemberIdentification2{
-					MmbId: camt_029_001_09.Max35Text(fedMsg.SenderDI.SenderABANumber),
-					ClrSysId: &camt_029_001_09.ClearingSystemIdentification2Choice{
-						Cd: &clearingSystemId,
-					},
-				},
-			},
-		},
+		Agt: payment.AgentToCamt029(fedMsg.SenderDI.SenderABANumber, nil, clearingSystemId),
 	}
 	assgne := camt_029_001_09.Party40Choice{
-		Agt: &camt_029_001_09.BranchAndFinancialInstitutionIdentification6{
-			FinInstnId: camt_029_001_09.FinancialInstitutionIdentification18{
-				ClrSysMmbId: &camt_029_001_09.ClearingSystemMemberIdentification2{
-					MmbId: camt_029_001_09.Max35Text(fedMsg.ReceiverDI.ReceiverABANumber),
-					ClrSysId: &camt_029_001_09.ClearingSystemIdentification2Choice{
-						Cd: &clearingSystemId,
-					},
-				},
-			},
-		},
+		Agt: payment.AgentToCamt029(fedMsg.ReceiverDI.ReceiverABANumber, nil, clearingSystemId),
 	}
 
 	var resolvedCase *camt_029_001_09.Case5
 	if fedMsg.ResolvedCase.CaseID != "" {
-		creatorMemberID := diMemberID(fedMsg.ResolvedCase.CreatorDI)
 		resolvedCase = &camt_029_001_09.Case5{
-			Id: camt_029_001_09.Max35Text(fedMsg.ResolvedCase.CaseID),
+			Id: fedMsg.ResolvedCase.CaseID,
 			Cretr: camt_029_001_09.Party40Choice{
-				Agt: &camt_029_001_09.BranchAndFinancialInstitutionIdentification6{
-					FinInstnId: camt_029_001_09.FinancialInstitutionIdentification18{
-						ClrSysMmbId: &camt_029_001_09.ClearingSystemMemberIdentification2{
-							MmbId: camt_029_001_09.Max35Text(creatorMemberID),
-							ClrSysId: &camt_029_001_09.ClearingSystemIdentification2Choice{
-								Cd: &clearingSystemId,
-							},
-						},
-					},
-				},
+				Agt: payment.AgentToCamt029(fedMsg.ResolvedCase.CreatorDI.MemberID(), nil, clearingSystemId),
 			},
 		}
 	}
@@ -67,26 +66,16 @@ func BuildCamt029Struct(message FedNowMessageCxlRsp, msgConfig *config.Config) (
 		AssgnmtCxlConf: fedMsg.InvestigationStatus.AssignmentCancellationConfirmed,
 	}
 	if fedMsg.InvestigationStatus.DuplicateOf != nil {
-		creatorMemberID := diMemberID(fedMsg.InvestigationStatus.DuplicateOf.CreatorDI)
 		status.DplctOf = &camt_029_001_09.Case5{
-			Id: camt_029_001_09.Max35Text(fedMsg.InvestigationStatus.DuplicateOf.CaseID),
+			Id: fedMsg.InvestigationStatus.DuplicateOf.CaseID,
 			Cretr: camt_029_001_09.Party40Choice{
-				Agt: &camt_029_001_09.BranchAndFinancialInstitutionIdentification6{
-					FinInstnId: camt_029_001_09.FinancialInstitutionIdentification18{
-						ClrSysMmbId: &camt_029_001_09.ClearingSystemMemberIdentification2{
-							MmbId: camt_029_001_09.Max35Text(creatorMemberID),
-							ClrSysId: &camt_029_001_09.ClearingSystemIdentification2Choice{
-								Cd: &clearingSystemId,
-							},
-						},
-					},
-				},
+				Agt: payment.AgentToCamt029(fedMsg.InvestigationStatus.DuplicateOf.CreatorDI.MemberID(), nil, clearingSystemId),
 			},
 		}
 	}
 
 	var cxlDetails []camt_029_001_09.UnderlyingTransaction22
-	for _, detail := range fedMsg.CancellationDetails {
+	for i, detail := range fedMsg.CancellationDetails {
 		txInf := camt_029_001_09.PaymentTransaction102{
 			OrgnlInstrId:    detail.OriginalInstructionID,
 			OrgnlEndToEndId: detail.OriginalEndToEndID,
@@ -109,14 +98,33 @@ func BuildCamt029Struct(message FedNowMessageCxlRsp, msgConfig *config.Config) (
 
 		if detail.ResolutionRelatedInfo != nil {
 			txInf.RsltnRltdInf = &camt_029_001_09.ResolutionData1{
-				EndToEndId:     detail.ResolutionRelatedInfo.EndToEndID,
-				TxId:           detail.ResolutionRelatedInfo.TransactionID,
-				UETR:           detail.ResolutionRelatedInfo.UETR,
-				IntrBkSttlmAmt: detail.ResolutionRelatedInfo.InterbankSettlementAmount,
-				IntrBkSttlmDt:  detail.ResolutionRelatedInfo.InterbankSettlementDate,
+				EndToEndId:    detail.ResolutionRelatedInfo.EndToEndID,
+				TxId:          detail.ResolutionRelatedInfo.TransactionID,
+				UETR:          detail.ResolutionRelatedInfo.UETR,
+				IntrBkSttlmDt: detail.ResolutionRelatedInfo.InterbankSettlementDate,
+			}
+			if amount := detail.ResolutionRelatedInfo.InterbankSettlementAmount; amount != nil {
+				path := fmt.Sprintf("fedNowMessage.cancellationDetails[%d].resolutionRelatedInformation.interbankSettlementAmount", i)
+				if err := amount.ValidateFedNow(path); err != nil {
+					return nil, common.NewValidationError("camt.029.001.09", err)
+				}
+				intrBkSttlmAmt := payment.HistoricAmountToCamt029(*amount)
+				txInf.RsltnRltdInf.IntrBkSttlmAmt = &intrBkSttlmAmt
 			}
 		}
 
+		if detail.RejectionReason != nil || len(detail.AdditionalInfo) > 0 {
+			reason := camt_029_001_09.CancellationStatusReason4{AddtlInf: detail.AdditionalInfo}
+			if detail.RejectionReason != nil {
+				path := fmt.Sprintf("fedNowMessage.cancellationDetails[%d].rejectionReason", i)
+				if err := codes.Validate(path, "camt.029.001.09", codes.CancellationRejection, string(*detail.RejectionReason)); err != nil {
+					return nil, common.NewValidationError("camt.029.001.09", err)
+				}
+				reason.Rsn = &camt_029_001_09.CancellationStatusReason3Choice{Cd: detail.RejectionReason}
+			}
+			txInf.CxlStsRsnInf = []camt_029_001_09.CancellationStatusReason4{reason}
+		}
+
 		cxlDetails = append(cxlDetails, camt_029_001_09.UnderlyingTransaction22{
 			TxInfAndSts: []camt_029_001_09.PaymentTransaction102{txInf},
 		})
@@ -143,7 +151,7 @@ func BuildCamt029Struct(message FedNowMessageCxlRsp, msgConfig *config.Config) (
 func BuildCamt029(payload []byte, cfg *config.Config) (*camt_029_001_09.Document, error) {
 	var message FedNowMessageCxlRsp
 	if err := json.Unmarshal(payload, &message); err != nil {
-		return nil, err
+		return nil, common.NewJSONDecodeError("camt.029.001.09", payload, err)
 	}
 	return BuildCamt029Struct(message, cfg)
 }
@@ -156,7 +164,7 @@ func ParseCamt029(appHdr head.BusinessApplicationHeaderV02, document camt_029_00
 		resolvedCase = FedNowCase{
 			CaseID: camt_029_001_09.Max35Text(response.RslvdCase.Id),
 			CreatorDI: FedNowDepositoryInstitution2{
-				SenderABANumber: extractCaseCreatorMemberID(response.RslvdCase.Cretr),
+				SenderABANumber: payment.MemberIDFromCamt029Agent(response.RslvdCase.Cretr.Agt),
 			},
 		}
 	}
@@ -170,7 +178,7 @@ func ParseCamt029(appHdr head.BusinessApplicationHeaderV02, document camt_029_00
 		status.DuplicateOf = &FedNowCase{
 			CaseID: camt_029_001_09.Max35Text(response.Sts.DplctOf.Id),
 			CreatorDI: FedNowDepositoryInstitution2{
-				SenderABANumber: extractCaseCreatorMemberID(response.Sts.DplctOf.Cretr),
+				SenderABANumber: payment.MemberIDFromCamt029Agent(response.Sts.DplctOf.Cretr.Agt),
 			},
 		}
 	}
@@ -199,36 +207,49 @@ func ParseCamt029(appHdr head.BusinessApplicationHeaderV02, document camt_029_00
 
 			if tx.RsltnRltdInf != nil {
 				detail.ResolutionRelatedInfo = &FedNowResolutionRelatedInfo{
-					EndToEndID:                tx.RsltnRltdInf.EndToEndId,
-					TransactionID:             tx.RsltnRltdInf.TxId,
-					UETR:                      tx.RsltnRltdInf.UETR,
-					InterbankSettlementAmount: tx.RsltnRltdInf.IntrBkSttlmAmt,
-					InterbankSettlementDate:   tx.RsltnRltdInf.IntrBkSttlmDt,
+					EndToEndID:              tx.RsltnRltdInf.EndToEndId,
+					TransactionID:           tx.RsltnRltdInf.TxId,
+					UETR:                    tx.RsltnRltdInf.UETR,
+					InterbankSettlementDate: tx.RsltnRltdInf.IntrBkSttlmDt,
+				}
+				if tx.RsltnRltdInf.IntrBkSttlmAmt != nil {
+					amount, err := payment.AmountFromCamt029Historic(*tx.RsltnRltdInf.IntrBkSttlmAmt)
+					if err != nil {
+						return nil, fmt.Errorf("invalid IntrBkSttlmAmt: %w", err)
+					}
+					detail.ResolutionRelatedInfo.InterbankSettlementAmount = &amount
+				}
+			}
+
+			for _, reason := range tx.CxlStsRsnInf {
+				if reason.Rsn != nil && reason.Rsn.Cd != nil && detail.RejectionReason == nil {
+					detail.RejectionReason = reason.Rsn.Cd
 				}
+				detail.AdditionalInfo = append(detail.AdditionalInfo, reason.AddtlInf...)
 			}
 
 			details = append(details, detail)
 		}
 	}
 
-	senderABANumber := extractClrSysMemberIDFromParty40ChoiceCamt029(response.Assgnmt.Assgnr)
+	senderABANumber := payment.MemberIDFromCamt029Agent(response.Assgnmt.Assgnr.Agt)
 	if senderABANumber == "" {
-		senderABANumber = extractClrSysMemberIDCamt029(appHdr.To)
+		senderABANumber = payment.MemberIDFromHead(appHdr.To)
 	}
-	receiverABANumber := extractClrSysMemberIDFromParty40ChoiceCamt029(response.Assgnmt.Assgne)
+	receiverABANumber := payment.MemberIDFromCamt029Agent(response.Assgnmt.Assgne.Agt)
 	if receiverABANumber == "" {
-		receiverABANumber = extractClrSysMemberIDCamt029(appHdr.Fr)
+		receiverABANumber = payment.MemberIDFromHead(appHdr.Fr)
 	}
 
 	msg := FedNowMessageCxlRsp{
 		FedNowMsg: FedNowCxlRsp{
 			CreationDateTime: response.Assgnmt.CreDtTm,
 			Identifier: FedNowIdentifierCxlRsp{
-				BusinessMessageID: camt_029_001_09.Max35Text(appHdr.BizMsgIdr),
-				MessageID:         camt_029_001_09.Max35Text(response.Assgnmt.Id),
-				MessageType:       camt_029_001_09.Max35Text(appHdr.MsgDefIdr),
+				BusinessMessageID: string(appHdr.BizMsgIdr),
+				MessageID:         string(response.Assgnmt.Id),
+				MessageType:       string(appHdr.MsgDefIdr),
 				CreationDateTime:  common.ISODateTime(appHdr.CreDt),
-			},
+			}.WithHeader(appHdr),
 			ResolvedCase:        resolvedCase,
 			InvestigationStatus: status,
 			CancellationDetails: details,
@@ -243,34 +264,3 @@ func ParseCamt029(appHdr head.BusinessApplicationHeaderV02, document camt_029_00
 
 	return &msg, nil
 }
-
-func extractClrSysMemberIDCamt029(party head.Party44Choice) camt_029_001_09.Max35Text {
-	if party.FIId == nil || party.FIId.FinInstnId.ClrSysMmbId == nil {
-		return ""
-	}
-	return camt_029_001_09.Max35Text(party.FIId.FinInstnId.ClrSysMmbId.MmbId)
-}
-
-func extractCaseCreatorMemberID(party camt_029_001_09.Party40Choice) camt_029_001_09.Max35Text {
-	if party.Agt == nil || party.Agt.FinInstnId.ClrSysMmbId == nil {
-		return ""
-	}
-	return camt_029_001_09.Max35Text(party.Agt.FinInstnId.ClrSysMmbId.MmbId)
-}
-
-func extractClrSysMemberIDFromParty40ChoiceCamt029(party camt_029_001_09.Party40Choice) camt_029_001_09.Max35Text {
-	if party.Agt == nil || party.Agt.FinInstnId.ClrSysMmbId == nil {
-		return ""
-	}
-	return camt_029_001_09.Max35Text(party.Agt.FinInstnId.ClrSysMmbId.MmbId)
-}
-
-func diMemberID(di FedNowDepositoryInstitution2) camt_029_001_09.Max35Text {
-	if di.SenderABANumber != "" {
-		return di.SenderABANumber
-	}
-	if di.ReceiverABANumber != "" {
-		return di.ReceiverABANumber
-	}
-	return ""
-}
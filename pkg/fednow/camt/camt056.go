@@ -9,13 +9,30 @@ import (
 	camt_056_001_08 "github.com/mbanq/iso20022-go/ISO20022/camt_056_001_08"
 	head "github.com/mbanq/iso20022-go/ISO20022/head_001_001_02"
 	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow/codes"
 	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+	"github.com/mbanq/iso20022-go/pkg/payment"
 )
 
 func BuildCamt056Struct(message FedNowMessageCxlReq, msgConfig *config.Config) (*camt_056_001_08.Document, error) {
 	fedMsg := message.FedNowMsg
 
-	clearingSystemId := camt_056_001_08.ExternalClearingSystemIdentification1Code(msgConfig.ClearingSystemId)
+	clearingSystemId := string(msgConfig.ClearingSystemId)
+
+	// Routing Number Validation
+	if err := common.ValidateRoutingNumber("fedNowMessage.senderDepositoryInstitution.senderABANumber", fedMsg.SenderDI.SenderABANumber); err != nil {
+		return nil, common.NewValidationError("camt.056.001.08", err)
+	}
+	if err := common.ValidateRoutingNumber("fedNowMessage.receiverDepositoryInstitution.receiverABANumber", fedMsg.ReceiverDI.ReceiverABANumber); err != nil {
+		return nil, common.NewValidationError("camt.056.001.08", err)
+	}
+
+	// Code Validation
+	if fedMsg.CancellationReason != nil {
+		if err := codes.Validate("fedNowMessage.cancellationReason", "camt.056.001.08", codes.CancellationReason, string(*fedMsg.CancellationReason)); err != nil {
+			return nil, common.NewValidationError("camt.056.001.08", err)
+		}
+	}
 
 	// OrgnlCreDtTm is optional.
 	var orgnlCreationTime *common.ISODateTime
@@ -25,28 +42,10 @@ func BuildCamt056Struct(message FedNowMessageCxlReq, msgConfig *config.Config) (
 	}
 
 	assgnr := camt_056_001_08.Party40Choice{
-		Agt: &camt_056_001_08.BranchAndFinancialInstitutionIdentification6{
-			FinInstnId: camt_056_001_08.FinancialInstitutionIdentification18{
-				ClrSysMmbId: &camt_056_001_08.ClearingSystemMemberIdentification2{
-					MmbId: camt_056_001_08.Max35Text(fedMsg.SenderDI.SenderABANumber),
-					ClrSysId: &camt_056_001_08.ClearingSystemIdentification2Choice{
-						Cd: &clearingSystemId,
-					},
-				},
-			},
-		},
+		Agt: payment.AgentToCamt056(fedMsg.SenderDI.SenderABANumber, nil, clearingSystemId),
 	}
 	assgne := camt_056_001_08.Party40Choice{
-		Agt: &camt_056_001_08.BranchAndFinancialInstitutionIdentification6{
-			FinInstnId: camt_056_001_08.FinancialInstitutionIdentification18{
-				ClrSysMmbId: &camt_056_001_08.ClearingSystemMemberIdentification2{
-					MmbId: camt_056_001_08.Max35Text(fedMsg.ReceiverDI.ReceiverABANumber),
-					ClrSysId: &camt_056_001_08.ClearingSystemIdentification2Choice{
-						Cd: &clearingSystemId,
-					},
-				},
-			},
-		},
+		Agt: payment.AgentToCamt056(fedMsg.ReceiverDI.ReceiverABANumber, nil, clearingSystemId),
 	}
 
 	// Cancellation reason (optional) mapped onto both group and transaction levels.
@@ -79,10 +78,10 @@ func BuildCamt056Struct(message FedNowMessageCxlReq, msgConfig *config.Config) (
 	}
 
 	txInf := camt_056_001_08.PaymentTransaction106{
-		OrgnlInstrId:    fedMsg.OriginalIdentifier.InstructionID,
+		OrgnlInstrId:    (*camt_056_001_08.Max35Text)(fedMsg.OriginalIdentifier.InstructionID),
 		OrgnlEndToEndId: (*camt_056_001_08.Max35Text)(&fedMsg.OriginalIdentifier.EndToEndID),
-		OrgnlTxId:       fedMsg.OriginalIdentifier.TransactionID,
-		OrgnlUETR:       fedMsg.OriginalIdentifier.UETR,
+		OrgnlTxId:       (*camt_056_001_08.Max35Text)(fedMsg.OriginalIdentifier.TransactionID),
+		OrgnlUETR:       (*camt_056_001_08.UUIDv4Identifier)(fedMsg.OriginalIdentifier.UETR),
 		Assgnr:          assgnr.Agt,
 		Assgne:          assgne.Agt,
 		CxlRsnInf:       cxlRsnInf,
@@ -112,7 +111,7 @@ func BuildCamt056Struct(message FedNowMessageCxlReq, msgConfig *config.Config) (
 func BuildCamt056(payload []byte, cfg *config.Config) (*camt_056_001_08.Document, error) {
 	var message FedNowMessageCxlReq
 	if err := json.Unmarshal(payload, &message); err != nil {
-		return nil, err
+		return nil, common.NewJSONDecodeError("camt.056.001.08", payload, err)
 	}
 	return BuildCamt056Struct(message, cfg)
 }
@@ -122,21 +121,21 @@ func ParseCamt056(appHdr head.BusinessApplicationHeaderV02, document camt_056_00
 
 	// Extract original identifiers (best-effort, first underlying + first tx).
 	var (
-		origMsgId      camt_056_001_08.Max35Text
-		origMsgNmId    camt_056_001_08.Max35Text
+		origMsgId      string
+		origMsgNmId    string
 		origCreDtTm    common.ISODateTime
-		origInstrId    *camt_056_001_08.Max35Text
-		origEndToEndId camt_056_001_08.Max35Text
-		origTxId       *camt_056_001_08.Max35Text
-		origUETR       *camt_056_001_08.UUIDv4Identifier
+		origInstrId    *string
+		origEndToEndId string
+		origTxId       *string
+		origUETR       *string
 		cxlReason      *camt_056_001_08.ExternalCancellationReason1Code
 		addtlInfo      *camt_056_001_08.Max105Text
 	)
 
 	if len(req.Undrlyg) > 0 && req.Undrlyg[0].OrgnlGrpInfAndCxl != nil {
 		grp := req.Undrlyg[0].OrgnlGrpInfAndCxl
-		origMsgId = grp.OrgnlMsgId
-		origMsgNmId = grp.OrgnlMsgNmId
+		origMsgId = string(grp.OrgnlMsgId)
+		origMsgNmId = string(grp.OrgnlMsgNmId)
 		if grp.OrgnlCreDtTm != nil {
 			origCreDtTm = common.ISODateTime(*grp.OrgnlCreDtTm)
 		}
@@ -154,21 +153,21 @@ func ParseCamt056(appHdr head.BusinessApplicationHeaderV02, document camt_056_00
 		// If group info wasn't provided at Undrlyg level, fall back to TxInf.OrgnlGrpInf.
 		if tx.OrgnlGrpInf != nil && (origMsgId == "" || origMsgNmId == "" || time.Time(origCreDtTm).IsZero()) {
 			if origMsgId == "" {
-				origMsgId = tx.OrgnlGrpInf.OrgnlMsgId
+				origMsgId = string(tx.OrgnlGrpInf.OrgnlMsgId)
 			}
 			if origMsgNmId == "" {
-				origMsgNmId = tx.OrgnlGrpInf.OrgnlMsgNmId
+				origMsgNmId = string(tx.OrgnlGrpInf.OrgnlMsgNmId)
 			}
 			if tx.OrgnlGrpInf.OrgnlCreDtTm != nil && time.Time(origCreDtTm).IsZero() {
 				origCreDtTm = common.ISODateTime(*tx.OrgnlGrpInf.OrgnlCreDtTm)
 			}
 		}
-		origInstrId = tx.OrgnlInstrId
+		origInstrId = (*string)(tx.OrgnlInstrId)
 		if tx.OrgnlEndToEndId != nil {
-			origEndToEndId = *tx.OrgnlEndToEndId
+			origEndToEndId = string(*tx.OrgnlEndToEndId)
 		}
-		origTxId = tx.OrgnlTxId
-		origUETR = tx.OrgnlUETR
+		origTxId = (*string)(tx.OrgnlTxId)
+		origUETR = (*string)(tx.OrgnlUETR)
 		// If group didn't have reason, fall back to tx-level.
 		if cxlReason == nil && len(tx.CxlRsnInf) > 0 && tx.CxlRsnInf[0].Rsn != nil && tx.CxlRsnInf[0].Rsn.Cd != nil {
 			cxlReason = tx.CxlRsnInf[0].Rsn.Cd
@@ -179,24 +178,24 @@ func ParseCamt056(appHdr head.BusinessApplicationHeaderV02, document camt_056_00
 		}
 	}
 
-	senderABANumber := extractClrSysMemberIDFromParty40ChoiceCamt056(req.Assgnmt.Assgnr)
+	senderABANumber := payment.MemberIDFromCamt056Agent(req.Assgnmt.Assgnr.Agt)
 	if senderABANumber == "" {
-		senderABANumber = extractClrSysMemberID(appHdr.To)
+		senderABANumber = payment.MemberIDFromHead(appHdr.To)
 	}
-	receiverABANumber := extractClrSysMemberIDFromParty40ChoiceCamt056(req.Assgnmt.Assgne)
+	receiverABANumber := payment.MemberIDFromCamt056Agent(req.Assgnmt.Assgne.Agt)
 	if receiverABANumber == "" {
-		receiverABANumber = extractClrSysMemberID(appHdr.Fr)
+		receiverABANumber = payment.MemberIDFromHead(appHdr.Fr)
 	}
 
 	msg := FedNowMessageCxlReq{
 		FedNowMsg: FedNowCxlReq{
 			CreationDateTime: common.ISODateTime(req.Assgnmt.CreDtTm),
 			Identifier: FedNowIdentifier{
-				BusinessMessageID: camt_056_001_08.Max35Text(appHdr.BizMsgIdr),
-				MessageID:         camt_056_001_08.Max35Text(req.Assgnmt.Id),
-				MessageType:       camt_056_001_08.Max35Text(appHdr.MsgDefIdr),
+				BusinessMessageID: string(appHdr.BizMsgIdr),
+				MessageID:         string(req.Assgnmt.Id),
+				MessageType:       string(appHdr.MsgDefIdr),
 				CreationDateTime:  common.ISODateTime(appHdr.CreDt),
-			},
+			}.WithHeader(appHdr),
 			OriginalIdentifier: FedNowIdentifier{
 				MessageID:        origMsgId,
 				MessageType:      origMsgNmId,
@@ -219,17 +218,3 @@ func ParseCamt056(appHdr head.BusinessApplicationHeaderV02, document camt_056_00
 
 	return &msg, nil
 }
-
-func extractClrSysMemberID(party head.Party44Choice) camt_056_001_08.Max35Text {
-	if party.FIId == nil || party.FIId.FinInstnId.ClrSysMmbId == nil {
-		return ""
-	}
-	return camt_056_001_08.Max35Text(party.FIId.FinInstnId.ClrSysMmbId.MmbId)
-}
-
-func extractClrSysMemberIDFromParty40ChoiceCamt056(party camt_056_001_08.Party40Choice) camt_056_001_08.Max35Text {
-	if party.Agt == nil || party.Agt.FinInstnId.ClrSysMmbId == nil {
-		return ""
-	}
-	return camt_056_001_08.Max35Text(party.Agt.FinInstnId.ClrSysMmbId.MmbId)
-}
@@ -4,6 +4,7 @@ import (
 	camt_029_001_09 "github.com/mbanq/iso20022-go/ISO20022/camt_029_001_09"
 	camt_056_001_08 "github.com/mbanq/iso20022-go/ISO20022/camt_056_001_08"
 	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/payment"
 )
 
 // FedNowMessageCxlReq represents a FedNow camt.056 cancellation request message.
@@ -26,22 +27,14 @@ type FedNowCxlReq struct {
 	ReceiverDI         FedNowDepositoryInstitution                      `json:"receiverDepositoryInstitution"`
 }
 
-type FedNowIdentifier struct {
-	BusinessMessageID camt_056_001_08.Max35Text         `json:"businessMessageId"`
-	MessageID         camt_056_001_08.Max35Text         `json:"messageId"`
-	MessageType       camt_056_001_08.Max35Text         `json:"messageType,omitempty"`
-	InstructionID     *camt_056_001_08.Max35Text        `json:"instructionId,omitempty"`
-	EndToEndID        camt_056_001_08.Max35Text         `json:"endToEndId,omitempty"`
-	TransactionID     *camt_056_001_08.Max35Text        `json:"transactionId,omitempty"`
-	UETR              *camt_056_001_08.UUIDv4Identifier `json:"uetr,omitempty"`
-	CreationDateTime  common.ISODateTime                `json:"creationDateTime,omitempty"`
-}
+// FedNowIdentifier is the shared message identifier.
+type FedNowIdentifier = payment.Identifier
 
-type FedNowDepositoryInstitution struct {
-	SenderABANumber   camt_056_001_08.Max35Text   `json:"senderABANumber,omitempty"`
-	ReceiverABANumber camt_056_001_08.Max35Text   `json:"receiverABANumber,omitempty"`
-	Name              *camt_056_001_08.Max140Text `json:"senderShortName,omitempty"`
-}
+// FedNowDepositoryInstitution is the shared participant identification.
+type FedNowDepositoryInstitution = payment.DepositoryInstitution
+
+// FedNowAmount is the shared currency amount.
+type FedNowAmount = payment.Amount
 
 // FlowType constants identify the FedNow business flow context.
 // A single ISO message type (e.g. camt.029.001.09) can appear in
@@ -99,18 +92,11 @@ type FedNowCxlRsp struct {
 	ReceiverDI          FedNowDepositoryInstitution2 `json:"receiverDepositoryInstitution"`
 }
 
-type FedNowIdentifierCxlRsp struct {
-	BusinessMessageID camt_029_001_09.Max35Text `json:"businessMessageId"`
-	MessageID         camt_029_001_09.Max35Text `json:"messageId"`
-	MessageType       camt_029_001_09.Max35Text `json:"messageType,omitempty"`
-	CreationDateTime  common.ISODateTime        `json:"creationDateTime,omitempty"`
-}
+// FedNowIdentifierCxlRsp is the shared message identifier.
+type FedNowIdentifierCxlRsp = payment.Identifier
 
-type FedNowDepositoryInstitution2 struct {
-	SenderABANumber   camt_029_001_09.Max35Text   `json:"senderABANumber,omitempty"`
-	ReceiverABANumber camt_029_001_09.Max35Text   `json:"receiverABANumber,omitempty"`
-	Name              *camt_029_001_09.Max140Text `json:"senderShortName,omitempty"`
-}
+// FedNowDepositoryInstitution2 is the shared participant identification.
+type FedNowDepositoryInstitution2 = payment.DepositoryInstitution
 
 type FedNowCase struct {
 	CaseID    camt_029_001_09.Max35Text    `json:"caseId"`
@@ -131,11 +117,11 @@ type FedNowOriginalGroupInfo struct {
 }
 
 type FedNowResolutionRelatedInfo struct {
-	EndToEndID                *camt_029_001_09.Max35Text                         `json:"endToEndId,omitempty"`
-	TransactionID             *camt_029_001_09.Max35Text                         `json:"transactionId,omitempty"`
-	UETR                      *camt_029_001_09.UUIDv4Identifier                  `json:"uetr,omitempty"`
-	InterbankSettlementAmount *camt_029_001_09.ActiveOrHistoricCurrencyAndAmount `json:"interbankSettlementAmount,omitempty"`
-	InterbankSettlementDate   *common.ISODate                                    `json:"interbankSettlementDate,omitempty"`
+	EndToEndID                *camt_029_001_09.Max35Text        `json:"endToEndId,omitempty"`
+	TransactionID             *camt_029_001_09.Max35Text        `json:"transactionId,omitempty"`
+	UETR                      *camt_029_001_09.UUIDv4Identifier `json:"uetr,omitempty"`
+	InterbankSettlementAmount *FedNowAmount                     `json:"interbankSettlementAmount,omitempty"`
+	InterbankSettlementDate   *common.ISODate                   `json:"interbankSettlementDate,omitempty"`
 }
 
 type FedNowCxlRspDetails struct {
@@ -144,4 +130,8 @@ type FedNowCxlRspDetails struct {
 	OriginalEndToEndID    *camt_029_001_09.Max35Text        `json:"originalEndToEndId,omitempty"`
 	OriginalUETR          *camt_029_001_09.UUIDv4Identifier `json:"originalUetr,omitempty"`
 	ResolutionRelatedInfo *FedNowResolutionRelatedInfo      `json:"resolutionRelatedInformation,omitempty"`
+	// RejectionReason is the reason a rejected cancellation (RJCR) was not
+	// accepted for this transaction, sent as CxlStsRsnInf.
+	RejectionReason *camt_029_001_09.ExternalPaymentCancellationRejection1Code `json:"rejectionReason,omitempty"`
+	AdditionalInfo  []camt_029_001_09.Max105Text                               `json:"additionalInformation,omitempty"`
 }
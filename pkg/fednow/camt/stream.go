@@ -0,0 +1,150 @@
+package camt
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+
+	camt052 "github.com/mbanq/iso20022-go/ISO20022/camt_052_001_08"
+	camt054 "github.com/mbanq/iso20022-go/ISO20022/camt_054_001_08"
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+// Camt052Entry is one entry (Ntry) of a camt.052 account report, with the
+// group header of the message and the account of its report.
+type Camt052Entry struct {
+	GroupHeader camt052.GroupHeader81
+	ReportID    string
+	Account     camt052.CashAccount39
+	Entry       camt052.ReportEntry10
+}
+
+// Camt054Entry is one entry (Ntry) of a camt.054 debit or credit
+// notification, with the group header of the message and the account of its
+// notification.
+type Camt054Entry struct {
+	GroupHeader    camt054.GroupHeader81
+	NotificationID string
+	Account        camt054.CashAccount39
+	Entry          camt054.ReportEntry10
+}
+
+// StreamCamt052 reads a camt.052 account report from r, bare or in a FedNow
+// envelope, and calls fn with each entry in document order. Only one entry is
+// decoded at a time, so memory use does not grow with the size of the
+// report. An error returned by fn stops the stream and is returned as is.
+func StreamCamt052(r io.Reader, fn func(Camt052Entry) error) error {
+	return streamEntries(r, "camt.052.001.08", "BkToCstmrAcctRpt", "Rpt",
+		func(header *camt052.GroupHeader81, reportID string, account *camt052.CashAccount39, entry camt052.ReportEntry10) error {
+			return fn(Camt052Entry{GroupHeader: *header, ReportID: reportID, Account: *account, Entry: entry})
+		})
+}
+
+// StreamCamt054 reads a camt.054 debit or credit notification from r, bare or
+// in a FedNow envelope, and calls fn with each entry in document order, like
+// StreamCamt052.
+func StreamCamt054(r io.Reader, fn func(Camt054Entry) error) error {
+	return streamEntries(r, "camt.054.001.08", "BkToCstmrDbtCdtNtfctn", "Ntfctn",
+		func(header *camt054.GroupHeader81, notificationID string, account *camt054.CashAccount39, entry camt054.ReportEntry10) error {
+			return fn(Camt054Entry{GroupHeader: *header, NotificationID: notificationID, Account: *account, Entry: entry})
+		})
+}
+
+// errNoReport reports a stream without the message element.
+var errNoReport = errors.New("message element not found")
+
+// streamEntries walks the tokens of r down to the message element root, then
+// decodes its GrpHdr and, in each report element, the Id, the Acct and every
+// Ntry, skipping the other elements without decoding them.
+func streamEntries[H, A, E any](r io.Reader, messageType, root, report string, fn func(header *H, reportID string, account *A, entry E) error) error {
+	decoder := xml.NewDecoder(r)
+	fail := func(element string, err error) error {
+		line, column := decoder.InputPos()
+		return &common.DecodeError{Format: "xml", MessageType: messageType, Element: element, Line: line, Column: column, Err: err}
+	}
+
+	// Find the message element, wherever the envelope puts it.
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return fail(root, errNoReport)
+		}
+		if err != nil {
+			return fail("", err)
+		}
+		if se, ok := token.(xml.StartElement); ok && se.Name.Local == root {
+			break
+		}
+	}
+
+	var header H
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return fail(root, err)
+		}
+		switch t := token.(type) {
+		case xml.EndElement:
+			return nil
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "GrpHdr":
+				if err := decoder.DecodeElement(&header, &t); err != nil {
+					return fail("GrpHdr", err)
+				}
+			case report:
+				if err := streamReport(decoder, report, &header, fn, fail); err != nil {
+					return err
+				}
+			default:
+				if err := decoder.Skip(); err != nil {
+					return fail(t.Name.Local, err)
+				}
+			}
+		}
+	}
+}
+
+// streamReport decodes the children of a report element up to its end.
+func streamReport[H, A, E any](decoder *xml.Decoder, report string, header *H, fn func(*H, string, *A, E) error, fail func(string, error) error) error {
+	var (
+		id      string
+		account A
+		entries int
+	)
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return fail(report, err)
+		}
+		switch t := token.(type) {
+		case xml.EndElement:
+			return nil
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "Id":
+				if err := decoder.DecodeElement(&id, &t); err != nil {
+					return fail(report+".Id", err)
+				}
+			case "Acct":
+				if err := decoder.DecodeElement(&account, &t); err != nil {
+					return fail(report+".Acct", err)
+				}
+			case "Ntry":
+				var entry E
+				if err := decoder.DecodeElement(&entry, &t); err != nil {
+					return fail(fmt.Sprintf("%s.Ntry[%d]", report, entries), err)
+				}
+				entries++
+				if err := fn(header, id, &account, entry); err != nil {
+					return err
+				}
+			default:
+				if err := decoder.Skip(); err != nil {
+					return fail(report+"."+t.Name.Local, err)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Package codes catalogues the ISO 20022 external code sets as FedNow uses
+// them: the codes of each set with a human-readable description, and which
+// codes FedNow accepts in which message.
+package codes
+
+import (
+	"fmt"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+// Set identifies an ISO 20022 external code set by the name of its type.
+type Set string
+
+const (
+	PaymentStatus             Set = "ExternalPaymentTransactionStatus1Code"
+	StatusReason              Set = "ExternalStatusReason1Code"
+	ReturnReason              Set = "ExternalReturnReason1Code"
+	CancellationReason        Set = "ExternalCancellationReason1Code"
+	CancellationRejection     Set = "ExternalPaymentCancellationRejection1Code"
+	InvestigationConfirmation Set = "ExternalInvestigationExecutionConfirmation1Code"
+)
+
+// entry is a code of the catalogue, which scripts/gen_codes.go generates
+// from scripts/external_codes.json.
+type entry struct {
+	code        string
+	name        string
+	description string
+}
+
+// allowed lists, per message definition, the code sets the message carries
+// and the codes FedNow accepts in it. A nil list accepts every code of the
+// set.
+var allowed = map[string]map[Set][]string{
+	"pacs.002.001.10": {
+		PaymentStatus: {"ACCC", "ACSC", "ACSP", "ACTC", "ACWP", "PDNG", "RJCT"},
+		StatusReason:  nil,
+	},
+	"pacs.004.001.10": {
+		ReturnReason: nil,
+	},
+	"camt.056.001.08": {
+		CancellationReason: nil,
+	},
+	"camt.029.001.09": {
+		InvestigationConfirmation: nil,
+		CancellationRejection:     nil,
+	},
+}
+
+// Code is a single entry of an external code set.
+type Code struct {
+	Set         Set    `json:"set"`
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Sets returns the code sets in the catalogue.
+func Sets() []Set {
+	return []Set{PaymentStatus, StatusReason, ReturnReason, CancellationReason, CancellationRejection, InvestigationConfirmation}
+}
+
+// All returns every code of set, in catalogue order.
+func All(set Set) []Code {
+	entries := catalogue[set]
+	result := make([]Code, len(entries))
+	for i, e := range entries {
+		result[i] = Code{Set: set, Code: e.code, Name: e.name, Description: e.description}
+	}
+	return result
+}
+
+// Lookup returns the entry for code in set.
+func Lookup(set Set, code string) (Code, bool) {
+	for _, e := range catalogue[set] {
+		if e.code == code {
+			return Code{Set: set, Code: e.code, Name: e.name, Description: e.description}, true
+		}
+	}
+	return Code{}, false
+}
+
+// Describe returns the description of code in set, or the code itself when
+// it is not in the catalogue.
+func Describe(set Set, code string) string {
+	if c, ok := Lookup(set, code); ok {
+		return c.Description
+	}
+	return code
+}
+
+// Allowed returns the codes of set that FedNow accepts in messageType (e.g.
+// "pacs.002.001.10"). It returns nil when the message does not carry the set.
+func Allowed(messageType string, set Set) []Code {
+	codes, ok := allowed[messageType][set]
+	if !ok {
+		return nil
+	}
+	if codes == nil {
+		return All(set)
+	}
+	result := make([]Code, 0, len(codes))
+	for _, code := range codes {
+		if c, ok := Lookup(set, code); ok {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// IsAllowed reports whether FedNow accepts code of set in messageType.
+func IsAllowed(messageType string, set Set, code string) bool {
+	for _, c := range Allowed(messageType, set) {
+		if c.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that code is a known code of set that FedNow accepts in
+// messageType. Errors are *common.FieldError values at path.
+func Validate(path, messageType string, set Set, code string) error {
+	if _, ok := Lookup(set, code); !ok {
+		return &common.FieldError{Path: path, Value: code, Reason: fmt.Sprintf("is not a known %s", set)}
+	}
+	if !IsAllowed(messageType, set, code) {
+		return &common.FieldError{Path: path, Value: code, Reason: fmt.Sprintf("is not allowed in %s", messageType)}
+	}
+	return nil
+}
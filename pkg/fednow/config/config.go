@@ -6,6 +6,7 @@ import (
 
 	head "github.com/mbanq/iso20022-go/ISO20022/head_001_001_02"
 	pacs "github.com/mbanq/iso20022-go/ISO20022/pacs_008_001_08"
+	"github.com/mbanq/iso20022-go/pkg/common"
 )
 
 type Config struct {
@@ -19,6 +20,9 @@ type Config struct {
 	ClearingSystem         head.ExternalClearingSystemIdentification1Code `json:"clearingSystem"`
 	FrbId                  head.Max35Text                                 `json:"frbId"`
 	IspId                  head.Max35Text                                 `json:"ispId"`
+	AddressPolicy          common.AddressPolicy                           `json:"addressPolicy,omitempty"`
+	Limits                 Limits                                         `json:"limits,omitempty"`
+	MessageIDSource        string                                         `json:"messageIdSource,omitempty"`
 }
 
 func LoadConfig(configPath string) (*Config, error) {
@@ -32,6 +36,26 @@ func LoadConfig(configPath string) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
 
 	return config, nil
 }
+
+// Validate checks the configuration values that every message relies on.
+func (c *Config) Validate() error {
+	if err := common.ValidateFedNowCurrency("currency", string(c.Currency)); err != nil {
+		return err
+	}
+	if !c.SettlementMethod.IsValid() {
+		return &common.FieldError{Path: "settlementMethod", Value: string(c.SettlementMethod), Reason: "is not a SettlementMethod1Code"}
+	}
+	if !c.ChargeBearer.IsValid() {
+		return &common.FieldError{Path: "chargeBearer", Value: string(c.ChargeBearer), Reason: "is not a ChargeBearerType1Code"}
+	}
+	if err := c.Limits.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+// DefaultNetworkLimit is the FedNow network limit for a single credit
+// transfer, applied when Limits.NetworkMax is not configured.
+var DefaultNetworkLimit = common.MustParseAmount("1000000.00")
+
+// Kinds of limit reported by LimitError.
+const (
+	LimitNetwork           = "network"
+	LimitParticipant       = "participant"
+	LimitCustomerType      = "customerType"
+	LimitRequestForPayment = "requestForPayment"
+)
+
+// Limits caps the amount of outgoing credit transfers (pacs.008) and requests
+// for payment (pain.013). Limits that are not configured are not checked,
+// except the network limit, which defaults to DefaultNetworkLimit.
+type Limits struct {
+	// NetworkMax is the FedNow network maximum.
+	NetworkMax *common.Amount `json:"networkMax,omitempty"`
+	// ParticipantMax is the credit transfer limit the participant has set
+	// with FedNow, at or below the network maximum.
+	ParticipantMax *common.Amount `json:"participantMax,omitempty"`
+	// CustomerTypeMax holds per-product limits keyed by the customerType of
+	// the payload (e.g. "retail", "business").
+	CustomerTypeMax map[string]common.Amount `json:"customerTypeMax,omitempty"`
+	// RequestForPaymentMax caps the amount requested in a request for payment.
+	RequestForPaymentMax *common.Amount `json:"requestForPaymentMax,omitempty"`
+}
+
+// LimitError reports an amount above a configured limit.
+type LimitError struct {
+	// Limit is the kind of limit exceeded, e.g. LimitParticipant.
+	Limit string
+	// CustomerType is set when Limit is LimitCustomerType.
+	CustomerType string
+	Max          common.Amount
+	Amount       common.Amount
+}
+
+func (e *LimitError) Error() string {
+	if e.Limit == LimitCustomerType {
+		return fmt.Sprintf("amount %s exceeds the limit of %s for customer type %q", e.Amount, e.Max, e.CustomerType)
+	}
+	return fmt.Sprintf("amount %s exceeds the %s limit of %s", e.Amount, e.Limit, e.Max)
+}
+
+// Network returns the network maximum in effect.
+func (l Limits) Network() common.Amount {
+	if l.NetworkMax != nil {
+		return *l.NetworkMax
+	}
+	return DefaultNetworkLimit
+}
+
+// CheckCreditTransfer checks the amount of a credit transfer against the
+// network, participant and customer type limits, in that order.
+func (l Limits) CheckCreditTransfer(amount common.Amount, customerType string) error {
+	if err := l.check(LimitNetwork, "", l.Network(), amount); err != nil {
+		return err
+	}
+	if l.ParticipantMax != nil {
+		if err := l.check(LimitParticipant, "", *l.ParticipantMax, amount); err != nil {
+			return err
+		}
+	}
+	return l.checkCustomerType(amount, customerType)
+}
+
+// CheckRequestForPayment checks the amount of a request for payment against
+// the network, request for payment and customer type limits, in that order.
+func (l Limits) CheckRequestForPayment(amount common.Amount, customerType string) error {
+	if err := l.check(LimitNetwork, "", l.Network(), amount); err != nil {
+		return err
+	}
+	if l.RequestForPaymentMax != nil {
+		if err := l.check(LimitRequestForPayment, "", *l.RequestForPaymentMax, amount); err != nil {
+			return err
+		}
+	}
+	return l.checkCustomerType(amount, customerType)
+}
+
+func (l Limits) checkCustomerType(amount common.Amount, customerType string) error {
+	if customerType == "" {
+		return nil
+	}
+	max, ok := l.CustomerTypeMax[customerType]
+	if !ok {
+		return nil
+	}
+	return l.check(LimitCustomerType, customerType, max, amount)
+}
+
+func (l Limits) check(limit, customerType string, max, amount common.Amount) error {
+	if amount.Cmp(max) > 0 {
+		return &LimitError{Limit: limit, CustomerType: customerType, Max: max, Amount: amount}
+	}
+	return nil
+}
+
+// Validate checks that every configured limit is positive and that the
+// participant limit does not exceed the network maximum.
+func (l Limits) Validate() error {
+	if l.NetworkMax != nil && l.NetworkMax.Validate() != nil {
+		return &common.FieldError{Path: "limits.networkMax", Value: l.NetworkMax.String(), Reason: "must be greater than zero"}
+	}
+	if l.ParticipantMax != nil {
+		if l.ParticipantMax.Validate() != nil {
+			return &common.FieldError{Path: "limits.participantMax", Value: l.ParticipantMax.String(), Reason: "must be greater than zero"}
+		}
+		if l.ParticipantMax.Cmp(l.Network()) > 0 {
+			return &common.FieldError{Path: "limits.participantMax", Value: l.ParticipantMax.String(), Reason: fmt.Sprintf("exceeds the network limit of %s", l.Network())}
+		}
+	}
+	if l.RequestForPaymentMax != nil && l.RequestForPaymentMax.Validate() != nil {
+		return &common.FieldError{Path: "limits.requestForPaymentMax", Value: l.RequestForPaymentMax.String(), Reason: "must be greater than zero"}
+	}
+	for customerType, max := range l.CustomerTypeMax {
+		if max.Validate() != nil {
+			return &common.FieldError{Path: "limits.customerTypeMax." + customerType, Value: max.String(), Reason: "must be greater than zero"}
+		}
+	}
+	return nil
+}
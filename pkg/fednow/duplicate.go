@@ -0,0 +1,37 @@
+package fednow
+
+import "sync"
+
+// DuplicateStore remembers the inbound messages seen by Parse, so that a
+// message received twice, e.g. resent after a timeout, is not processed
+// twice. Implementations backed by a database can be shared by several
+// receivers.
+type DuplicateStore interface {
+	// Seen records the BizMsgIdr of a message from the sender with routing
+	// number from, and reports whether it was recorded before.
+	Seen(from, bizMsgIdr string) (bool, error)
+}
+
+// MemoryDuplicateStore is an in-memory DuplicateStore. It grows without
+// bound and forgets everything on restart, so it only suits tests and
+// short-lived receivers.
+type MemoryDuplicateStore struct {
+	mu   sync.Mutex
+	seen map[[2]string]struct{}
+}
+
+// Seen records from and bizMsgIdr and reports whether they were recorded
+// before.
+func (s *MemoryDuplicateStore) Seen(from, bizMsgIdr string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := [2]string{from, bizMsgIdr}
+	if _, ok := s.seen[key]; ok {
+		return true, nil
+	}
+	if s.seen == nil {
+		s.seen = make(map[[2]string]struct{})
+	}
+	s.seen[key] = struct{}{}
+	return false, nil
+}
@@ -0,0 +1,45 @@
+package fednow
+
+import (
+	"bufio"
+	"io"
+	"slices"
+
+	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+)
+
+// Encoder writes a stream of FedNow messages, each in its own envelope and
+// followed by a newline, e.g. to a batch file. Every message is built and
+// written in turn, so memory use does not grow with the length of the
+// stream.
+type Encoder struct {
+	w      *bufio.Writer
+	schema *EnvelopeSchema
+	cfg    *config.Config
+	format Format
+	opts   []Option
+}
+
+// NewEncoder returns an Encoder writing to w in the envelope described by
+// schema, as loaded by LoadEnvelopeSchema. opts apply to every message.
+func NewEncoder(w io.Writer, schema *EnvelopeSchema, cfg *config.Config, format Format, opts ...Option) *Encoder {
+	return &Encoder{w: bufio.NewWriter(w), schema: schema, cfg: cfg, format: format, opts: opts}
+}
+
+// Encode builds message and writes its envelope. opts are applied after
+// those of NewEncoder. Output is buffered; call Flush when done.
+func (e *Encoder) Encode(messageType string, message FedNowMessage, opts ...Option) error {
+	if e.schema == nil {
+		return &EnvelopeError{MessageType: messageType, Element: "envelope schema"}
+	}
+	load := func() (*EnvelopeSchema, error) { return e.schema, nil }
+	if err := generate(e.w, load, messageType, e.cfg, message, e.format, slices.Concat(e.opts, opts)); err != nil {
+		return err
+	}
+	return e.w.WriteByte('\n')
+}
+
+// Flush writes any buffered output to the underlying writer.
+func (e *Encoder) Flush() error {
+	return e.w.Flush()
+}
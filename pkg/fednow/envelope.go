@@ -0,0 +1,99 @@
+package fednow
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	head "github.com/mbanq/iso20022-go/ISO20022/head_001_001_02"
+)
+
+// headNamespace is the namespace of the AppHdr.
+const headNamespace = "urn:iso:std:iso:20022:tech:xsd:head.001.001.02"
+
+// Format sets the whitespace of generated XML. The zero value is compact.
+type Format struct {
+	// Prefix starts every indented line.
+	Prefix string
+	// Indent is repeated once per nesting level. No line breaks are written
+	// when both Prefix and Indent are empty.
+	Indent string
+}
+
+var (
+	// Indented is the format of Generate, indenting by four spaces.
+	Indented = Format{Indent: "    "}
+	// Compact writes the XML on a single line.
+	Compact = Format{}
+)
+
+// WriteMessage writes the AppHdr and Document of a registered message type to
+// w, without the FedNow envelope. The Document is written in the namespace of
+// the registration.
+func WriteMessage(w io.Writer, messageType string, appHdr *head.BusinessApplicationHeaderV02, document any, format Format) error {
+	registered, ok := Lookup(messageType)
+	if !ok {
+		return &UnsupportedMessageError{MessageType: messageType}
+	}
+	encoder := newEncoder(w, format)
+	if err := encodeMessage(encoder, registered.Namespace, appHdr, document); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// writeEnvelope writes appHdr and document inside the root and message
+// elements of schema and wrapper.
+func writeEnvelope(w io.Writer, schema *EnvelopeSchema, wrapper, namespace string, appHdr *head.BusinessApplicationHeaderV02, document any, format Format) error {
+	encoder := newEncoder(w, format)
+	elements := []xml.StartElement{
+		namespaced(schema.Root, schema.Namespace),
+		{Name: xml.Name{Local: schema.Message}},
+		{Name: xml.Name{Local: wrapper}},
+	}
+	for _, element := range elements {
+		if err := encoder.EncodeToken(element); err != nil {
+			return err
+		}
+	}
+	if err := encodeMessage(encoder, namespace, appHdr, document); err != nil {
+		return err
+	}
+	for i := len(elements) - 1; i >= 0; i-- {
+		if err := encoder.EncodeToken(elements[i].End()); err != nil {
+			return err
+		}
+	}
+	return encoder.Close()
+}
+
+func newEncoder(w io.Writer, format Format) *xml.Encoder {
+	encoder := xml.NewEncoder(w)
+	encoder.Indent(format.Prefix, format.Indent)
+	return encoder
+}
+
+// encodeMessage encodes appHdr as the AppHdr element and document as the
+// Document element in namespace. The element names replace those of the Go
+// types, e.g. BusinessApplicationHeaderV02.
+func encodeMessage(encoder *xml.Encoder, namespace string, appHdr *head.BusinessApplicationHeaderV02, document any) error {
+	if err := encoder.EncodeElement(appHdr, namespaced("AppHdr", headNamespace)); err != nil {
+		return fmt.Errorf("error marshalling AppHdr: %w", err)
+	}
+	if err := encoder.EncodeElement(document, namespaced("Document", namespace)); err != nil {
+		return fmt.Errorf("error marshalling document: %w", err)
+	}
+	return nil
+}
+
+// namespaced returns a start element declaring namespace as the default
+// namespace. The declaration is written as a plain xmlns attribute: with
+// xml.Name.Space set, encoding/xml would write xmlns="" on every child struct
+// of the generated models, which have an unqualified XMLName.
+func namespaced(local, namespace string) xml.StartElement {
+	start := xml.StartElement{Name: xml.Name{Local: local}}
+	if namespace != "" {
+		start.Attr = []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: namespace}}
+	}
+	return start
+}
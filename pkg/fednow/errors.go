@@ -0,0 +1,102 @@
+package fednow
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+// Sentinels for errors.Is. Each matches the error type of the same name, so
+// callers can branch on the kind of failure without errors.As.
+var (
+	ErrUnsupportedMessage = errors.New("unsupported message")
+	ErrValidation         = common.ErrValidation
+	ErrEnvelopeNotFound   = errors.New("envelope not found")
+	ErrXSDMismatch        = errors.New("XSD mismatch")
+	ErrDecode             = common.ErrDecode
+	ErrDuplicate          = errors.New("duplicate message")
+)
+
+// ValidationError reports a payload rejected by a message builder. Fields
+// lists the offending JSON fields.
+type ValidationError = common.ValidationError
+
+// DecodeError reports XML or JSON that could not be decoded, with the line
+// and column of the failure when known.
+type DecodeError = common.DecodeError
+
+// UnsupportedMessageError reports a message type without a handler, or a
+// message whose Go type does not match the requested message type.
+type UnsupportedMessageError struct {
+	MessageType string
+	// Got is the Go type of the message passed to Generate, when it did not
+	// match MessageType.
+	Got string
+}
+
+func (e *UnsupportedMessageError) Error() string {
+	if e.Got != "" {
+		return fmt.Sprintf("invalid message type for %s: %s", e.MessageType, e.Got)
+	}
+	return fmt.Sprintf("unsupported message type: %s", e.MessageType)
+}
+
+func (e *UnsupportedMessageError) Is(target error) bool { return target == ErrUnsupportedMessage }
+
+// EnvelopeError reports a FedNow envelope element that could not be found:
+// the AppHdr or Document of an incoming message, or the wrapper element of
+// the envelope XSD for an outgoing one.
+type EnvelopeError struct {
+	MessageType string
+	Element     string
+}
+
+func (e *EnvelopeError) Error() string {
+	if e.MessageType != "" {
+		return fmt.Sprintf("no %s found for %s", e.Element, e.MessageType)
+	}
+	return fmt.Sprintf("failed to find %s in XML", e.Element)
+}
+
+func (e *EnvelopeError) Is(target error) bool { return target == ErrEnvelopeNotFound }
+
+// XSDError reports an envelope XSD that could not be read or does not have
+// the structure of the FedNow envelope.
+type XSDError struct {
+	Path        string
+	MessageType string
+	Err         error
+}
+
+func (e *XSDError) Error() string {
+	if e.MessageType == "" {
+		return fmt.Sprintf("envelope XSD %s: %v", e.Path, e.Err)
+	}
+	return fmt.Sprintf("envelope XSD %s for %s: %v", e.Path, e.MessageType, e.Err)
+}
+
+func (e *XSDError) Unwrap() error { return e.Err }
+
+func (e *XSDError) Is(target error) bool { return target == ErrXSDMismatch }
+
+// DuplicateError reports an inbound message whose BizMsgIdr was already
+// received from the same sender, as recorded by the DuplicateStore passed to
+// Parse. PossibleDuplicate and CopyDuplicate are the PssblDplct and CpyDplct
+// of its AppHdr.
+type DuplicateError struct {
+	MessageType       string
+	From              string
+	BusinessMessageID string
+	PossibleDuplicate bool
+	CopyDuplicate     string
+}
+
+func (e *DuplicateError) Error() string {
+	if e.PossibleDuplicate {
+		return fmt.Sprintf("duplicate %s %s from %s (flagged as possible duplicate)", e.MessageType, e.BusinessMessageID, e.From)
+	}
+	return fmt.Sprintf("duplicate %s %s from %s", e.MessageType, e.BusinessMessageID, e.From)
+}
+
+func (e *DuplicateError) Is(target error) bool { return target == ErrDuplicate }
@@ -1,14 +1,15 @@
 package fednow
 
 import (
+	"bytes"
 	"encoding/xml"
 	"fmt"
 	"io"
 	"os"
 	"strings"
 	"sync"
-	"time"
 
+	admi002 "github.com/mbanq/iso20022-go/ISO20022/admi_002_001_01"
 	admi007 "github.com/mbanq/iso20022-go/ISO20022/admi_007_001_01"
 	camt029 "github.com/mbanq/iso20022-go/ISO20022/camt_029_001_09"
 	camt056 "github.com/mbanq/iso20022-go/ISO20022/camt_056_001_08"
@@ -24,307 +25,139 @@ import (
 	config "github.com/mbanq/iso20022-go/pkg/fednow/config"
 	"github.com/mbanq/iso20022-go/pkg/fednow/pacs"
 	"github.com/mbanq/iso20022-go/pkg/fednow/pain"
+	"github.com/mbanq/iso20022-go/pkg/payment"
 )
 
-type xsdCacheEntry struct {
-	rootElement    string
-	messageElement string
-	wrapperElement string
-	rootNs         string
-}
-
 var (
-	xsdCache = make(map[string]*xsdCacheEntry)
-	cacheMux = &sync.RWMutex{}
+	envelopeSchemas = make(map[string]*EnvelopeSchema)
+	cacheMux        = &sync.RWMutex{}
 )
 
 // Generate creates a FedNow XML envelope for a given message ID using the specified XSD file.
-func Generate(xsdPath, messageType string, config *config.Config, message FedNowMessage) ([]byte, error) {
-	// Determine preferred wrapper from message context (if the message
-	// implements WrapperPreferrer). This is needed when a single ISO
-	// message type maps to multiple FedNow wrapper elements.
-	var preferredWrapper string
-	if wp, ok := message.(WrapperPreferrer); ok {
-		preferredWrapper = wp.PreferredWrapper()
-	}
-
-	// Use a composite cache key so that different wrapper preferences for the
-	// same message type (e.g. camt.029 return vs information) are cached
-	// independently and don't overwrite each other.
-	cacheKey := messageType
-	if preferredWrapper != "" {
-		cacheKey = messageType + "|" + preferredWrapper
-	}
-
-	// First, try to read from the cache with a read lock.
-	cacheMux.RLock()
-	entry, found := xsdCache[cacheKey]
-	cacheMux.RUnlock()
-
-	if !found {
-
-		cacheMux.Lock()
-		defer cacheMux.Unlock()
-		entry, found = xsdCache[cacheKey]
-		if !found {
-			rootElement, messageElement, wrapperElement, rootNs, err := findWrapperForMessageID(xsdPath, messageType, preferredWrapper)
-			if err != nil {
-				return nil, fmt.Errorf("error finding wrapper element: %v", err)
-			}
-
-			entry = &xsdCacheEntry{
-				rootElement:    rootElement,
-				messageElement: messageElement,
-				wrapperElement: wrapperElement,
-				rootNs:         rootNs,
-			}
-			xsdCache[cacheKey] = entry
-		}
-	}
-
-	handler, ok := messageHandlers[messageType]
-	if !ok {
-		return nil, fmt.Errorf("unsupported message type: %s", messageType)
-	}
-
-	bah, pacs, err := handler(config, message)
-	if err != nil {
+// The envelope is indented; use GenerateTo for compact output.
+func Generate(xsdPath, messageType string, config *config.Config, message FedNowMessage, opts ...Option) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := GenerateTo(&buf, xsdPath, messageType, config, message, Indented, opts...); err != nil {
 		return nil, err
 	}
-
-	// Construct the final XML
-	finalXML := fmt.Sprintf("<%s xmlns=\"%s\">\n    <%s>\n        <%s>\n%s\n%s\n        </%s>\n    </%s>\n</%s>",
-		entry.rootElement,
-		entry.rootNs,
-		entry.messageElement,
-		entry.wrapperElement,
-		bah,
-		pacs,
-		entry.wrapperElement,
-		entry.messageElement,
-		entry.rootElement,
-	)
-
-	return []byte(finalXML), nil
+	return buf.Bytes(), nil
 }
 
-type messageHandler func(cfg *config.Config, msg FedNowMessage) (string, string, error)
-
-var messageHandlers = map[string]messageHandler{
-	"admi.007.001.01": handleAdmi007,
-	"pacs.008.001.08": handlePacs008,
-	"pacs.002.001.10": handlePacs002,
-	"pacs.004.001.10": handlePacs004,
-	"pain.013.001.07": handlePain013,
-	"camt.056.001.08": handleCamt056,
-	"camt.029.001.09": handleCamt029,
+// GenerateTo writes the FedNow XML envelope of message to w in the given
+// format. The message is built before anything is written.
+func GenerateTo(w io.Writer, xsdPath, messageType string, config *config.Config, message FedNowMessage, format Format, opts ...Option) error {
+	load := func() (*EnvelopeSchema, error) { return cachedEnvelopeSchema(xsdPath, messageType) }
+	return generate(w, load, messageType, config, message, format, opts)
 }
 
-func handleAdmi007(cfg *config.Config, message FedNowMessage) (string, string, error) {
-	msg, ok := message.(admi.FedNowMessageRctAck)
-	if !ok {
-		return "", "", fmt.Errorf("invalid message type for admi.007.001.01")
-	}
-
-	appHdr, document, err := GenerateAdmi007("admi.007.001.01", cfg, msg)
-	if err != nil {
-		return "", "", err
-	}
-
-	appHdrPayload, err := xml.MarshalIndent(appHdr, "            ", "    ")
-	if err != nil {
-		return "", "", fmt.Errorf("error marshalling AppHdr: %v", err)
-	}
-
-	bah := strings.Replace(string(appHdrPayload), "<BusinessApplicationHeaderV02>", "<AppHdr xmlns=\"urn:iso:std:iso:20022:tech:xsd:head.001.001.02\">", 1)
-	bah = strings.Replace(bah, "</BusinessApplicationHeaderV02>", "</AppHdr>", 1)
-
-	documentPayload, err := xml.MarshalIndent(document, "            ", "    ")
-	if err != nil {
-		return "", "", fmt.Errorf("error marshalling document: %v", err)
+// Generate creates a FedNow XML envelope for message in the envelope
+// described by s.
+func (s *EnvelopeSchema) Generate(messageType string, config *config.Config, message FedNowMessage, opts ...Option) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := s.GenerateTo(&buf, messageType, config, message, Indented, opts...); err != nil {
+		return nil, err
 	}
-
-	admi007Doc := strings.Replace(string(documentPayload), "<Document>", "<Document xmlns=\"urn:iso:std:iso:20022:tech:xsd:admi.007.001.01\">", 1)
-
-	return bah, admi007Doc, nil
+	return buf.Bytes(), nil
 }
 
-func handlePacs002(cfg *config.Config, message FedNowMessage) (string, string, error) {
-	msg, ok := message.(pacs.FedNowMessageACK)
-	if !ok {
-		return "", "", fmt.Errorf("invalid message type for pacs.002.001.10")
-	}
-
-	appHdr, document, err := GeneratePacs002("pacs.002.001.10", cfg, msg)
-	if err != nil {
-		return "", "", err
-	}
-
-	appHdrPayload, err := xml.MarshalIndent(appHdr, "            ", "    ")
-	if err != nil {
-		return "", "", fmt.Errorf("error marshalling AppHdr: %v", err)
-	}
-
-	bah := strings.Replace(string(appHdrPayload), "<BusinessApplicationHeaderV02>", "<AppHdr xmlns=\"urn:iso:std:iso:20022:tech:xsd:head.001.001.02\">", 1)
-	bah = strings.Replace(bah, "</BusinessApplicationHeaderV02>", "</AppHdr>", 1)
-
-	documentPayload, err := xml.MarshalIndent(document, "            ", "    ")
-	if err != nil {
-		return "", "", fmt.Errorf("error marshalling document: %v", err)
-	}
-
-	pacs002 := strings.Replace(string(documentPayload), "<Document>", "<Document xmlns=\"urn:iso:std:iso:20022:tech:xsd:pacs.002.001.10\">", 1)
-
-	return bah, pacs002, nil
+// GenerateTo writes the FedNow XML envelope of message to w in the given
+// format.
+func (s *EnvelopeSchema) GenerateTo(w io.Writer, messageType string, config *config.Config, message FedNowMessage, format Format, opts ...Option) error {
+	load := func() (*EnvelopeSchema, error) { return s, nil }
+	return generate(w, load, messageType, config, message, format, opts)
 }
 
-func handleCamt056(cfg *config.Config, message FedNowMessage) (string, string, error) {
-	msg, ok := message.(camt.FedNowMessageCxlReq)
-	if !ok {
-		return "", "", fmt.Errorf("invalid message type for camt.056.001.08")
-	}
-
-	appHdr, document, err := GenerateCamt056("camt.056.001.08", cfg, msg)
-	if err != nil {
-		return "", "", err
+func generate(w io.Writer, load func() (*EnvelopeSchema, error), messageType string, config *config.Config, message FedNowMessage, format Format, opts []Option) error {
+	registered, ok := Lookup(messageType)
+	if !ok || registered.Build == nil {
+		return &UnsupportedMessageError{MessageType: messageType}
 	}
 
-	appHdrPayload, err := xml.MarshalIndent(appHdr, "            ", "    ")
+	schema, err := load()
 	if err != nil {
-		return "", "", fmt.Errorf("error marshalling AppHdr: %v", err)
+		return err
 	}
 
-	bah := strings.Replace(string(appHdrPayload), "<BusinessApplicationHeaderV02>", "<AppHdr xmlns=\"urn:iso:std:iso:20022:tech:xsd:head.001.001.02\">", 1)
-	bah = strings.Replace(bah, "</BusinessApplicationHeaderV02>", "</AppHdr>", 1)
-
-	documentPayload, err := xml.MarshalIndent(document, "            ", "    ")
-	if err != nil {
-		return "", "", fmt.Errorf("error marshalling document: %v", err)
-	}
-
-	camt056Doc := strings.Replace(string(documentPayload), "<Document>", "<Document xmlns=\"urn:iso:std:iso:20022:tech:xsd:camt.056.001.08\">", 1)
-
-	return bah, camt056Doc, nil
-}
-
-func handleCamt029(cfg *config.Config, message FedNowMessage) (string, string, error) {
-	msg, ok := message.(camt.FedNowMessageCxlRsp)
-	if !ok {
-		return "", "", fmt.Errorf("invalid message type for camt.029.001.09")
-	}
-
-	appHdr, document, err := GenerateCamt029("camt.029.001.09", cfg, msg)
-	if err != nil {
-		return "", "", err
-	}
-
-	appHdrPayload, err := xml.MarshalIndent(appHdr, "            ", "    ")
-	if err != nil {
-		return "", "", fmt.Errorf("error marshalling AppHdr: %v", err)
-	}
-
-	bah := strings.Replace(string(appHdrPayload), "<BusinessApplicationHeaderV02>", "<AppHdr xmlns=\"urn:iso:std:iso:20022:tech:xsd:head.001.001.02\">", 1)
-	bah = strings.Replace(bah, "</BusinessApplicationHeaderV02>", "</AppHdr>", 1)
-
-	documentPayload, err := xml.MarshalIndent(document, "            ", "    ")
-	if err != nil {
-		return "", "", fmt.Errorf("error marshalling document: %v", err)
-	}
-
-	camt029Doc := strings.Replace(string(documentPayload), "<Document>", "<Document xmlns=\"urn:iso:std:iso:20022:tech:xsd:camt.029.001.09\">", 1)
-
-	return bah, camt029Doc, nil
-}
-
-func handlePacs004(cfg *config.Config, message FedNowMessage) (string, string, error) {
-	msg, ok := message.(pacs.FedNowMessageRtn)
-	if !ok {
-		return "", "", fmt.Errorf("invalid message type for pacs.004.001.10")
+	// Determine preferred wrapper from message context (if the message
+	// implements WrapperPreferrer) or the registration. This is needed when
+	// a single ISO message type maps to multiple FedNow wrapper elements.
+	preferredWrapper := registered.Wrapper
+	if wp, ok := message.(WrapperPreferrer); ok {
+		preferredWrapper = wp.PreferredWrapper()
 	}
-
-	appHdr, document, err := GeneratePacs004("pacs.004.001.10", cfg, msg)
+	wrapper, err := schema.Wrapper(messageType, preferredWrapper)
 	if err != nil {
-		return "", "", err
+		return err
 	}
 
-	appHdrPayload, err := xml.MarshalIndent(appHdr, "            ", "    ")
+	appHdr, document, err := registered.Build(registered.MessageType, config, message, opts...)
 	if err != nil {
-		return "", "", fmt.Errorf("error marshalling AppHdr: %v", err)
+		return err
 	}
 
-	bah := strings.Replace(string(appHdrPayload), "<BusinessApplicationHeaderV02>", "<AppHdr xmlns=\"urn:iso:std:iso:20022:tech:xsd:head.001.001.02\">", 1)
-	bah = strings.Replace(bah, "</BusinessApplicationHeaderV02>", "</AppHdr>", 1)
-
-	documentPayload, err := xml.MarshalIndent(document, "            ", "    ")
-	if err != nil {
-		return "", "", fmt.Errorf("error marshalling document: %v", err)
+	if o := newOptions(opts); o.xsdValidation {
+		// Check the message before anything reaches w.
+		var buf bytes.Buffer
+		if err := writeEnvelope(&buf, schema, wrapper, registered.Namespace, appHdr, document, format); err != nil {
+			return err
+		}
+		if err := o.validateXSD(registered.MessageType, buf.Bytes()); err != nil {
+			return err
+		}
+		_, err = buf.WriteTo(w)
+		return err
 	}
-
-	pacs004 := strings.Replace(string(documentPayload), "<Document>", "<Document xmlns=\"urn:iso:std:iso:20022:tech:xsd:pacs.004.001.10\">", 1)
-
-	return bah, pacs004, nil
+	return writeEnvelope(w, schema, wrapper, registered.Namespace, appHdr, document, format)
 }
 
-func handlePain013(cfg *config.Config, message FedNowMessage) (string, string, error) {
-	msg, ok := message.(pain.FedNowMessageRFP)
-	if !ok {
-		return "", "", fmt.Errorf("invalid message type for pain.013.001.07")
-	}
-
-	appHdr, document, err := GeneratePain013("pain.013.001.07", cfg, msg)
-	if err != nil {
-		return "", "", err
-	}
-
-	appHdrPayload, err := xml.MarshalIndent(appHdr, "            ", "    ")
-	if err != nil {
-		return "", "", fmt.Errorf("error marshalling AppHdr: %v", err)
+// cachedEnvelopeSchema returns the envelope schema of the XSD at xsdPath,
+// reading it on first use. messageType annotates a read error.
+func cachedEnvelopeSchema(xsdPath, messageType string) (*EnvelopeSchema, error) {
+	// First, try to read from the cache with a read lock.
+	cacheMux.RLock()
+	schema, found := envelopeSchemas[xsdPath]
+	cacheMux.RUnlock()
+	if found {
+		return schema, nil
 	}
 
-	bah := strings.Replace(string(appHdrPayload), "<BusinessApplicationHeaderV02>", "<AppHdr xmlns=\"urn:iso:std:iso:20022:tech:xsd:head.001.001.02\">", 1)
-	bah = strings.Replace(bah, "</BusinessApplicationHeaderV02>", "</AppHdr>", 1)
+	cacheMux.Lock()
+	defer cacheMux.Unlock()
+	schema, found = envelopeSchemas[xsdPath]
+	if !found {
+		xsdFile, err := os.Open(xsdPath)
+		if err != nil {
+			return nil, &XSDError{Path: xsdPath, MessageType: messageType, Err: err}
+		}
+		defer xsdFile.Close()
 
-	documentPayload, err := xml.MarshalIndent(document, "            ", "    ")
-	if err != nil {
-		return "", "", fmt.Errorf("error marshalling document: %v", err)
+		schema, err = readEnvelopeSchema(xsdFile, xsdPath, messageType)
+		if err != nil {
+			return nil, err
+		}
+		envelopeSchemas[xsdPath] = schema
 	}
-
-	pain013 := strings.Replace(string(documentPayload), "<Document>", "<Document xmlns=\"urn:iso:std:iso:20022:tech:xsd:pain.013.001.07\">", 1)
-
-	return bah, pain013, nil
+	return schema, nil
 }
 
-func GeneratePacs004(messageType string, msgConfig *config.Config, message pacs.FedNowMessageRtn) (*head.BusinessApplicationHeaderV02, *pacs004.Document, error) {
-
-	now := time.Now().In(common.EstLocation)
-	// Override creation date and time with current EST time
-	message.FedNowMsg.CreationDateTime = common.ISODateTime(now)
-
-	appHdr, err := bah.BuildBah(string(message.FedNowMsg.Identifier.MessageID), msgConfig, messageType)
-	if err != nil {
+// generateParts builds the AppHdr and Document of message. The creation time
+// is taken once and stored in *created before the identifiers are assigned
+// from it, so that the AppHdr CreDt, the MsgId and the creation time the
+// builder writes into the Document all agree. ownUETR assigns a UETR to
+// messages that start a payment.
+func generateParts[M, D any](messageType string, msgConfig *config.Config, message *M, created *common.ISODateTime, id *payment.Identifier, ownUETR bool, build func(M, *config.Config) (*D, error), opts []Option) (*head.BusinessApplicationHeaderV02, *D, error) {
+	o := newOptions(opts)
+	*created = o.creationDateTime(*created)
+	if err := o.assignIDs(messageType, msgConfig, id, *created, ownUETR); err != nil {
 		return nil, nil, err
 	}
 
-	document, err := pacs.BuildPacs004Struct(message, msgConfig)
+	appHdr, err := bah.BuildBahWith(id.BusinessMessageID, msgConfig, messageType, o.headerOptions(*id, *created))
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return appHdr, document, nil
-}
-
-func GeneratePacs002(messageType string, msgConfig *config.Config, message pacs.FedNowMessageACK) (*head.BusinessApplicationHeaderV02, *pacs002.Document, error) {
-
-	now := time.Now().In(common.EstLocation)
-	// Override creation date and time with current EST time
-	message.FedNowMsg.CreationDateTime = common.ISODateTime(now)
-
-	appHdr, err := bah.BuildBah(string(message.FedNowMsg.Identifier.MessageID), msgConfig, messageType)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	document, err := pacs.BuildPacs002Struct(message, msgConfig)
+	document, err := build(*message, msgConfig)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -332,154 +165,133 @@ func GeneratePacs002(messageType string, msgConfig *config.Config, message pacs.
 	return appHdr, document, nil
 }
 
-func GenerateCamt056(messageType string, msgConfig *config.Config, message camt.FedNowMessageCxlReq) (*head.BusinessApplicationHeaderV02, *camt056.Document, error) {
-
-	now := time.Now().In(common.EstLocation)
-	// Override creation date and time with current EST time
-	message.FedNowMsg.CreationDateTime = common.ISODateTime(now)
-
-	appHdr, err := bah.BuildBah(string(message.FedNowMsg.Identifier.MessageID), msgConfig, messageType)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	document, err := camt.BuildCamt056Struct(message, msgConfig)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return appHdr, document, nil
+// GeneratePacs004 builds a pacs.004 payment return. Its GrpHdr CreDtTm is
+// the AppHdr CreDt.
+func GeneratePacs004(messageType string, msgConfig *config.Config, message pacs.FedNowMessageRtn, opts ...Option) (*head.BusinessApplicationHeaderV02, *pacs004.Document, error) {
+	return generateParts(messageType, msgConfig, &message, &message.FedNowMsg.CreationDateTime, &message.FedNowMsg.Identifier, false, pacs.BuildPacs004Struct, opts)
 }
 
-func GenerateCamt029(messageType string, msgConfig *config.Config, message camt.FedNowMessageCxlRsp) (*head.BusinessApplicationHeaderV02, *camt029.Document, error) {
-
-	now := time.Now().In(common.EstLocation)
-	// Override creation date and time with current EST time
-	message.FedNowMsg.CreationDateTime = common.ISODateTime(now)
-
-	appHdr, err := bah.BuildBah(string(message.FedNowMsg.Identifier.MessageID), msgConfig, messageType)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	document, err := camt.BuildCamt029Struct(message, msgConfig)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return appHdr, document, nil
+// GeneratePacs002 builds a pacs.002 payment status report. Its GrpHdr
+// CreDtTm is the AppHdr CreDt.
+func GeneratePacs002(messageType string, msgConfig *config.Config, message pacs.FedNowMessageACK, opts ...Option) (*head.BusinessApplicationHeaderV02, *pacs002.Document, error) {
+	return generateParts(messageType, msgConfig, &message, &message.FedNowMsg.CreationDateTime, &message.FedNowMsg.Identifier, false, pacs.BuildPacs002Struct, opts)
 }
 
-func GenerateAdmi007(messageType string, msgConfig *config.Config, message admi.FedNowMessageRctAck) (*head.BusinessApplicationHeaderV02, *admi007.Document, error) {
-
-	now := time.Now().In(common.EstLocation)
-	// Override creation date and time with current EST time
-	message.FedNowMsg.CreationDateTime = common.ISODateTime(now)
-
-	appHdr, err := bah.BuildBah(string(message.FedNowMsg.Identifier.MessageID), msgConfig, messageType)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	document, err := admi.BuildAdmi007Struct(message, msgConfig)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return appHdr, document, nil
+// GenerateCamt056 builds a camt.056 return request. Its Assgnmt CreDtTm is
+// the AppHdr CreDt.
+func GenerateCamt056(messageType string, msgConfig *config.Config, message camt.FedNowMessageCxlReq, opts ...Option) (*head.BusinessApplicationHeaderV02, *camt056.Document, error) {
+	return generateParts(messageType, msgConfig, &message, &message.FedNowMsg.CreationDateTime, &message.FedNowMsg.Identifier, false, camt.BuildCamt056Struct, opts)
 }
 
-func handlePacs008(cfg *config.Config, message FedNowMessage) (string, string, error) {
-	msg, ok := message.(pacs.FedNowMessageCCT)
-	if !ok {
-		return "", "", fmt.Errorf("invalid message type for pacs.008.001.08")
-	}
-
-	appHdr, document, err := GeneratePacs008("pacs.008.001.08", cfg, msg)
-	if err != nil {
-		return "", "", err
-	}
-
-	appHdrPayload, err := xml.MarshalIndent(appHdr, "            ", "    ")
-	if err != nil {
-		return "", "", fmt.Errorf("error marshalling AppHdr: %v", err)
-	}
-
-	bah := strings.Replace(string(appHdrPayload), "<BusinessApplicationHeaderV02>", "<AppHdr xmlns=\"urn:iso:std:iso:20022:tech:xsd:head.001.001.02\">", 1)
-	bah = strings.Replace(bah, "</BusinessApplicationHeaderV02>", "</AppHdr>", 1)
+// GenerateCamt029 builds a camt.029 resolution of investigation. Its
+// Assgnmt CreDtTm is the AppHdr CreDt.
+func GenerateCamt029(messageType string, msgConfig *config.Config, message camt.FedNowMessageCxlRsp, opts ...Option) (*head.BusinessApplicationHeaderV02, *camt029.Document, error) {
+	return generateParts(messageType, msgConfig, &message, &message.FedNowMsg.CreationDateTime, &message.FedNowMsg.Identifier, false, camt.BuildCamt029Struct, opts)
+}
 
-	documentPayload, err := xml.MarshalIndent(document, "            ", "    ")
-	if err != nil {
-		return "", "", fmt.Errorf("error marshalling document: %v", err)
-	}
+// GenerateAdmi002 builds an admi.002 message reject. Its Document carries no
+// creation time, so only the AppHdr CreDt is set.
+func GenerateAdmi002(messageType string, msgConfig *config.Config, message admi.FedNowMessageADM, opts ...Option) (*head.BusinessApplicationHeaderV02, *admi002.Document, error) {
+	return generateParts(messageType, msgConfig, &message, &message.FedNowMsg.CreationDateTime, &message.FedNowMsg.Identifier, false, admi.BuildAdmi002Struct, opts)
+}
 
-	pacs008 := strings.Replace(string(documentPayload), "<Document>", "<Document xmlns=\"urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08\">", 1)
+// GenerateAdmi007 builds an admi.007 receipt acknowledgement. Its MsgId
+// CreDtTm is the AppHdr CreDt.
+func GenerateAdmi007(messageType string, msgConfig *config.Config, message admi.FedNowMessageRctAck, opts ...Option) (*head.BusinessApplicationHeaderV02, *admi007.Document, error) {
+	return generateParts(messageType, msgConfig, &message, &message.FedNowMsg.CreationDateTime, &message.FedNowMsg.Identifier, false, admi.BuildAdmi007Struct, opts)
+}
 
-	return bah, pacs008, nil
+// GeneratePacs008 builds a pacs.008 customer credit transfer with its own
+// UETR. Its GrpHdr CreDtTm is the AppHdr CreDt.
+func GeneratePacs008(messageType string, msgConfig *config.Config, message pacs.FedNowMessageCCT, opts ...Option) (*head.BusinessApplicationHeaderV02, *pacs008.Document, error) {
+	return generateParts(messageType, msgConfig, &message, &message.FedNowMsg.CreationDateTime, &message.FedNowMsg.Identifier, true, pacs.BuildPacs008Struct, opts)
 }
 
-func GeneratePacs008(messageType string, msgConfig *config.Config, message pacs.FedNowMessageCCT) (*head.BusinessApplicationHeaderV02, *pacs008.Document, error) {
+// GeneratePain013 builds a pain.013 request for payment with its own UETR.
+// Its GrpHdr CreDtTm is the AppHdr CreDt.
+func GeneratePain013(messageType string, msgConfig *config.Config, message pain.FedNowMessageRFP, opts ...Option) (*head.BusinessApplicationHeaderV02, *pain013.Document, error) {
+	return generateParts(messageType, msgConfig, &message, &message.FedNowMsg.CreationDateTime, &message.FedNowMsg.Identifier, true, pain.BuildPain013Struct, opts)
+}
 
-	now := time.Now().In(common.EstLocation)
-	// Override creation date and time with current EST time
-	message.FedNowMsg.CreationDateTime = common.ISODateTime(now)
+// EnvelopeSchema describes the FedNow envelope: the root and message
+// elements, and the wrapper elements that carry each message type, as read
+// from the FedNow outgoing envelope XSD.
+type EnvelopeSchema struct {
+	// Namespace is the target namespace of the XSD, declared on the root.
+	Namespace string
+	Root      string
+	Message   string
+	wrappers  []envelopeWrapper
+}
 
-	appHdr, err := bah.BuildBah(string(message.FedNowMsg.Identifier.MessageID), msgConfig, messageType)
-	if err != nil {
-		return nil, nil, err
-	}
+// envelopeWrapper is a wrapper element and the namespaces of the elements
+// it references, e.g. those of head.001.001.02 and pacs.008.001.08.
+type envelopeWrapper struct {
+	name       string
+	namespaces []string
+}
 
-	document, err := pacs.BuildPacs008Struct(message, msgConfig)
+// LoadEnvelopeSchema reads the envelope XSD at path, e.g. that of a new
+// FedNow release, for use with its Generate methods or an Encoder.
+func LoadEnvelopeSchema(path string) (*EnvelopeSchema, error) {
+	xsdFile, err := os.Open(path)
 	if err != nil {
-		return nil, nil, err
+		return nil, &XSDError{Path: path, Err: err}
 	}
-
-	return appHdr, document, nil
+	defer xsdFile.Close()
+	return readEnvelopeSchema(xsdFile, path, "")
 }
 
-func GeneratePain013(messageType string, msgConfig *config.Config, message pain.FedNowMessageRFP) (*head.BusinessApplicationHeaderV02, *pain013.Document, error) {
-
-	now := time.Now().In(common.EstLocation)
-	// Override creation date and time with current EST time
-	message.FedNowMsg.CreationDateTime = common.ISODateTime(now)
+// ReadEnvelopeSchema reads an envelope XSD from r.
+func ReadEnvelopeSchema(r io.Reader) (*EnvelopeSchema, error) {
+	return readEnvelopeSchema(r, "", "")
+}
 
-	appHdr, err := bah.BuildBah(string(message.FedNowMsg.Identifier.MessageID), msgConfig, messageType)
-	if err != nil {
-		return nil, nil, err
+// Wrapper returns the wrapper element of messageType. When several wrappers
+// reference the message namespace (e.g. camt.029 used by both return-request
+// and information-request flows), preferredWrapper is used to disambiguate;
+// otherwise the first one in the XSD is returned.
+func (s *EnvelopeSchema) Wrapper(messageType, preferredWrapper string) (string, error) {
+	var candidates []string
+	for _, wrapper := range s.wrappers {
+		for _, ns := range wrapper.namespaces {
+			if strings.Contains(ns, messageType) {
+				candidates = append(candidates, wrapper.name)
+				break
+			}
+		}
 	}
-
-	document, err := pain.BuildPain013Struct(message, msgConfig)
-	if err != nil {
-		return nil, nil, err
+	if len(candidates) == 0 {
+		return "", &EnvelopeError{MessageType: messageType, Element: "wrapper element"}
 	}
-
-	return appHdr, document, nil
-}
-
-// findWrapperForMessageID dynamically parses the XSD to find the correct wrapper element.
-// When preferredWrapper is non-empty and multiple wrappers reference the same message
-// namespace, the preferred one is selected. Otherwise the first match is used.
-func findWrapperForMessageID(xsdPath string, messageId string, preferredWrapper string) (string, string, string, string, error) {
-	xsdFile, err := os.Open(xsdPath)
-	if err != nil {
-		return "", "", "", "", fmt.Errorf("error opening XSD file: %v", err)
+	for _, c := range candidates {
+		if c == preferredWrapper {
+			return c, nil
+		}
 	}
-	defer xsdFile.Close()
+	return candidates[0], nil
+}
 
-	decoder := xml.NewDecoder(xsdFile)
-	var rootElement, messageElement, wrapperElementName, rootNs string
+// readEnvelopeSchema parses the XSD to find the envelope elements. path and
+// messageType annotate errors.
+func readEnvelopeSchema(r io.Reader, path, messageType string) (*EnvelopeSchema, error) {
+	decoder := xml.NewDecoder(r)
+	schema := &EnvelopeSchema{}
 	nsMap := make(map[string]string)
 	var elementStack []xml.StartElement
-	var choiceRefs []string
+	// Top-level elements in XSD order, the namespaces each references, and
+	// the elements referenced from a choice, which are the wrappers.
+	var topLevel []string
+	refs := make(map[string][]string)
+	choiceRefs := make(map[string]bool)
 
-	// First pass: find the root, message, and choice refs
 	for {
 		token, err := decoder.Token()
 		if err == io.EOF {
 			break
 		}
 		if err != nil {
-			return "", "", "", "", fmt.Errorf("error decoding XSD token (pass 1): %v", err)
+			return nil, &XSDError{Path: path, MessageType: messageType, Err: fmt.Errorf("decoding token: %w", err)}
 		}
 
 		switch se := token.(type) {
@@ -490,103 +302,61 @@ func findWrapperForMessageID(xsdPath string, messageId string, preferredWrapper
 					if attr.Name.Space == "xmlns" {
 						nsMap[attr.Name.Local] = attr.Value
 					} else if attr.Name.Local == "targetNamespace" {
-						rootNs = attr.Value
-					}
-				}
-			} else if se.Name.Local == "element" {
-				parent, grandParent := getParents(elementStack)
-				if parent.Name.Local == "schema" {
-					if rootElement == "" {
-						rootElement = getAttrName(se, "name")
-					}
-				} else if parent.Name.Local == "sequence" && grandParent.Name.Local == "complexType" {
-					ggParent := getGrandparent(elementStack)
-					if ggParent.Name.Local == "element" && getAttrName(ggParent, "name") == rootElement {
-						minOccurs := getAttrName(se, "minOccurs")
-						if minOccurs != "0" {
-							ref := getAttrName(se, "ref")
-							if messageElement == "" && ref != "" {
-								messageElement = ref
-							}
-						}
-					}
-				} else if parent.Name.Local == "choice" {
-					ref := getAttrName(se, "ref")
-					if ref != "" {
-						choiceRefs = append(choiceRefs, ref)
+						schema.Namespace = attr.Value
 					}
 				}
+				continue
 			}
-		case xml.EndElement:
-			if len(elementStack) > 0 {
-				elementStack = elementStack[:len(elementStack)-1]
+			if se.Name.Local != "element" {
+				continue
 			}
-		}
-	}
-
-	// Second pass: collect all wrapper elements that reference the correct document namespace.
-	// When multiple wrappers match (e.g. camt.029 used by both return-request and
-	// information-request flows), preferredWrapper is used to disambiguate.
-	xsdFile.Seek(0, 0)
-	decoder = xml.NewDecoder(xsdFile)
-	var currentWrapper string
-	var inWrapper bool
-	var candidates []string
 
-	for {
-		token, err := decoder.Token()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return "", "", "", "", fmt.Errorf("error decoding XSD token (pass 2): %v", err)
-		}
-
-		switch se := token.(type) {
-		case xml.StartElement:
-			if se.Name.Local == "element" && isChoiceRef(getAttrName(se, "name"), choiceRefs) {
-				currentWrapper = getAttrName(se, "name")
-				inWrapper = true
-			} else if inWrapper && se.Name.Local == "element" {
-				ref := getAttrName(se, "ref")
-				if strings.Contains(ref, ":") {
-					parts := strings.Split(ref, ":")
-					refPrefix := parts[0]
-					if ns, ok := nsMap[refPrefix]; ok {
-						if strings.Contains(ns, messageId) {
-							candidates = append(candidates, currentWrapper)
-						}
+			parent, grandParent := getParents(elementStack)
+			if parent.Name.Local == "schema" {
+				name := getAttrName(se, "name")
+				topLevel = append(topLevel, name)
+				if schema.Root == "" {
+					schema.Root = name
+				}
+				continue
+			}
+			if len(topLevel) == 0 {
+				continue
+			}
+			current := topLevel[len(topLevel)-1]
+			ref := getAttrName(se, "ref")
+
+			if parent.Name.Local == "sequence" && grandParent.Name.Local == "complexType" {
+				ggParent := getGrandparent(elementStack)
+				if ggParent.Name.Local == "element" && getAttrName(ggParent, "name") == schema.Root {
+					if schema.Message == "" && ref != "" && getAttrName(se, "minOccurs") != "0" {
+						schema.Message = ref
 					}
 				}
+			} else if parent.Name.Local == "choice" && ref != "" {
+				choiceRefs[ref] = true
+			}
+			if prefix, _, ok := strings.Cut(ref, ":"); ok {
+				if ns, ok := nsMap[prefix]; ok {
+					refs[current] = append(refs[current], ns)
+				}
 			}
 		case xml.EndElement:
-			if inWrapper && se.Name.Local == currentWrapper {
-				inWrapper = false
-				currentWrapper = ""
+			if len(elementStack) > 0 {
+				elementStack = elementStack[:len(elementStack)-1]
 			}
 		}
 	}
 
-	// Pick the preferred wrapper if it appears among candidates; otherwise use first match.
-	if len(candidates) > 0 {
-		if preferredWrapper != "" {
-			for _, c := range candidates {
-				if c == preferredWrapper {
-					wrapperElementName = c
-					break
-				}
-			}
-		}
-		if wrapperElementName == "" {
-			wrapperElementName = candidates[0]
-		}
+	if schema.Root == "" || schema.Message == "" {
+		return nil, &XSDError{Path: path, MessageType: messageType, Err: fmt.Errorf("could not determine the envelope elements (root: '%s', message: '%s')", schema.Root, schema.Message)}
 	}
-
-	if rootElement == "" || messageElement == "" || wrapperElementName == "" {
-		return "", "", "", "", fmt.Errorf("could not determine all required elements (root: '%s', message: '%s', wrapper: '%s')", rootElement, messageElement, wrapperElementName)
+	for _, name := range topLevel {
+		if choiceRefs[name] {
+			schema.wrappers = append(schema.wrappers, envelopeWrapper{name: name, namespaces: refs[name]})
+		}
 	}
-
-	return rootElement, messageElement, wrapperElementName, rootNs, nil
+	return schema, nil
 }
 
 func getParents(stack []xml.StartElement) (xml.StartElement, xml.StartElement) {
@@ -614,12 +384,3 @@ func getAttrName(se xml.StartElement, name string) string {
 	}
 	return ""
 }
-
-func isChoiceRef(name string, refs []string) bool {
-	for _, ref := range refs {
-		if ref == name {
-			return true
-		}
-	}
-	return false
-}
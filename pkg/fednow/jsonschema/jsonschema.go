@@ -0,0 +1,285 @@
+// Package jsonschema derives JSON Schema (draft 2020-12) documents from the
+// custom JSON payload types of the FedNow messages, such as
+// pacs.FedNowMessageCCT. Field names and required fields follow the json
+// tags: a field is required unless it is a pointer or tagged omitempty. A
+// pointer the builders cannot do without is tagged jsonschema:"required",
+// which makes it required and not nullable. String types of the generated ISO 20022 models carry the enumeration,
+// pattern and length facets of their XSD simple type, and the external code
+// types the codes FedNow allows in the message.
+//
+// The schemas committed under schemas/ are produced by cmd/jsonschema:
+//
+//	go generate ./pkg/fednow/jsonschema
+package jsonschema
+
+//go:generate go run ../../../cmd/jsonschema -xsd ../../../Internal/XSD/iso -out ../../../schemas
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow/codes"
+	"github.com/mbanq/iso20022-go/pkg/xsd"
+)
+
+// Draft is the JSON Schema dialect of the generated documents.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+const (
+	isoModelPath = "github.com/mbanq/iso20022-go/ISO20022/"
+	isoNamespace = "urn:iso:std:iso:20022:tech:xsd:"
+)
+
+// Schema is a JSON Schema document or subschema.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 any                `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties any                `json:"additionalProperties,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+}
+
+// FileName returns the name of the committed schema of messageType.
+func FileName(messageType string) string {
+	return messageType + ".schema.json"
+}
+
+// Generator derives schemas, taking the facets of ISO string types from a
+// set of XSDs. A nil set omits those facets.
+type Generator struct {
+	xsds *xsd.Set
+}
+
+// NewGenerator returns a generator using the ISO schemas in xsds, e.g. from
+// xsd.LoadDir("Internal/XSD/iso").
+func NewGenerator(xsds *xsd.Set) *Generator {
+	return &Generator{xsds: xsds}
+}
+
+// Generate returns the schema of payload, the Go value carried by
+// messageType.
+func (g *Generator) Generate(messageType string, payload any) *Schema {
+	r := &reflector{generator: g, messageType: messageType, defs: make(map[string]*Schema), names: make(map[reflect.Type]string)}
+	root := r.schemaOf(reflect.TypeOf(payload))
+	// The payload itself is the document rather than a definition.
+	if name, ok := r.names[reflect.TypeOf(payload)]; ok {
+		root = r.defs[name]
+		delete(r.defs, name)
+	}
+	root.Schema = Draft
+	root.Title = messageType + " payload"
+	if len(r.defs) > 0 {
+		root.Defs = r.defs
+	}
+	return root
+}
+
+// Marshal encodes s as indented JSON ending in a newline, the format of the
+// committed schemas.
+func Marshal(s *Schema) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(s); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type reflector struct {
+	generator   *Generator
+	messageType string
+	defs        map[string]*Schema
+	names       map[reflect.Type]string
+}
+
+var (
+	xmlNameType     = reflect.TypeOf(xml.Name{})
+	isoDateTimeType = reflect.TypeOf(common.ISODateTime{})
+	isoDateType     = reflect.TypeOf(common.ISODate{})
+	isoTimeType     = reflect.TypeOf(common.ISOTime{})
+	amountType      = reflect.TypeOf(common.Amount{})
+	timeType        = reflect.TypeOf(time.Time{})
+)
+
+func (r *reflector) schemaOf(t reflect.Type) *Schema {
+	switch t {
+	case isoDateTimeType, timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case isoDateType:
+		return &Schema{Type: "string", Format: "date"}
+	case isoTimeType:
+		return &Schema{Type: "string", Format: "time"}
+	case amountType:
+		// Amounts are accepted as a JSON number or a quoted decimal.
+		return &Schema{Type: []string{"number", "string"}, Pattern: `^[0-9]+(\.[0-9]+)?$`}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return r.schemaOf(t.Elem())
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: r.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.schemaOf(t.Elem())}
+	case reflect.Struct:
+		return r.structRef(t)
+	case reflect.String:
+		return r.stringSchema(t)
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	}
+	return &Schema{}
+}
+
+// structRef returns a reference to the definition of a named struct, adding
+// it on first use, or the schema of an anonymous struct.
+func (r *reflector) structRef(t reflect.Type) *Schema {
+	if t.Name() == "" {
+		return r.structSchema(t)
+	}
+	name, ok := r.names[t]
+	if !ok {
+		name = t.Name()
+		if _, taken := r.defs[name]; taken {
+			name = pkgName(t) + "." + name
+		}
+		r.names[t] = name
+		// Reserve the name before recursing so that cycles terminate.
+		r.defs[name] = &Schema{}
+		*r.defs[name] = *r.structSchema(t)
+	}
+	return &Schema{Ref: "#/$defs/" + name}
+}
+
+func (r *reflector) structSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	iso := strings.HasPrefix(t.PkgPath(), isoModelPath)
+	if !iso {
+		// The ISO models carry XMLName, and wildcard types InnerXml, which a
+		// caller may round trip; the custom payloads are closed.
+		s.AdditionalProperties = false
+	}
+	r.addFields(s, t)
+	return s
+}
+
+func (r *reflector) addFields(s *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || field.Type == xmlNameType || field.Tag.Get("xml") == ",innerxml" {
+			continue
+		}
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			r.addFields(s, field.Type)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		required := field.Tag.Get("jsonschema") == "required"
+		property := r.schemaOf(field.Type)
+		switch field.Type.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Map:
+			// encoding/json writes a nil value as null.
+			if !required {
+				property = &Schema{AnyOf: []*Schema{property, {Type: "null"}}}
+			}
+		}
+		s.Properties[name] = property
+		if required || !strings.Contains(opts, "omitempty") && field.Type.Kind() != reflect.Pointer {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// stringSchema returns the schema of a string type, with the facets of its
+// XSD simple type when it comes from a generated ISO model.
+func (r *reflector) stringSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: "string"}
+	if !strings.HasPrefix(t.PkgPath(), isoModelPath) || r.generator.xsds == nil {
+		return s
+	}
+
+	namespace := isoNamespace + strings.ReplaceAll(pkgName(t), "_", ".")
+	schema, ok := r.generator.xsds.Schema(namespace)
+	if !ok {
+		return s
+	}
+	facets, ok := schema.SimpleType(t.Name())
+	if !ok {
+		return s
+	}
+	switch facets.Base {
+	case "xs:date":
+		s.Format = "date"
+	case "xs:dateTime":
+		s.Format = "date-time"
+	}
+	s.Enum = facets.Enumeration
+	if len(facets.Patterns) == 1 {
+		s.Pattern = "^(?:" + facets.Patterns[0] + ")$"
+	}
+	if facets.Length >= 0 {
+		s.MinLength, s.MaxLength = intPtr(facets.Length), intPtr(facets.Length)
+	}
+	if facets.MinLength >= 0 {
+		s.MinLength = intPtr(facets.MinLength)
+	}
+	if facets.MaxLength >= 0 {
+		s.MaxLength = intPtr(facets.MaxLength)
+	}
+
+	// External code types only carry a length in the XSD; list the codes
+	// FedNow allows in this message instead.
+	if set := codes.Set(t.Name()); s.Enum == nil && isCodeSet(set) {
+		for _, code := range codes.Allowed(r.messageType, set) {
+			s.Enum = append(s.Enum, code.Code)
+		}
+	}
+	return s
+}
+
+func isCodeSet(set codes.Set) bool {
+	for _, s := range codes.Sets() {
+		if s == set {
+			return true
+		}
+	}
+	return false
+}
+
+// pkgName returns the last element of the package path of t.
+func pkgName(t reflect.Type) string {
+	path := t.PkgPath()
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+func intPtr(n int) *int {
+	return &n
+}
@@ -0,0 +1,307 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/schemas"
+)
+
+var (
+	loaded   = make(map[string]*Schema)
+	loadedMu sync.Mutex
+)
+
+// Load returns the committed schema of messageType, embedded from schemas/.
+func Load(messageType string) (*Schema, error) {
+	loadedMu.Lock()
+	defer loadedMu.Unlock()
+	if s, ok := loaded[messageType]; ok {
+		return s, nil
+	}
+	data, err := schemas.FS.ReadFile(FileName(messageType))
+	if err != nil {
+		return nil, fmt.Errorf("no JSON schema for %s", messageType)
+	}
+	var s Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("schema for %s: %w", messageType, err)
+	}
+	loaded[messageType] = &s
+	return &s, nil
+}
+
+// UnmarshalJSON decodes additionalProperties as a bool or a *Schema.
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	type plain Schema
+	raw := struct {
+		*plain
+		AdditionalProperties json.RawMessage `json:"additionalProperties,omitempty"`
+	}{plain: (*plain)(s)}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	switch {
+	case raw.AdditionalProperties == nil:
+		s.AdditionalProperties = nil
+	case bytes.Equal(raw.AdditionalProperties, []byte("true")), bytes.Equal(raw.AdditionalProperties, []byte("false")):
+		s.AdditionalProperties = bytes.Equal(raw.AdditionalProperties, []byte("true"))
+	default:
+		var additional Schema
+		if err := json.Unmarshal(raw.AdditionalProperties, &additional); err != nil {
+			return err
+		}
+		s.AdditionalProperties = &additional
+	}
+	return nil
+}
+
+// Validate checks data, a JSON document, against s. It supports the keywords
+// Generate emits. Every problem is reported as a *common.FieldError whose path
+// is the JSON path of the offending value (e.g.
+// "fedNowMessage.amount.currency"); the errors are joined with errors.Join.
+// Malformed JSON is returned as the encoding/json error.
+func (s *Schema) Validate(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return errors.New("invalid data after the top-level JSON value")
+	}
+
+	v := &validator{root: s}
+	v.check(s, value, "")
+	return errors.Join(v.errs...)
+}
+
+type validator struct {
+	root *Schema
+	errs []error
+}
+
+func (v *validator) report(path, value, reason string) {
+	v.errs = append(v.errs, &common.FieldError{Path: path, Value: value, Reason: reason})
+}
+
+func (v *validator) check(s *Schema, value any, path string) {
+	if s.Ref != "" {
+		name, ok := strings.CutPrefix(s.Ref, "#/$defs/")
+		def := v.root.Defs[name]
+		if !ok || def == nil {
+			v.report(path, "", fmt.Sprintf("unresolved schema reference %s", s.Ref))
+			return
+		}
+		s = def
+	}
+
+	if len(s.AnyOf) > 0 {
+		var first []error
+		for i, branch := range s.AnyOf {
+			sub := &validator{root: v.root}
+			sub.check(branch, value, path)
+			if len(sub.errs) == 0 {
+				return
+			}
+			if i == 0 {
+				first = sub.errs
+			}
+		}
+		v.errs = append(v.errs, first...)
+		return
+	}
+
+	if types := s.types(); len(types) > 0 && !matchesType(types, value) {
+		v.report(path, "", fmt.Sprintf("must be of type %s", strings.Join(types, " or ")))
+		return
+	}
+
+	switch x := value.(type) {
+	case map[string]any:
+		v.checkObject(s, x, path)
+	case []any:
+		if s.Items != nil {
+			for i, item := range x {
+				v.check(s.Items, item, fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+	case string:
+		v.checkString(s, x, path)
+	case json.Number:
+		if s.Pattern != "" {
+			v.checkString(&Schema{Pattern: s.Pattern}, x.String(), path)
+		}
+	}
+}
+
+// checkObject matches the fields of object to the properties of s the way
+// encoding/json does: exactly, or else ignoring case.
+func (v *validator) checkObject(s *Schema, object map[string]any, path string) {
+	for _, name := range s.Required {
+		if _, ok := lookup(object, name); !ok {
+			v.report(join(path, name), "", "is required")
+		}
+	}
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if property, ok := lookup(s.Properties, name); ok {
+			v.check(property, object[name], join(path, name))
+			continue
+		}
+		switch additional := s.AdditionalProperties.(type) {
+		case bool:
+			if !additional {
+				v.report(join(path, name), "", "is not an allowed field")
+			}
+		case *Schema:
+			v.check(additional, object[name], join(path, name))
+		}
+	}
+}
+
+func (v *validator) checkString(s *Schema, value, path string) {
+	var errs []error
+	if s.Enum != nil {
+		errs = append(errs, common.CheckEnumeration(value, s.Enum...))
+	}
+	if s.Pattern != "" {
+		if re, err := compile(s.Pattern); err == nil {
+			errs = append(errs, common.CheckPattern(value, re))
+		}
+	}
+	if s.MinLength != nil || s.MaxLength != nil {
+		errs = append(errs, common.CheckLength(value, intOr(s.MinLength, -1), intOr(s.MaxLength, -1)))
+	}
+	if layout, ok := formatLayouts[s.Format]; ok {
+		if _, err := time.Parse(layout, value); err != nil {
+			errs = append(errs, &common.FieldError{Value: value, Reason: fmt.Sprintf("is not a valid %s", s.Format)})
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			v.errs = append(v.errs, common.PrefixError(path, err))
+		}
+	}
+}
+
+// formatLayouts are the time layouts of the formats Generate emits, matching
+// the JSON encoding of common.ISODateTime, ISODate and ISOTime.
+var formatLayouts = map[string]string{
+	"date-time": time.RFC3339,
+	"date":      "2006-01-02",
+	"time":      "15:04:05",
+}
+
+func (s *Schema) types() []string {
+	switch t := s.Type.(type) {
+	case string:
+		return []string{t}
+	case []string:
+		return t
+	case []any:
+		types := make([]string, 0, len(t))
+		for _, x := range t {
+			if name, ok := x.(string); ok {
+				types = append(types, name)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+func matchesType(types []string, value any) bool {
+	for _, t := range types {
+		switch x := value.(type) {
+		case nil:
+			if t == "null" {
+				return true
+			}
+		case bool:
+			if t == "boolean" {
+				return true
+			}
+		case string:
+			if t == "string" {
+				return true
+			}
+		case json.Number:
+			if t == "number" || t == "integer" && !strings.ContainsAny(x.String(), ".eE") {
+				return true
+			}
+		case []any:
+			if t == "array" {
+				return true
+			}
+		case map[string]any:
+			if t == "object" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var (
+	patterns   = make(map[string]*regexp.Regexp)
+	patternsMu sync.Mutex
+)
+
+func compile(pattern string) (*regexp.Regexp, error) {
+	patternsMu.Lock()
+	defer patternsMu.Unlock()
+	if re, ok := patterns[pattern]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	patterns[pattern] = re
+	return re, nil
+}
+
+// lookup returns the value of key in m, or of a key equal to it under case
+// folding.
+func lookup[V any](m map[string]V, key string) (V, bool) {
+	if value, ok := m[key]; ok {
+		return value, true
+	}
+	for name, value := range m {
+		if strings.EqualFold(name, key) {
+			return value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func intOr(p *int, fallback int) int {
+	if p == nil {
+		return fallback
+	}
+	return *p
+}
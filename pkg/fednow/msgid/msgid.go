@@ -0,0 +1,155 @@
+// Package msgid generates and validates FedNow message identifiers: the
+// MsgId of the group header, the BizMsgIdr of the AppHdr and the UETR of a
+// payment.
+//
+// A FedNow MsgId is laid out as
+//
+//	YYYYMMDD  business date, US Eastern time
+//	RTN       nine-digit routing number of the sending participant
+//	source    participant-assigned segment, e.g. an application or channel
+//	sequence  participant-assigned sequence, unique for the date and source
+//
+// for at most 35 characters. FedNow expects the BizMsgIdr of the AppHdr to
+// repeat the MsgId.
+package msgid
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	iso "github.com/mbanq/iso20022-go/ISO20022/pacs_008_001_08"
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+const (
+	// MaxLength is the maximum length of a MsgId or BizMsgIdr.
+	MaxLength = 35
+	// SourceLength is the length of the source segment of generated IDs.
+	SourceLength = 4
+	// SequenceWidth is the number of digits of the sequence of generated
+	// IDs, left-padded with zeros.
+	SequenceWidth = MaxLength - len(dateLayout) - 9 - SourceLength
+	// DefaultSource is the source segment used when none is configured.
+	DefaultSource = "0001"
+
+	dateLayout = "20060102"
+)
+
+var (
+	patternSource    = regexp.MustCompile(`^[A-Za-z0-9]{4}$`)
+	patternReference = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+)
+
+// Generator produces MsgIds for one participant and source.
+type Generator struct {
+	// RTN is the routing number of the sending participant.
+	RTN string
+	// Source is the four-character alphanumeric source segment.
+	Source string
+	// Sequence numbers the IDs of each business date.
+	Sequence Sequence
+	// Now returns the time of IDs created by MessageID. The default is
+	// time.Now.
+	Now func() time.Time
+}
+
+// NewGenerator returns a Generator after validating rtn and source.
+func NewGenerator(rtn, source string, sequence Sequence) (*Generator, error) {
+	g := &Generator{RTN: rtn, Source: source, Sequence: sequence}
+	if err := g.validate(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (g *Generator) validate() error {
+	if err := common.ValidateRoutingNumber("rtn", g.RTN); err != nil {
+		return err
+	}
+	if !patternSource.MatchString(g.Source) {
+		return &common.FieldError{Path: "source", Value: g.Source, Reason: fmt.Sprintf("must be %d letters or digits", SourceLength)}
+	}
+	if g.Sequence == nil {
+		return &common.FieldError{Path: "sequence", Reason: "is required"}
+	}
+	return nil
+}
+
+// MessageID returns a new MsgId dated now.
+func (g *Generator) MessageID() (string, error) {
+	now := time.Now
+	if g.Now != nil {
+		now = g.Now
+	}
+	return g.MessageIDAt(now())
+}
+
+// MessageIDAt returns a new MsgId for the business date of t in US Eastern
+// time, using the next value of the sequence for that date.
+func (g *Generator) MessageIDAt(t time.Time) (string, error) {
+	if err := g.validate(); err != nil {
+		return "", err
+	}
+	t = t.In(common.EstLocation)
+	n, err := g.Sequence.Next(t)
+	if err != nil {
+		return "", fmt.Errorf("message ID sequence: %w", err)
+	}
+	sequence := strconv.FormatUint(n, 10)
+	if len(sequence) > SequenceWidth {
+		return "", fmt.Errorf("message ID sequence %s exceeds %d digits", sequence, SequenceWidth)
+	}
+	return t.Format(dateLayout) + g.RTN + g.Source + strings.Repeat("0", SequenceWidth-len(sequence)) + sequence, nil
+}
+
+// ValidateMessageID checks that id follows the FedNow MsgId layout: a valid
+// date, a valid routing number and an alphanumeric source and sequence, in at
+// most 35 characters. The source and sequence of other participants may have
+// any length, so they are not split. path names the field in the error.
+func ValidateMessageID(path, id string) error {
+	if id == "" {
+		return &common.FieldError{Path: path, Reason: "is required"}
+	}
+	if len(id) > MaxLength {
+		return &common.FieldError{Path: path, Value: id, Reason: fmt.Sprintf("is longer than %d characters", MaxLength)}
+	}
+	if len(id) <= len(dateLayout)+9 {
+		return &common.FieldError{Path: path, Value: id, Reason: "must be a date, a routing number, a source and a sequence"}
+	}
+	if _, err := time.Parse(dateLayout, id[:len(dateLayout)]); err != nil {
+		return &common.FieldError{Path: path, Value: id, Reason: "does not start with a YYYYMMDD date"}
+	}
+	if err := common.RoutingNumber(id[len(dateLayout) : len(dateLayout)+9]).Validate(); err != nil {
+		return &common.FieldError{Path: path, Value: id, Reason: err.Error()}
+	}
+	if !patternReference.MatchString(id[len(dateLayout)+9:]) {
+		return &common.FieldError{Path: path, Value: id, Reason: "source and sequence must be letters or digits"}
+	}
+	return nil
+}
+
+// NewUETR returns a random RFC 4122 version 4 UUID, in the lower-case form
+// required for a UETR.
+func NewUETR() (string, error) {
+	var b [16]byte
+	if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+		return "", fmt.Errorf("generate UETR: %w", err)
+	}
+	b[6] = b[6]&0x0f | 0x40 // version 4
+	b[8] = b[8]&0x3f | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
+
+// ValidateUETR checks that uetr is a lower-case UUID version 4. path names
+// the field in the error.
+func ValidateUETR(path, uetr string) error {
+	if uetr == "" {
+		return &common.FieldError{Path: path, Reason: "is required"}
+	}
+	return common.PrefixError(path, iso.UUIDv4Identifier(uetr).Validate())
+}
@@ -0,0 +1,66 @@
+package msgid
+
+import (
+	"sync"
+	"time"
+)
+
+// Sequence numbers the message IDs of a business date. Implementations backed
+// by a database or another shared store let several processes send under the
+// same RTN and source without reusing an ID.
+type Sequence interface {
+	// Next returns the next number for the business date of t, in US Eastern
+	// time. Numbers must be unique per date and fit in SequenceWidth digits.
+	Next(t time.Time) (uint64, error)
+}
+
+// SequenceFunc adapts a function to a Sequence.
+type SequenceFunc func(t time.Time) (uint64, error)
+
+// Next calls f(t).
+func (f SequenceFunc) Next(t time.Time) (uint64, error) { return f(t) }
+
+// Counter is an in-memory Sequence counting from 1 every business date. It
+// restarts on every process start, so it only suits tests and single-use
+// tools.
+type Counter struct {
+	mu   sync.Mutex
+	date string
+	n    uint64
+}
+
+// Next returns the next number of the date of t.
+func (c *Counter) Next(t time.Time) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if date := t.Format(dateLayout); date != c.date {
+		c.date, c.n = date, 0
+	}
+	c.n++
+	return c.n, nil
+}
+
+// ClockSequence is an in-memory Sequence that never runs behind the clock:
+// each number is at least the milliseconds since midnight of t times one
+// million. A restarted process therefore continues above the numbers of the
+// previous one unless that one issued over a million IDs per millisecond of
+// its run. Processes running side by side under the same source still need a
+// shared Sequence.
+type ClockSequence struct {
+	mu   sync.Mutex
+	date string
+	last uint64
+}
+
+// Next returns the next number of the date of t.
+func (c *ClockSequence) Next(t time.Time) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if date := t.Format(dateLayout); date != c.date {
+		c.date, c.last = date, 0
+	}
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	floor := uint64(t.Sub(midnight).Milliseconds()) * 1_000_000
+	c.last = max(c.last+1, floor)
+	return c.last, nil
+}
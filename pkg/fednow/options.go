@@ -0,0 +1,156 @@
+package fednow
+
+import (
+	"time"
+
+	head "github.com/mbanq/iso20022-go/ISO20022/head_001_001_02"
+	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow/bah"
+	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+	"github.com/mbanq/iso20022-go/pkg/fednow/msgid"
+	"github.com/mbanq/iso20022-go/pkg/payment"
+	"github.com/mbanq/iso20022-go/pkg/xsd"
+)
+
+// Option configures how Generate and the GenerateXxx functions build a
+// message, and how Parse handles an inbound one.
+type Option func(*options)
+
+type options struct {
+	now            func() time.Time
+	keepTimestamps bool
+	ids            *msgid.Generator
+	header         []func(*bah.Options)
+	duplicates     DuplicateStore
+	rawDocument    *[]byte
+	xsdValidation  bool
+	xsds           *xsd.Set
+}
+
+// WithClock sets the clock that stamps the creation date and time of
+// generated messages, e.g. a fixed time for golden-file tests. The time is
+// converted to US Eastern time. The default is time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(o *options) { o.now = now }
+}
+
+// WithCallerTimestamps keeps the creationDateTime of the payload when it is
+// set, instead of replacing it with the current time. A zero creationDateTime
+// is still stamped from the clock.
+func WithCallerTimestamps() Option {
+	return func(o *options) { o.keepTimestamps = true }
+}
+
+// WithIDGenerator sets the generator of the MsgId of payloads without a
+// messageId. The default generator numbers IDs with a msgid.ClockSequence
+// under the ispId and messageIdSource of the config, which is only unique
+// while a single process sends under that source.
+func WithIDGenerator(g *msgid.Generator) Option {
+	return func(o *options) { o.ids = g }
+}
+
+// WithHeader adjusts the AppHdr options of generated messages, after those
+// taken from the payload identifier, e.g. to set the sender or the business
+// service.
+func WithHeader(adjust func(*bah.Options)) Option {
+	return func(o *options) { o.header = append(o.header, adjust) }
+}
+
+// WithRelated chains the generated message to a received AppHdr, e.g. the
+// request a response answers, by adding it to the Rltd headers.
+func WithRelated(appHdr head.BusinessApplicationHeaderV02) Option {
+	return WithHeader(func(h *bah.Options) { h.Related = append(h.Related, payment.RelatedTo(appHdr)) })
+}
+
+// WithPossibleDuplicate flags the generated message as a possible duplicate
+// (PssblDplct), as required when resending a message whose delivery is
+// unknown.
+func WithPossibleDuplicate() Option {
+	return WithHeader(func(h *bah.Options) { h.PossibleDuplicate = true })
+}
+
+// WithDuplicateStore makes Parse record every inbound message in store and
+// report one already recorded with a *DuplicateError.
+func WithDuplicateStore(store DuplicateStore) Option {
+	return func(o *options) { o.duplicates = store }
+}
+
+// WithRawDocument makes Parse set *raw to the raw XML of the Document, e.g.
+// for archiving or signature checks. raw shares memory with the parsed input.
+// The generated models do not keep the raw XML of their elements.
+func WithRawDocument(raw *[]byte) Option {
+	return func(o *options) { o.rawDocument = raw }
+}
+
+// WithXSDValidation checks messages against the ISO 20022 schemas of their
+// AppHdr and Document: Generate checks the message it built before writing
+// it, and Parse checks the inbound message before decoding it. set holds the
+// schemas; nil uses those embedded from Internal/XSD/iso. Violations are
+// returned in a *ValidationError whose Fields hold the XPath of each.
+func WithXSDValidation(set *xsd.Set) Option {
+	return func(o *options) { o.xsdValidation, o.xsds = true, set }
+}
+
+func newOptions(opts []Option) options {
+	o := options{now: time.Now}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// creationDateTime returns the creation date and time of a message whose
+// payload carries requested. The AppHdr CreDt and the creation time of the
+// Document, if it has one, are set to it.
+func (o options) creationDateTime(requested common.ISODateTime) common.ISODateTime {
+	if o.keepTimestamps && !time.Time(requested).IsZero() {
+		return requested
+	}
+	return common.ISODateTime(o.now().In(common.EstLocation))
+}
+
+// defaultSequence numbers the IDs of the default generators.
+var defaultSequence = &msgid.ClockSequence{}
+
+// assignIDs fills the blank identifiers of a message created at created: the
+// MsgId from the ID generator, the BizMsgIdr from the MsgId and, when
+// ownUETR is set, the UETR of a payment.
+func (o options) assignIDs(messageType string, cfg *config.Config, id *payment.Identifier, created common.ISODateTime, ownUETR bool) error {
+	if id.MessageID == "" {
+		g := o.ids
+		if g == nil {
+			source := cfg.MessageIDSource
+			if source == "" {
+				source = msgid.DefaultSource
+			}
+			g = &msgid.Generator{RTN: string(cfg.IspId), Source: source, Sequence: defaultSequence}
+		}
+		messageID, err := g.MessageIDAt(time.Time(created))
+		if err != nil {
+			return common.NewValidationError(messageType, common.PrefixError("fedNowMessage.identifier.messageId", err))
+		}
+		id.MessageID = messageID
+	}
+	if id.BusinessMessageID == "" {
+		id.BusinessMessageID = id.MessageID
+	}
+	if ownUETR && (id.UETR == nil || *id.UETR == "") {
+		uetr, err := msgid.NewUETR()
+		if err != nil {
+			return err
+		}
+		id.UETR = &uetr
+	}
+	return nil
+}
+
+// headerOptions returns the AppHdr options of a message identified by id and
+// created at created.
+func (o options) headerOptions(id payment.Identifier, created common.ISODateTime) bah.Options {
+	h := bah.OptionsFor(id)
+	h.CreationDateTime = time.Time(created)
+	for _, adjust := range o.header {
+		adjust(&h)
+	}
+	return h
+}
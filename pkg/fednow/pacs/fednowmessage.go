@@ -1,14 +1,11 @@
 package pacs
 
 import (
-	"encoding/json"
-	"fmt"
-	"strings"
-
 	"github.com/mbanq/iso20022-go/ISO20022/pacs_002_001_10"
 	"github.com/mbanq/iso20022-go/ISO20022/pacs_004_001_10"
 	"github.com/mbanq/iso20022-go/ISO20022/pacs_008_001_08"
 	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/payment"
 )
 
 type FedNowMessageCCT struct {
@@ -38,6 +35,9 @@ type FedNowDetails struct {
 	ReceiverDI       FedNowDepositoryInstitution `json:"receiverDepositoryInstitution"`
 	Originator       FedNowParty                 `json:"originator"`
 	Beneficiary      FedNowParty                 `json:"beneficiary"`
+	// CustomerType is not transmitted. It selects the per-customer-type
+	// limit of config.Limits, e.g. "retail".
+	CustomerType string `json:"customerType,omitempty"`
 }
 
 type FedNowACK struct {
@@ -61,55 +61,31 @@ type FedNowRtn struct {
 	Beneficiary        FedNowParty                 `json:"beneficiary"`
 }
 
-type FedNowIdentifier struct {
-	BusinessMessageID pacs_008_001_08.Max35Text         `json:"businessMessageId"`
-	MessageID         pacs_008_001_08.Max35Text         `json:"messageId"`
-	MessageType       pacs_008_001_08.Max35Text         `json:"messageType,omitempty"`
-	InstructionID     *pacs_008_001_08.Max35Text        `json:"instructionId"`
-	EndToEndID        pacs_008_001_08.Max35Text         `json:"endToEndId,omitempty"`
-	TransactionID     *pacs_008_001_08.Max35Text        `json:"transactionId,omitempty"`
-	UETR              *pacs_008_001_08.UUIDv4Identifier `json:"uetr,omitempty"`
-	CreationDateTime  common.ISODateTime                `json:"creationDateTime,omitempty"`
-}
+// FedNowIdentifier is the shared message identifier.
+type FedNowIdentifier = payment.Identifier
 
 type FedNowPaymentType struct {
-	CategoryPurpose *pacs_008_001_08.ExternalCategoryPurpose1Code `json:"categoryPurpose"`
+	CategoryPurpose *pacs_008_001_08.ExternalCategoryPurpose1Code `json:"categoryPurpose" jsonschema:"required"`
 }
 
-type FedNowAmount struct {
-	Text json.Number                        `json:"amount"`
-	Ccy  pacs_008_001_08.ActiveCurrencyCode `json:"currency"`
-}
+// FedNowAmount is the shared currency amount.
+type FedNowAmount = payment.Amount
 
-type FedNowDepositoryInstitution struct {
-	SenderABANumber   pacs_008_001_08.Max35Text   `json:"senderABANumber,omitempty"`
-	ReceiverABANumber pacs_008_001_08.Max35Text   `json:"receiverABANumber,omitempty"`
-	Name              *pacs_008_001_08.Max140Text `json:"senderShortName"`
-}
+// FedNowDepositoryInstitution is the shared participant identification.
+type FedNowDepositoryInstitution = payment.DepositoryInstitution
 
-type FedNowParty struct {
-	Personal FedNowPersonal `json:"personal"`
-}
+// FedNowParty is the shared debtor/creditor party.
+type FedNowParty = payment.Party
 
-type FedNowPersonal struct {
-	Name       *pacs_008_001_08.Max140Text `json:"name"`
-	Address    FedNowPstlAdr               `json:"postalAddress"`
-	Identifier pacs_008_001_08.Max34Text   `json:"identifier"`
-}
+// FedNowPersonal is the shared party detail.
+type FedNowPersonal = payment.Personal
 
-type FedNowPstlAdr struct {
-	StreetName         *pacs_008_001_08.Max70Text   `json:"StreetName"`
-	BuildingNumber     *pacs_008_001_08.Max16Text   `json:"BuildingNumber"`
-	PostBox            *pacs_008_001_08.Max16Text   `json:"PostBox"`
-	TownName           *pacs_008_001_08.Max35Text   `json:"TownName"`
-	CountrySubdivision *pacs_008_001_08.Max35Text   `json:"CountrySubDivision"`
-	PostalCode         *pacs_008_001_08.Max16Text   `json:"PostalCode"`
-	Country            *pacs_008_001_08.CountryCode `json:"Country"`
-}
+// FedNowPstlAdr is the shared ISO PostalAddress24 representation.
+type FedNowPstlAdr = payment.PostalAddress
 
 type PaymentStatus struct {
 	//TODO: Add Optional Field - Originator
-	PaymentStatus         *pacs_002_001_10.ExternalPaymentTransactionStatus1Code `json:"paymentStatus"`
+	PaymentStatus         *pacs_002_001_10.ExternalPaymentTransactionStatus1Code `json:"paymentStatus" jsonschema:"required"`
 	AcceptanceDateTime    *common.ISODateTime                                    `json:"acceptanceDateTime,omitempty"`
 	StatusReason          *pacs_002_001_10.ExternalStatusReason1Code             `json:"statusReason,omitempty"`
 	AdditionalInformation *pacs_002_001_10.Max105Text                            `json:"additionalInformation,omitempty"`
@@ -120,28 +96,3 @@ type PaymentReturn struct {
 	AdditionalInformation *pacs_004_001_10.Max105Text                `json:"additionalInformation,omitempty"`
 	ReturnedAmount        FedNowAmount                               `json:"returnedAmount"`
 }
-
-func (address FedNowPstlAdr) ValidateAddress() error {
-	var missingFields []string
-	if address.StreetName == nil || *address.StreetName == "" {
-		missingFields = append(missingFields, "StreetName")
-	}
-	if address.TownName == nil || *address.TownName == "" {
-		missingFields = append(missingFields, "TownName")
-	}
-	if address.CountrySubdivision == nil || *address.CountrySubdivision == "" {
-		missingFields = append(missingFields, "CountrySubdivision")
-	}
-	if address.PostalCode == nil || *address.PostalCode == "" {
-		missingFields = append(missingFields, "PostalCode")
-	}
-	if address.Country == nil || *address.Country == "" {
-		missingFields = append(missingFields, "Country")
-	}
-
-	if len(missingFields) > 0 {
-		return fmt.Errorf("missing required address fields: %s", strings.Join(missingFields, ", "))
-	}
-
-	return nil
-}
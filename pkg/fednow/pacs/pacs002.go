@@ -8,16 +8,38 @@ import (
 
 	head "github.com/mbanq/iso20022-go/ISO20022/head_001_001_02"
 	"github.com/mbanq/iso20022-go/ISO20022/pacs_002_001_10"
-	"github.com/mbanq/iso20022-go/ISO20022/pacs_008_001_08"
 	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow/codes"
 	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+	"github.com/mbanq/iso20022-go/pkg/payment"
 )
 
 func BuildPacs002Struct(message FedNowMessageACK, msgConfig *config.Config) (*pacs_002_001_10.Document, error) {
 
 	fedMsg := message.FedNowMsg
 
-	clearingSystemId := pacs_002_001_10.ExternalClearingSystemIdentification1Code(msgConfig.ClearingSystemId)
+	clearingSystemId := string(msgConfig.ClearingSystemId)
+
+	// Routing Number Validation
+	if err := common.ValidateRoutingNumber("fedNowMessage.senderDepositoryInstitution.senderABANumber", fedMsg.SenderDI.SenderABANumber); err != nil {
+		return nil, common.NewValidationError("pacs.002.001.10", err)
+	}
+	if err := common.ValidateRoutingNumber("fedNowMessage.receiverDepositoryInstitution.receiverABANumber", fedMsg.ReceiverDI.ReceiverABANumber); err != nil {
+		return nil, common.NewValidationError("pacs.002.001.10", err)
+	}
+
+	// Code Validation
+	if fedMsg.PaymentStatus.PaymentStatus == nil {
+		return nil, common.NewValidationError("pacs.002.001.10", &common.FieldError{Path: "fedNowMessage.paymentStatus.paymentStatus", Reason: "is required"})
+	}
+	if err := codes.Validate("fedNowMessage.paymentStatus.paymentStatus", "pacs.002.001.10", codes.PaymentStatus, string(*fedMsg.PaymentStatus.PaymentStatus)); err != nil {
+		return nil, common.NewValidationError("pacs.002.001.10", err)
+	}
+	if fedMsg.PaymentStatus.StatusReason != nil {
+		if err := codes.Validate("fedNowMessage.paymentStatus.statusReason", "pacs.002.001.10", codes.StatusReason, string(*fedMsg.PaymentStatus.StatusReason)); err != nil {
+			return nil, common.NewValidationError("pacs.002.001.10", err)
+		}
+	}
 
 	// OrgnlCreDtTm should reflect the original message's creation time (not the ACK's creation time).
 	// We only set it if OriginalIdentifier.CreationDateTime is non-zero.
@@ -59,27 +81,9 @@ func BuildPacs002Struct(message FedNowMessageACK, msgConfig *config.Config) (*pa
 						OrgnlMsgNmId: pacs_002_001_10.Max35Text(fedMsg.OriginalIdentifier.MessageType),
 						OrgnlCreDtTm: creationTimePtr,
 					},
-					TxSts: fedMsg.PaymentStatus.PaymentStatus,
-					InstgAgt: &pacs_002_001_10.BranchAndFinancialInstitutionIdentification6{
-						FinInstnId: pacs_002_001_10.FinancialInstitutionIdentification18{
-							ClrSysMmbId: &pacs_002_001_10.ClearingSystemMemberIdentification2{
-								MmbId: pacs_002_001_10.Max35Text(fedMsg.SenderDI.SenderABANumber),
-								ClrSysId: &pacs_002_001_10.ClearingSystemIdentification2Choice{
-									Cd: &clearingSystemId,
-								},
-							},
-						},
-					},
-					InstdAgt: &pacs_002_001_10.BranchAndFinancialInstitutionIdentification6{
-						FinInstnId: pacs_002_001_10.FinancialInstitutionIdentification18{
-							ClrSysMmbId: &pacs_002_001_10.ClearingSystemMemberIdentification2{
-								MmbId: pacs_002_001_10.Max35Text(fedMsg.ReceiverDI.ReceiverABANumber),
-								ClrSysId: &pacs_002_001_10.ClearingSystemIdentification2Choice{
-									Cd: &clearingSystemId,
-								},
-							},
-						},
-					},
+					TxSts:    fedMsg.PaymentStatus.PaymentStatus,
+					InstgAgt: payment.AgentToPacs002(fedMsg.SenderDI.SenderABANumber, nil, clearingSystemId),
+					InstdAgt: payment.AgentToPacs002(fedMsg.ReceiverDI.ReceiverABANumber, nil, clearingSystemId),
 				},
 			},
 		},
@@ -98,7 +102,7 @@ func BuildPacs002Struct(message FedNowMessageACK, msgConfig *config.Config) (*pa
 		pacsDoc.FIToFIPmtStsRpt.TxInfAndSts[0].OrgnlUETR = uetr
 	}
 
-	if *fedMsg.PaymentStatus.PaymentStatus == "ACSC" || *fedMsg.PaymentStatus.PaymentStatus == "ACWP" {
+	if *fedMsg.PaymentStatus.PaymentStatus == pacs_002_001_10.ExternalPaymentTransactionStatus1CodeAcsc || *fedMsg.PaymentStatus.PaymentStatus == pacs_002_001_10.ExternalPaymentTransactionStatus1CodeAcwp {
 		if fedMsg.PaymentStatus.AcceptanceDateTime != nil {
 			pacsDoc.FIToFIPmtStsRpt.TxInfAndSts[0].AccptncDtTm = fedMsg.PaymentStatus.AcceptanceDateTime
 			acceptanceDate := common.ISODate(time.Time(*fedMsg.PaymentStatus.AcceptanceDateTime))
@@ -108,7 +112,7 @@ func BuildPacs002Struct(message FedNowMessageACK, msgConfig *config.Config) (*pa
 		}
 	}
 
-	if *fedMsg.PaymentStatus.PaymentStatus == "RJCT" {
+	if *fedMsg.PaymentStatus.PaymentStatus == pacs_002_001_10.ExternalPaymentTransactionStatus1CodeRjct {
 		var statusReason *pacs_002_001_10.ExternalStatusReason1Code
 		if fedMsg.PaymentStatus.StatusReason != nil {
 			code := pacs_002_001_10.ExternalStatusReason1Code(*fedMsg.PaymentStatus.StatusReason)
@@ -138,7 +142,7 @@ func BuildPacs002(payload []byte, config *config.Config) (*pacs_002_001_10.Docum
 
 	var message FedNowMessageACK
 	if err := json.Unmarshal(payload, &message); err != nil {
-		return nil, err
+		return nil, common.NewJSONDecodeError("pacs.002.001.10", payload, err)
 	}
 
 	return BuildPacs002Struct(message, config)
@@ -147,60 +151,45 @@ func BuildPacs002(payload []byte, config *config.Config) (*pacs_002_001_10.Docum
 func ParsePacs002(appHdr head.BusinessApplicationHeaderV02, document pacs_002_001_10.Document) (*FedNowMessageACK, error) {
 
 	fitofipmtstsrpt := document.FIToFIPmtStsRpt
-	txinfandsts := fitofipmtstsrpt.TxInfAndSts[0]
-
-	var orgnlInstrId *pacs_008_001_08.Max35Text
-	if txinfandsts.OrgnlInstrId != nil {
-		val := pacs_008_001_08.Max35Text(*txinfandsts.OrgnlInstrId)
-		orgnlInstrId = &val
+	if len(fitofipmtstsrpt.TxInfAndSts) == 0 {
+		return nil, &common.FieldError{Path: "FIToFIPmtStsRpt.TxInfAndSts", Reason: "is required"}
 	}
+	txinfandsts := fitofipmtstsrpt.TxInfAndSts[0]
 
-	var orgnlMsgId pacs_008_001_08.Max35Text
-	var orgnlMsgNmId pacs_008_001_08.Max35Text
+	var orgnlMsgId string
+	var orgnlMsgNmId string
 	var orgnlCreDtTm common.ISODateTime
 	if txinfandsts.OrgnlGrpInf != nil {
-		orgnlMsgId = pacs_008_001_08.Max35Text(txinfandsts.OrgnlGrpInf.OrgnlMsgId)
-		orgnlMsgNmId = pacs_008_001_08.Max35Text(txinfandsts.OrgnlGrpInf.OrgnlMsgNmId)
+		orgnlMsgId = string(txinfandsts.OrgnlGrpInf.OrgnlMsgId)
+		orgnlMsgNmId = string(txinfandsts.OrgnlGrpInf.OrgnlMsgNmId)
 		if txinfandsts.OrgnlGrpInf.OrgnlCreDtTm != nil {
 			orgnlCreDtTm = common.ISODateTime(*txinfandsts.OrgnlGrpInf.OrgnlCreDtTm)
 		}
 	}
 
-	var orgnlEndToEndId pacs_008_001_08.Max35Text
+	var orgnlEndToEndId string
 	if txinfandsts.OrgnlEndToEndId != nil {
-		orgnlEndToEndId = pacs_008_001_08.Max35Text(*txinfandsts.OrgnlEndToEndId)
+		orgnlEndToEndId = string(*txinfandsts.OrgnlEndToEndId)
 	}
 
-	var orgnlTxId *pacs_008_001_08.Max35Text
-	if txinfandsts.OrgnlTxId != nil {
-		val := pacs_008_001_08.Max35Text(*txinfandsts.OrgnlTxId)
-		orgnlTxId = &val
-	}
-
-	var orgnlUETR *pacs_008_001_08.UUIDv4Identifier
-	if txinfandsts.OrgnlUETR != nil {
-		val := pacs_008_001_08.UUIDv4Identifier(*txinfandsts.OrgnlUETR)
-		orgnlUETR = &val
-	}
-
-	senderABANumber := extractClrSysMemberID(appHdr.Fr)
-	receiverABANumber := extractClrSysMemberID(appHdr.To)
+	senderABANumber := payment.MemberIDFromHead(appHdr.Fr)
+	receiverABANumber := payment.MemberIDFromHead(appHdr.To)
 
 	fednowMsg := FedNowMessageACK{
 		FedNowMsg: FedNowACK{
 			CreationDateTime: common.ISODateTime(fitofipmtstsrpt.GrpHdr.CreDtTm),
 			Identifier: FedNowIdentifier{
-				BusinessMessageID: pacs_008_001_08.Max35Text(appHdr.BizMsgIdr),
-				MessageID:         pacs_008_001_08.Max35Text(fitofipmtstsrpt.GrpHdr.MsgId),
+				BusinessMessageID: string(appHdr.BizMsgIdr),
+				MessageID:         string(fitofipmtstsrpt.GrpHdr.MsgId),
 				CreationDateTime:  common.ISODateTime(appHdr.CreDt),
-			},
+			}.WithHeader(appHdr),
 			OriginalIdentifier: FedNowIdentifier{
 				MessageID:        orgnlMsgId,
 				MessageType:      orgnlMsgNmId,
-				InstructionID:    orgnlInstrId,
+				InstructionID:    (*string)(txinfandsts.OrgnlInstrId),
 				EndToEndID:       orgnlEndToEndId,
-				TransactionID:    orgnlTxId,
-				UETR:             orgnlUETR,
+				TransactionID:    (*string)(txinfandsts.OrgnlTxId),
+				UETR:             (*string)(txinfandsts.OrgnlUETR),
 				CreationDateTime: orgnlCreDtTm,
 			},
 			PaymentStatus: PaymentStatus{
@@ -217,11 +206,11 @@ func ParsePacs002(appHdr head.BusinessApplicationHeaderV02, document pacs_002_00
 
 	if txinfandsts.TxSts != nil {
 		switch *txinfandsts.TxSts {
-		case "ACSC", "ACWP":
+		case pacs_002_001_10.ExternalPaymentTransactionStatus1CodeAcsc, pacs_002_001_10.ExternalPaymentTransactionStatus1CodeAcwp:
 			if txinfandsts.AccptncDtTm != nil {
 				fednowMsg.FedNowMsg.PaymentStatus.AcceptanceDateTime = txinfandsts.AccptncDtTm
 			}
-		case "RJCT":
+		case pacs_002_001_10.ExternalPaymentTransactionStatus1CodeRjct:
 			if len(txinfandsts.StsRsnInf) > 0 {
 				statusReasonInfo := txinfandsts.StsRsnInf[0]
 				if statusReasonInfo.Rsn != nil && statusReasonInfo.Rsn.Cd != nil {
@@ -5,30 +5,70 @@
 package pacs
 
 import (
+	"fmt"
+
+	head "github.com/mbanq/iso20022-go/ISO20022/head_001_001_02"
 	pacs004 "github.com/mbanq/iso20022-go/ISO20022/pacs_004_001_10"
 	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow/codes"
 	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+	"github.com/mbanq/iso20022-go/pkg/payment"
 )
 
 // BuildPacs004Struct creates a pacs.004.001.10 message from a FedNowMessageRtn struct
 func BuildPacs004Struct(message FedNowMessageRtn, msgConfig *config.Config) (*pacs004.Document, error) {
 
+	fedMsg := message.FedNowMsg
+
+	// Routing Number Validation
+	if err := common.ValidateRoutingNumber("fedNowMessage.senderDepositoryInstitution.senderABANumber", fedMsg.SenderDI.SenderABANumber); err != nil {
+		return nil, common.NewValidationError("pacs.004.001.10", err)
+	}
+	if err := common.ValidateRoutingNumber("fedNowMessage.receiverDepositoryInstitution.receiverABANumber", fedMsg.ReceiverDI.ReceiverABANumber); err != nil {
+		return nil, common.NewValidationError("pacs.004.001.10", err)
+	}
+
+	// Code Validation
+	if fedMsg.PaymentReturn.ReturnReason != nil {
+		if err := codes.Validate("fedNowMessage.paymentReturn.returnReason", "pacs.004.001.10", codes.ReturnReason, string(*fedMsg.PaymentReturn.ReturnReason)); err != nil {
+			return nil, common.NewValidationError("pacs.004.001.10", err)
+		}
+	}
+
+	// Amount Validation
+	returnedAmount := fedMsg.PaymentReturn.ReturnedAmount
+	if err := fedMsg.Amount.ValidateFedNow("fedNowMessage.amount"); err != nil {
+		return nil, common.NewValidationError("pacs.004.001.10", err)
+	}
+	if err := returnedAmount.Validate("fedNowMessage.paymentReturn.returnedAmount"); err != nil {
+		return nil, common.NewValidationError("pacs.004.001.10", err)
+	}
+	if returnedAmount.Ccy != fedMsg.Amount.Ccy {
+		return nil, common.NewValidationError("pacs.004.001.10", &common.FieldError{Path: "fedNowMessage.paymentReturn.returnedAmount.currency", Value: returnedAmount.Ccy, Reason: fmt.Sprintf("does not match original currency %s", fedMsg.Amount.Ccy)})
+	}
+	if returnedAmount.Text.Cmp(fedMsg.Amount.Text) > 0 {
+		return nil, common.NewValidationError("pacs.004.001.10", &common.FieldError{Path: "fedNowMessage.paymentReturn.returnedAmount.amount", Value: returnedAmount.Text.String(), Reason: fmt.Sprintf("exceeds original amount %s", fedMsg.Amount.Text)})
+	}
+
 	clearingSystemCd := pacs004.ExternalCashClearingSystem1Code(msgConfig.ClearingSystem)
-	clearingSystemId := pacs004.ExternalClearingSystemIdentification1Code(msgConfig.ClearingSystemId)
+	clearingSystemId := string(msgConfig.ClearingSystemId)
 	chargebearer := pacs004.ChargeBearerType1Code(msgConfig.ChargeBearer)
-	OrgnlCreDtTm := message.FedNowMsg.OriginalIdentifier.CreationDateTime
+	OrgnlCreDtTm := fedMsg.OriginalIdentifier.CreationDateTime
 	localInstrument := pacs004.Max35Text(*msgConfig.LocalInstrument.Prtry)
-	var orgnlUetr *pacs004.UUIDv4Identifier
-	if message.FedNowMsg.OriginalIdentifier.UETR != nil {
-		tmp := pacs004.UUIDv4Identifier(*message.FedNowMsg.OriginalIdentifier.UETR)
-		orgnlUetr = &tmp
+	orgnlIntrBkSttlmAmt := payment.HistoricAmountToPacs004(fedMsg.Amount)
+	originator := payment.PartyToPacs004(fedMsg.Originator)
+	beneficiary := payment.PartyToPacs004(fedMsg.Beneficiary)
+
+	var additionalInfo []pacs004.Max105Text
+	if fedMsg.PaymentReturn.AdditionalInformation != nil {
+		additionalInfo = []pacs004.Max105Text{*fedMsg.PaymentReturn.AdditionalInformation}
 	}
 
 	pacsDoc := &pacs004.Document{
 		PmtRtr: pacs004.PaymentReturnV10{
 			GrpHdr: pacs004.GroupHeader90{
-				MsgId:   pacs004.Max35Text(message.FedNowMsg.Identifier.MessageID),
-				CreDtTm: message.FedNowMsg.CreationDateTime,
+				MsgId:   pacs004.Max35Text(fedMsg.Identifier.MessageID),
+				CreDtTm: fedMsg.CreationDateTime,
 				NbOfTxs: "1",
 				SttlmInf: pacs004.SettlementInstruction7{
 					SttlmMtd: pacs004.SettlementMethod1Code(msgConfig.SettlementMethod),
@@ -40,116 +80,38 @@ func BuildPacs004Struct(message FedNowMessageRtn, msgConfig *config.Config) (*pa
 			TxInf: []pacs004.PaymentTransaction118{
 				{
 					OrgnlGrpInf: &pacs004.OriginalGroupInformation29{
-						OrgnlMsgId:   pacs004.Max35Text(message.FedNowMsg.OriginalIdentifier.MessageID),
-						OrgnlMsgNmId: pacs004.Max35Text(message.FedNowMsg.OriginalIdentifier.MessageType),
+						OrgnlMsgId:   pacs004.Max35Text(fedMsg.OriginalIdentifier.MessageID),
+						OrgnlMsgNmId: pacs004.Max35Text(fedMsg.OriginalIdentifier.MessageType),
 						OrgnlCreDtTm: &OrgnlCreDtTm,
 					},
-					OrgnlInstrId:    (*pacs004.Max35Text)(message.FedNowMsg.OriginalIdentifier.InstructionID),
-					OrgnlEndToEndId: (*pacs004.Max35Text)(&message.FedNowMsg.OriginalIdentifier.EndToEndID),
-					OrgnlUETR:       orgnlUetr,
-					OrgnlIntrBkSttlmAmt: &pacs004.ActiveOrHistoricCurrencyAndAmount{
-						Ccy:  pacs004.ActiveOrHistoricCurrencyCode(message.FedNowMsg.Amount.Ccy),
-						Text: string(message.FedNowMsg.Amount.Text),
-					},
-					OrgnlIntrBkSttlmDt: (*common.ISODate)(&message.FedNowMsg.OriginalIdentifier.CreationDateTime),
-					RtrdIntrBkSttlmAmt: pacs004.ActiveCurrencyAndAmount{
-						Ccy:  pacs004.ActiveCurrencyCode(message.FedNowMsg.PaymentReturn.ReturnedAmount.Ccy),
-						Text: string(message.FedNowMsg.PaymentReturn.ReturnedAmount.Text),
-					},
-					IntrBkSttlmDt: (*common.ISODate)(&message.FedNowMsg.CreationDateTime),
-					ChrgBr:        &chargebearer,
-					InstgAgt: &pacs004.BranchAndFinancialInstitutionIdentification6{
-						FinInstnId: pacs004.FinancialInstitutionIdentification18{
-							ClrSysMmbId: &pacs004.ClearingSystemMemberIdentification2{
-								ClrSysId: &pacs004.ClearingSystemIdentification2Choice{
-									Cd: &clearingSystemId,
-								},
-								MmbId: pacs004.Max35Text(message.FedNowMsg.SenderDI.SenderABANumber),
-							},
-						},
-					},
-					InstdAgt: &pacs004.BranchAndFinancialInstitutionIdentification6{
-						FinInstnId: pacs004.FinancialInstitutionIdentification18{
-							ClrSysMmbId: &pacs004.ClearingSystemMemberIdentification2{
-								ClrSysId: &pacs004.ClearingSystemIdentification2Choice{
-									Cd: &clearingSystemId,
-								},
-								MmbId: pacs004.Max35Text(message.FedNowMsg.ReceiverDI.ReceiverABANumber),
-							},
-						},
-					},
+					OrgnlInstrId:        (*pacs004.Max35Text)(fedMsg.OriginalIdentifier.InstructionID),
+					OrgnlEndToEndId:     (*pacs004.Max35Text)(&fedMsg.OriginalIdentifier.EndToEndID),
+					OrgnlUETR:           (*pacs004.UUIDv4Identifier)(fedMsg.OriginalIdentifier.UETR),
+					OrgnlIntrBkSttlmAmt: &orgnlIntrBkSttlmAmt,
+					OrgnlIntrBkSttlmDt:  (*common.ISODate)(&fedMsg.OriginalIdentifier.CreationDateTime),
+					RtrdIntrBkSttlmAmt:  payment.AmountToPacs004(fedMsg.PaymentReturn.ReturnedAmount),
+					IntrBkSttlmDt:       (*common.ISODate)(&fedMsg.CreationDateTime),
+					ChrgBr:              &chargebearer,
+					InstgAgt:            payment.AgentToPacs004(fedMsg.SenderDI.SenderABANumber, nil, clearingSystemId),
+					InstdAgt:            payment.AgentToPacs004(fedMsg.ReceiverDI.ReceiverABANumber, nil, clearingSystemId),
 					RtrChain: &pacs004.TransactionParties8{
 						Dbtr: pacs004.Party40Choice{
-							Pty: &pacs004.PartyIdentification135{
-								Nm: (*pacs004.Max140Text)(message.FedNowMsg.Originator.Personal.Name),
-								PstlAdr: &pacs004.PostalAddress24{
-									StrtNm:      (*pacs004.Max70Text)(message.FedNowMsg.Originator.Personal.Address.StreetName),
-									BldgNb:      (*pacs004.Max16Text)(message.FedNowMsg.Originator.Personal.Address.BuildingNumber),
-									TwnNm:       (*pacs004.Max35Text)(message.FedNowMsg.Originator.Personal.Address.TownName),
-									CtrySubDvsn: (*pacs004.Max35Text)(message.FedNowMsg.Originator.Personal.Address.CountrySubdivision),
-									PstCd:       (*pacs004.Max16Text)(message.FedNowMsg.Originator.Personal.Address.PostalCode),
-									Ctry:        (*pacs004.CountryCode)(message.FedNowMsg.Originator.Personal.Address.Country),
-								},
-							},
-						},
-						DbtrAcct: &pacs004.CashAccount38{
-							Id: pacs004.AccountIdentification4Choice{
-								Othr: &pacs004.GenericAccountIdentification1{
-									Id: pacs004.Max34Text(message.FedNowMsg.Originator.Personal.Identifier),
-								},
-							},
-						},
-						DbtrAgt: &pacs004.BranchAndFinancialInstitutionIdentification6{
-							FinInstnId: pacs004.FinancialInstitutionIdentification18{
-								ClrSysMmbId: &pacs004.ClearingSystemMemberIdentification2{
-									MmbId: pacs004.Max35Text(message.FedNowMsg.SenderDI.SenderABANumber),
-									ClrSysId: &pacs004.ClearingSystemIdentification2Choice{
-										Cd: &clearingSystemId,
-									},
-								},
-								Nm: (*pacs004.Max140Text)(message.FedNowMsg.SenderDI.Name),
-							},
-						},
-						CdtrAgt: &pacs004.BranchAndFinancialInstitutionIdentification6{
-							FinInstnId: pacs004.FinancialInstitutionIdentification18{
-								ClrSysMmbId: &pacs004.ClearingSystemMemberIdentification2{
-									MmbId: pacs004.Max35Text(message.FedNowMsg.ReceiverDI.ReceiverABANumber),
-									ClrSysId: &pacs004.ClearingSystemIdentification2Choice{
-										Cd: &clearingSystemId,
-									},
-								},
-								Nm: (*pacs004.Max140Text)(message.FedNowMsg.ReceiverDI.Name),
-							},
+							Pty: &originator,
 						},
+						DbtrAcct: payment.AccountToPacs004(fedMsg.Originator.Personal.Identifier),
+						DbtrAgt:  payment.AgentToPacs004(fedMsg.SenderDI.SenderABANumber, fedMsg.SenderDI.Name, clearingSystemId),
+						CdtrAgt:  payment.AgentToPacs004(fedMsg.ReceiverDI.ReceiverABANumber, fedMsg.ReceiverDI.Name, clearingSystemId),
 						Cdtr: pacs004.Party40Choice{
-							Pty: &pacs004.PartyIdentification135{
-								Nm: (*pacs004.Max140Text)(message.FedNowMsg.Beneficiary.Personal.Name),
-								PstlAdr: &pacs004.PostalAddress24{
-									StrtNm:      (*pacs004.Max70Text)(message.FedNowMsg.Beneficiary.Personal.Address.StreetName),
-									BldgNb:      (*pacs004.Max16Text)(message.FedNowMsg.Beneficiary.Personal.Address.BuildingNumber),
-									TwnNm:       (*pacs004.Max35Text)(message.FedNowMsg.Beneficiary.Personal.Address.TownName),
-									CtrySubDvsn: (*pacs004.Max35Text)(message.FedNowMsg.Beneficiary.Personal.Address.CountrySubdivision),
-									PstCd:       (*pacs004.Max16Text)(message.FedNowMsg.Beneficiary.Personal.Address.PostalCode),
-									Ctry:        (*pacs004.CountryCode)(message.FedNowMsg.Beneficiary.Personal.Address.Country),
-								},
-							},
-						},
-						CdtrAcct: &pacs004.CashAccount38{
-							Id: pacs004.AccountIdentification4Choice{
-								Othr: &pacs004.GenericAccountIdentification1{
-									Id: pacs004.Max34Text(message.FedNowMsg.Beneficiary.Personal.Identifier),
-								},
-							},
+							Pty: &beneficiary,
 						},
+						CdtrAcct: payment.AccountToPacs004(fedMsg.Beneficiary.Personal.Identifier),
 					},
 					RtrRsnInf: []pacs004.PaymentReturnReason6{
 						{
 							Rsn: &pacs004.ReturnReason5Choice{
-								Cd: message.FedNowMsg.PaymentReturn.ReturnReason,
-							},
-							AddtlInf: []pacs004.Max105Text{
-								pacs004.Max105Text(*message.FedNowMsg.PaymentReturn.AdditionalInformation),
+								Cd: fedMsg.PaymentReturn.ReturnReason,
 							},
+							AddtlInf: additionalInfo,
 						},
 					},
 					OrgnlTxRef: &pacs004.OriginalTransactionReference32{
@@ -166,3 +128,103 @@ func BuildPacs004Struct(message FedNowMessageRtn, msgConfig *config.Config) (*pa
 
 	return pacsDoc, nil
 }
+
+// ParsePacs004 parses a pacs.004.001.10 document into a FedNowMessageRtn struct
+func ParsePacs004(appHdr head.BusinessApplicationHeaderV02, document pacs004.Document) (*FedNowMessageRtn, error) {
+
+	pmtrtr := document.PmtRtr
+	if len(pmtrtr.TxInf) == 0 {
+		return nil, &common.FieldError{Path: "PmtRtr.TxInf", Reason: "is required"}
+	}
+	txinf := pmtrtr.TxInf[0]
+
+	var orgnlMsgId string
+	var orgnlMsgNmId string
+	var orgnlCreDtTm common.ISODateTime
+	if txinf.OrgnlGrpInf != nil {
+		orgnlMsgId = string(txinf.OrgnlGrpInf.OrgnlMsgId)
+		orgnlMsgNmId = string(txinf.OrgnlGrpInf.OrgnlMsgNmId)
+		if txinf.OrgnlGrpInf.OrgnlCreDtTm != nil {
+			orgnlCreDtTm = *txinf.OrgnlGrpInf.OrgnlCreDtTm
+		}
+	}
+
+	var orgnlEndToEndId string
+	if txinf.OrgnlEndToEndId != nil {
+		orgnlEndToEndId = string(*txinf.OrgnlEndToEndId)
+	}
+
+	var amount FedNowAmount
+	if txinf.OrgnlIntrBkSttlmAmt != nil {
+		parsed, err := payment.AmountFromPacs004Historic(*txinf.OrgnlIntrBkSttlmAmt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid OrgnlIntrBkSttlmAmt: %w", err)
+		}
+		amount = parsed
+	}
+	returnedAmount, err := payment.AmountFromPacs004(txinf.RtrdIntrBkSttlmAmt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid RtrdIntrBkSttlmAmt: %w", err)
+	}
+
+	paymentReturn := PaymentReturn{ReturnedAmount: returnedAmount}
+	if len(txinf.RtrRsnInf) > 0 {
+		rtrRsnInf := txinf.RtrRsnInf[0]
+		if rtrRsnInf.Rsn != nil {
+			paymentReturn.ReturnReason = rtrRsnInf.Rsn.Cd
+		}
+		if len(rtrRsnInf.AddtlInf) > 0 {
+			paymentReturn.AdditionalInformation = &rtrRsnInf.AddtlInf[0]
+		}
+	}
+
+	senderABANumber := payment.MemberIDFromPacs004Agent(txinf.InstgAgt)
+	if senderABANumber == "" {
+		senderABANumber = payment.MemberIDFromHead(appHdr.Fr)
+	}
+	receiverABANumber := payment.MemberIDFromPacs004Agent(txinf.InstdAgt)
+	if receiverABANumber == "" {
+		receiverABANumber = payment.MemberIDFromHead(appHdr.To)
+	}
+
+	var originator, beneficiary FedNowParty
+	if txinf.RtrChain != nil {
+		if txinf.RtrChain.Dbtr.Pty != nil {
+			originator = payment.PartyFromPacs004(*txinf.RtrChain.Dbtr.Pty, txinf.RtrChain.DbtrAcct)
+		}
+		if txinf.RtrChain.Cdtr.Pty != nil {
+			beneficiary = payment.PartyFromPacs004(*txinf.RtrChain.Cdtr.Pty, txinf.RtrChain.CdtrAcct)
+		}
+	}
+
+	fednowMsg := FedNowMessageRtn{
+		FedNowMsg: FedNowRtn{
+			CreationDateTime: pmtrtr.GrpHdr.CreDtTm,
+			Identifier: FedNowIdentifier{
+				BusinessMessageID: string(appHdr.BizMsgIdr),
+				MessageID:         string(pmtrtr.GrpHdr.MsgId),
+				CreationDateTime:  common.ISODateTime(appHdr.CreDt),
+			}.WithHeader(appHdr),
+			OriginalIdentifier: FedNowIdentifier{
+				MessageID:        orgnlMsgId,
+				MessageType:      orgnlMsgNmId,
+				InstructionID:    (*string)(txinf.OrgnlInstrId),
+				EndToEndID:       orgnlEndToEndId,
+				UETR:             (*string)(txinf.OrgnlUETR),
+				CreationDateTime: orgnlCreDtTm,
+			},
+			Amount:        amount,
+			PaymentReturn: paymentReturn,
+			SenderDI: FedNowDepositoryInstitution{
+				SenderABANumber: senderABANumber,
+			},
+			ReceiverDI: FedNowDepositoryInstitution{
+				ReceiverABANumber: receiverABANumber,
+			},
+			Originator:  originator,
+			Beneficiary: beneficiary,
+		},
+	}
+
+	return &fednowMsg, nil
+}
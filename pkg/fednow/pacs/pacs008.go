@@ -9,6 +9,7 @@ import (
 	"github.com/mbanq/iso20022-go/ISO20022/pacs_008_001_08"
 	"github.com/mbanq/iso20022-go/pkg/common"
 	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+	"github.com/mbanq/iso20022-go/pkg/payment"
 )
 
 func BuildPacs008Struct(message FedNowMessageCCT, msgConfig *config.Config) (*pacs_008_001_08.Document, error) {
@@ -17,28 +18,41 @@ func BuildPacs008Struct(message FedNowMessageCCT, msgConfig *config.Config) (*pa
 
 	// Assigning Configuration Values
 	cd := pacs_008_001_08.ExternalCashClearingSystem1Code(msgConfig.ClearingSystem)
-	clearingSystemId := pacs_008_001_08.ExternalClearingSystemIdentification1Code(msgConfig.ClearingSystemId)
+	if fedMsg.PaymentType.CategoryPurpose == nil {
+		return nil, common.NewValidationError("pacs.008.001.08", &common.FieldError{Path: "fedNowMessage.paymentType.categoryPurpose", Reason: "is required"})
+	}
 	categoryPurpose := pacs_008_001_08.Max35Text(*fedMsg.PaymentType.CategoryPurpose)
 
 	if fedMsg.Identifier.EndToEndID == "" {
 		fedMsg.Identifier.EndToEndID = "NOTPROVIDED"
 	}
 
+	// Routing Number Validation
+	if err := common.ValidateRoutingNumber("fedNowMessage.senderDepositoryInstitution.senderABANumber", fedMsg.SenderDI.SenderABANumber); err != nil {
+		return nil, common.NewValidationError("pacs.008.001.08", err)
+	}
+	if err := common.ValidateRoutingNumber("fedNowMessage.receiverDepositoryInstitution.receiverABANumber", fedMsg.ReceiverDI.ReceiverABANumber); err != nil {
+		return nil, common.NewValidationError("pacs.008.001.08", err)
+	}
+
 	// Address Validation
-	if err := fedMsg.Originator.Personal.Address.ValidateAddress(); err != nil {
-		return nil, fmt.Errorf("invalid originator address: %w", err)
+	if err := fedMsg.Originator.Personal.Address.Validate(msgConfig.AddressPolicy); err != nil {
+		return nil, common.NewValidationError("pacs.008.001.08", fmt.Errorf("invalid originator address: %w", err))
 	}
-	if err := fedMsg.Beneficiary.Personal.Address.ValidateAddress(); err != nil {
-		return nil, fmt.Errorf("invalid beneficiary address: %w", err)
+	if err := fedMsg.Beneficiary.Personal.Address.Validate(msgConfig.AddressPolicy); err != nil {
+		return nil, common.NewValidationError("pacs.008.001.08", fmt.Errorf("invalid beneficiary address: %w", err))
 	}
 
 	// Amount Validation
-	amountFloat, err := fedMsg.Amount.Text.Float64()
-	if err != nil {
-		return nil, fmt.Errorf("invalid amount format: %w", err)
+	if err := fedMsg.Amount.ValidateFedNow("fedNowMessage.amount"); err != nil {
+		return nil, common.NewValidationError("pacs.008.001.08", err)
+	}
+	if err := msgConfig.Limits.CheckCreditTransfer(fedMsg.Amount.Text, fedMsg.CustomerType); err != nil {
+		return nil, common.NewValidationError("pacs.008.001.08", err)
 	}
 
 	// Building the Pacs008 Struct
+	clearingSystemId := string(msgConfig.ClearingSystemId)
 	pacsDoc := &pacs_008_001_08.Document{
 		XMLName: xml.Name{Space: "urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08", Local: "Document"},
 		FIToFICstmrCdtTrf: pacs_008_001_08.FIToFICustomerCreditTransferV08{
@@ -56,8 +70,8 @@ func BuildPacs008Struct(message FedNowMessageCCT, msgConfig *config.Config) (*pa
 			CdtTrfTxInf: []pacs_008_001_08.CreditTransferTransaction39{
 				{
 					PmtId: pacs_008_001_08.PaymentIdentification7{
-						InstrId:    fedMsg.Identifier.InstructionID,
-						EndToEndId: fedMsg.Identifier.EndToEndID,
+						InstrId:    (*pacs_008_001_08.Max35Text)(fedMsg.Identifier.InstructionID),
+						EndToEndId: pacs_008_001_08.Max35Text(fedMsg.Identifier.EndToEndID),
 					},
 					PmtTpInf: &pacs_008_001_08.PaymentTypeInformation28{
 						LclInstrm: &msgConfig.LocalInstrument,
@@ -65,100 +79,28 @@ func BuildPacs008Struct(message FedNowMessageCCT, msgConfig *config.Config) (*pa
 							Prtry: &categoryPurpose,
 						},
 					},
-					IntrBkSttlmAmt: pacs_008_001_08.ActiveCurrencyAndAmount{
-						Ccy:  fedMsg.Amount.Ccy,
-						Text: fmt.Sprintf("%.2f", amountFloat),
-					},
-					IntrBkSttlmDt: (*common.ISODate)(&fedMsg.CreationDateTime),
-					ChrgBr:        msgConfig.ChargeBearer,
-					InstgAgt: &pacs_008_001_08.BranchAndFinancialInstitutionIdentification6{
-						FinInstnId: pacs_008_001_08.FinancialInstitutionIdentification18{
-							ClrSysMmbId: &pacs_008_001_08.ClearingSystemMemberIdentification2{
-								MmbId: fedMsg.SenderDI.SenderABANumber,
-								ClrSysId: &pacs_008_001_08.ClearingSystemIdentification2Choice{
-									Cd: &clearingSystemId,
-								},
-							},
-						},
-					},
-					InstdAgt: &pacs_008_001_08.BranchAndFinancialInstitutionIdentification6{
-						FinInstnId: pacs_008_001_08.FinancialInstitutionIdentification18{
-							ClrSysMmbId: &pacs_008_001_08.ClearingSystemMemberIdentification2{
-								MmbId: fedMsg.ReceiverDI.ReceiverABANumber,
-								ClrSysId: &pacs_008_001_08.ClearingSystemIdentification2Choice{
-									Cd: &clearingSystemId,
-								},
-							},
-						},
-					},
-					Dbtr: pacs_008_001_08.PartyIdentification135{
-						Nm: fedMsg.Originator.Personal.Name,
-						PstlAdr: &pacs_008_001_08.PostalAddress24{
-							StrtNm:      fedMsg.Originator.Personal.Address.StreetName,
-							BldgNb:      fedMsg.Originator.Personal.Address.BuildingNumber,
-							TwnNm:       fedMsg.Originator.Personal.Address.TownName,
-							CtrySubDvsn: fedMsg.Originator.Personal.Address.CountrySubdivision,
-							PstCd:       fedMsg.Originator.Personal.Address.PostalCode,
-							Ctry:        fedMsg.Originator.Personal.Address.Country,
-						},
-					},
-					DbtrAcct: &pacs_008_001_08.CashAccount38{
-						Id: pacs_008_001_08.AccountIdentification4Choice{
-							Othr: &pacs_008_001_08.GenericAccountIdentification1{
-								Id: fedMsg.Originator.Personal.Identifier,
-							},
-						},
-					},
-					DbtrAgt: pacs_008_001_08.BranchAndFinancialInstitutionIdentification6{
-						FinInstnId: pacs_008_001_08.FinancialInstitutionIdentification18{
-							ClrSysMmbId: &pacs_008_001_08.ClearingSystemMemberIdentification2{
-								MmbId: fedMsg.SenderDI.SenderABANumber,
-								ClrSysId: &pacs_008_001_08.ClearingSystemIdentification2Choice{
-									Cd: &clearingSystemId,
-								},
-							},
-							Nm: fedMsg.SenderDI.Name,
-						},
-					},
-					CdtrAgt: pacs_008_001_08.BranchAndFinancialInstitutionIdentification6{
-						FinInstnId: pacs_008_001_08.FinancialInstitutionIdentification18{
-							ClrSysMmbId: &pacs_008_001_08.ClearingSystemMemberIdentification2{
-								MmbId: fedMsg.ReceiverDI.ReceiverABANumber,
-								ClrSysId: &pacs_008_001_08.ClearingSystemIdentification2Choice{
-									Cd: &clearingSystemId,
-								},
-							},
-						},
-					},
-					Cdtr: pacs_008_001_08.PartyIdentification135{
-						Nm: fedMsg.Beneficiary.Personal.Name,
-						PstlAdr: &pacs_008_001_08.PostalAddress24{
-							StrtNm:      fedMsg.Beneficiary.Personal.Address.StreetName,
-							BldgNb:      fedMsg.Beneficiary.Personal.Address.BuildingNumber,
-							TwnNm:       fedMsg.Beneficiary.Personal.Address.TownName,
-							CtrySubDvsn: fedMsg.Beneficiary.Personal.Address.CountrySubdivision,
-							PstCd:       fedMsg.Beneficiary.Personal.Address.PostalCode,
-							Ctry:        fedMsg.Beneficiary.Personal.Address.Country,
-						},
-					},
-					CdtrAcct: &pacs_008_001_08.CashAccount38{
-						Id: pacs_008_001_08.AccountIdentification4Choice{
-							Othr: &pacs_008_001_08.GenericAccountIdentification1{
-								Id: fedMsg.Beneficiary.Personal.Identifier,
-							},
-						},
-					},
+					IntrBkSttlmAmt: payment.AmountToPacs008(fedMsg.Amount),
+					IntrBkSttlmDt:  (*common.ISODate)(&fedMsg.CreationDateTime),
+					ChrgBr:         msgConfig.ChargeBearer,
+					InstgAgt:       payment.AgentToPacs008(fedMsg.SenderDI.SenderABANumber, nil, clearingSystemId),
+					InstdAgt:       payment.AgentToPacs008(fedMsg.ReceiverDI.ReceiverABANumber, nil, clearingSystemId),
+					Dbtr:           payment.PartyToPacs008(fedMsg.Originator),
+					DbtrAcct:       payment.AccountToPacs008(fedMsg.Originator.Personal.Identifier),
+					DbtrAgt:        *payment.AgentToPacs008(fedMsg.SenderDI.SenderABANumber, fedMsg.SenderDI.Name, clearingSystemId),
+					CdtrAgt:        *payment.AgentToPacs008(fedMsg.ReceiverDI.ReceiverABANumber, nil, clearingSystemId),
+					Cdtr:           payment.PartyToPacs008(fedMsg.Beneficiary),
+					CdtrAcct:       payment.AccountToPacs008(fedMsg.Beneficiary.Personal.Identifier),
 				},
 			},
 		},
 	}
 
 	if fedMsg.Identifier.UETR != nil {
-		pacsDoc.FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.UETR = fedMsg.Identifier.UETR
+		pacsDoc.FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.UETR = (*pacs_008_001_08.UUIDv4Identifier)(fedMsg.Identifier.UETR)
 	}
 
 	if fedMsg.Identifier.TransactionID != nil && *fedMsg.Identifier.TransactionID != "" {
-		pacsDoc.FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.TxId = fedMsg.Identifier.TransactionID
+		pacsDoc.FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.TxId = (*pacs_008_001_08.Max35Text)(fedMsg.Identifier.TransactionID)
 	}
 	return pacsDoc, nil
 
@@ -168,7 +110,7 @@ func BuildPacs008(payload []byte, config *config.Config) (*pacs_008_001_08.Docum
 
 	var message FedNowMessageCCT
 	if err := json.Unmarshal(payload, &message); err != nil {
-		return nil, err
+		return nil, common.NewJSONDecodeError("pacs.008.001.08", payload, err)
 	}
 
 	return BuildPacs008Struct(message, config)
@@ -177,66 +119,55 @@ func BuildPacs008(payload []byte, config *config.Config) (*pacs_008_001_08.Docum
 func ParsePacs008(appHdr head.BusinessApplicationHeaderV02, document pacs_008_001_08.Document) (*FedNowMessageCCT, error) {
 
 	fitoficstmrcdttrf := document.FIToFICstmrCdtTrf
+	if len(fitoficstmrcdttrf.CdtTrfTxInf) == 0 {
+		return nil, &common.FieldError{Path: "FIToFICstmrCdtTrf.CdtTrfTxInf", Reason: "is required"}
+	}
 	cdtrftxinf := fitoficstmrcdttrf.CdtTrfTxInf[0]
 
 	categoryPurpose := resolveCategoryPurpose(cdtrftxinf.PmtTpInf)
-	originatorAddress := convertPostalAddress(cdtrftxinf.Dbtr.PstlAdr)
-	beneficiaryAddress := convertPostalAddress(cdtrftxinf.Cdtr.PstlAdr)
-	originatorIdentifier := extractAccountIdentifier(cdtrftxinf.DbtrAcct)
-	beneficiaryIdentifier := extractAccountIdentifier(cdtrftxinf.CdtrAcct)
-	senderABANumber := extractClrSysMemberIDFromAgent(cdtrftxinf.InstgAgt)
+	senderABANumber := payment.MemberIDFromPacs008Agent(cdtrftxinf.InstgAgt)
 	if senderABANumber == "" {
-		senderABANumber = extractClrSysMemberID(appHdr.To)
+		senderABANumber = payment.MemberIDFromHead(appHdr.To)
 	}
-	receiverABANumber := extractClrSysMemberIDFromAgent(cdtrftxinf.InstdAgt)
+	receiverABANumber := payment.MemberIDFromPacs008Agent(cdtrftxinf.InstdAgt)
 	if receiverABANumber == "" {
-		receiverABANumber = extractClrSysMemberID(appHdr.Fr)
+		receiverABANumber = payment.MemberIDFromHead(appHdr.Fr)
+	}
+
+	amount, err := payment.AmountFromPacs008(cdtrftxinf.IntrBkSttlmAmt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IntrBkSttlmAmt: %w", err)
 	}
 
-	var uetr pacs_008_001_08.UUIDv4Identifier
+	var uetr string
 	if cdtrftxinf.PmtId.UETR != nil {
-		uetr = pacs_008_001_08.UUIDv4Identifier(*cdtrftxinf.PmtId.UETR)
+		uetr = string(*cdtrftxinf.PmtId.UETR)
 	}
 
 	fednowMsg := FedNowMessageCCT{
 		FedNowMsg: FedNowDetails{
 			CreationDateTime: common.ISODateTime(fitoficstmrcdttrf.GrpHdr.CreDtTm),
 			Identifier: FedNowIdentifier{
-				BusinessMessageID: pacs_008_001_08.Max35Text(appHdr.BizMsgIdr),
-				MessageID:         pacs_008_001_08.Max35Text(fitoficstmrcdttrf.GrpHdr.MsgId),
-				InstructionID:     cdtrftxinf.PmtId.InstrId,
-				EndToEndID:        cdtrftxinf.PmtId.EndToEndId,
-				TransactionID:     cdtrftxinf.PmtId.TxId,
+				BusinessMessageID: string(appHdr.BizMsgIdr),
+				MessageID:         string(fitoficstmrcdttrf.GrpHdr.MsgId),
+				InstructionID:     (*string)(cdtrftxinf.PmtId.InstrId),
+				EndToEndID:        string(cdtrftxinf.PmtId.EndToEndId),
+				TransactionID:     (*string)(cdtrftxinf.PmtId.TxId),
 				CreationDateTime:  common.ISODateTime(appHdr.CreDt),
 				UETR:              &uetr,
-			},
+			}.WithHeader(appHdr),
 			PaymentType: FedNowPaymentType{
 				CategoryPurpose: categoryPurpose,
 			},
-			Amount: FedNowAmount{
-				Text: json.Number(cdtrftxinf.IntrBkSttlmAmt.Text),
-				Ccy:  cdtrftxinf.IntrBkSttlmAmt.Ccy,
-			},
+			Amount: amount,
 			SenderDI: FedNowDepositoryInstitution{
 				SenderABANumber: senderABANumber,
 			},
 			ReceiverDI: FedNowDepositoryInstitution{
 				ReceiverABANumber: receiverABANumber,
 			},
-			Originator: FedNowParty{
-				Personal: FedNowPersonal{
-					Name:       cdtrftxinf.Dbtr.Nm,
-					Address:    originatorAddress,
-					Identifier: originatorIdentifier,
-				},
-			},
-			Beneficiary: FedNowParty{
-				Personal: FedNowPersonal{
-					Name:       cdtrftxinf.Cdtr.Nm,
-					Address:    beneficiaryAddress,
-					Identifier: beneficiaryIdentifier,
-				},
-			},
+			Originator:  payment.PartyFromPacs008(cdtrftxinf.Dbtr, cdtrftxinf.DbtrAcct),
+			Beneficiary: payment.PartyFromPacs008(cdtrftxinf.Cdtr, cdtrftxinf.CdtrAcct),
 		},
 	}
 
@@ -259,53 +190,3 @@ func resolveCategoryPurpose(pmtType *pacs_008_001_08.PaymentTypeInformation28) *
 
 	return nil
 }
-
-func convertPostalAddress(addr *pacs_008_001_08.PostalAddress24) FedNowPstlAdr {
-	if addr == nil {
-		return FedNowPstlAdr{}
-	}
-
-	return FedNowPstlAdr{
-		StreetName:         addr.StrtNm,
-		BuildingNumber:     addr.BldgNb,
-		PostBox:            addr.PstBx,
-		TownName:           addr.TwnNm,
-		CountrySubdivision: addr.CtrySubDvsn,
-		PostalCode:         addr.PstCd,
-		Country:            addr.Ctry,
-	}
-}
-
-func extractAccountIdentifier(acct *pacs_008_001_08.CashAccount38) pacs_008_001_08.Max34Text {
-	if acct == nil {
-		return ""
-	}
-
-	if acct.Id.Othr != nil {
-		return acct.Id.Othr.Id
-	}
-
-	if acct.Id.IBAN != nil {
-		return pacs_008_001_08.Max34Text(*acct.Id.IBAN)
-	}
-
-	return ""
-}
-
-func extractClrSysMemberID(party head.Party44Choice) pacs_008_001_08.Max35Text {
-	if party.FIId == nil || party.FIId.FinInstnId.ClrSysMmbId == nil {
-		return ""
-	}
-
-	return pacs_008_001_08.Max35Text(party.FIId.FinInstnId.ClrSysMmbId.MmbId)
-}
-
-func extractClrSysMemberIDFromAgent(agent *pacs_008_001_08.BranchAndFinancialInstitutionIdentification6) pacs_008_001_08.Max35Text {
-	if agent == nil {
-		return ""
-	}
-	if agent.FinInstnId.ClrSysMmbId == nil {
-		return ""
-	}
-	return agent.FinInstnId.ClrSysMmbId.MmbId
-}
@@ -1,12 +1,9 @@
 package pain
 
 import (
-	"encoding/json"
-	"fmt"
-	"strings"
-
 	"github.com/mbanq/iso20022-go/ISO20022/pain_013_001_07"
 	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/payment"
 )
 
 type FedNowMessageRFP struct {
@@ -25,17 +22,13 @@ type FedNowDetails struct {
 	ReceiverDI       FedNowDepositoryInstitution `json:"receiverDepositoryInstitution"`
 	Originator       FedNowParty                 `json:"originator"`
 	Beneficiary      FedNowParty                 `json:"beneficiary"`
+	// CustomerType is not transmitted. It selects the per-customer-type
+	// limit of config.Limits, e.g. "retail".
+	CustomerType string `json:"customerType,omitempty"`
 }
 
-type FedNowIdentifier struct {
-	BusinessMessageID pain_013_001_07.Max35Text `json:"businessMessageId"`
-	MessageID         pain_013_001_07.Max35Text `json:"messageId"`
-	MessageType       pain_013_001_07.Max35Text `json:"messageType"`
-	InstructionID     pain_013_001_07.Max35Text `json:"instructionId"`
-	TransactionID     pain_013_001_07.Max35Text `json:"transactionId"`
-	UETR              pain_013_001_07.Max35Text `json:"uetr"`
-	EndToEndID        pain_013_001_07.Max35Text `json:"endToEndId"`
-}
+// FedNowIdentifier is the shared message identifier.
+type FedNowIdentifier = payment.Identifier
 
 type FedNowPaymentType struct {
 	CategoryPurpose pain_013_001_07.Max35Text `json:"categoryPurpose"`
@@ -48,58 +41,17 @@ type FedNowExecutionInfo struct {
 	ExpiryDate             common.ISODateTime          `json:"expiryDate"`
 }
 
-type FedNowAmount struct {
-	Text json.Number                                  `json:"amount"`
-	Ccy  pain_013_001_07.ActiveOrHistoricCurrencyCode `json:"currency"`
-}
+// FedNowAmount is the shared currency amount.
+type FedNowAmount = payment.Amount
 
-type FedNowDepositoryInstitution struct {
-	SenderABANumber   pain_013_001_07.Max35Text   `json:"senderABANumber,omitempty"`
-	ReceiverABANumber pain_013_001_07.Max35Text   `json:"receiverABANumber,omitempty"`
-	Name              *pain_013_001_07.Max140Text `json:"senderShortName"`
-}
+// FedNowDepositoryInstitution is the shared participant identification.
+type FedNowDepositoryInstitution = payment.DepositoryInstitution
 
-type FedNowParty struct {
-	Personal FedNowPersonal `json:"personal"`
-}
+// FedNowParty is the shared debtor/creditor party.
+type FedNowParty = payment.Party
 
-type FedNowPersonal struct {
-	Name       *pain_013_001_07.Max140Text `json:"name"`
-	Address    FedNowPstlAdr               `json:"postalAddress"`
-	Identifier pain_013_001_07.Max34Text   `json:"identifier"`
-}
+// FedNowPersonal is the shared party detail.
+type FedNowPersonal = payment.Personal
 
-type FedNowPstlAdr struct {
-	StreetName         *pain_013_001_07.Max70Text   `json:"StreetName"`
-	BuildingNumber     *pain_013_001_07.Max16Text   `json:"BuildingNumber"`
-	PostBox            *pain_013_001_07.Max16Text   `json:"PostalBox"`
-	TownName           *pain_013_001_07.Max35Text   `json:"TownName"`
-	CountrySubdivision *pain_013_001_07.Max35Text   `json:"CountrySubDivision"`
-	PostalCode         *pain_013_001_07.Max16Text   `json:"PostalCode"`
-	Country            *pain_013_001_07.CountryCode `json:"Country"`
-}
-
-func (address FedNowPstlAdr) ValidateAddress() error {
-	var missingFields []string
-	if address.StreetName == nil || *address.StreetName == "" {
-		missingFields = append(missingFields, "StreetName")
-	}
-	if address.TownName == nil || *address.TownName == "" {
-		missingFields = append(missingFields, "TownName")
-	}
-	if address.CountrySubdivision == nil || *address.CountrySubdivision == "" {
-		missingFields = append(missingFields, "CountrySubdivision")
-	}
-	if address.PostalCode == nil || *address.PostalCode == "" {
-		missingFields = append(missingFields, "PostalCode")
-	}
-	if address.Country == nil || *address.Country == "" {
-		missingFields = append(missingFields, "Country")
-	}
-
-	if len(missingFields) > 0 {
-		return fmt.Errorf("missing required address fields: %s", strings.Join(missingFields, ", "))
-	}
-
-	return nil
-}
+// FedNowPstlAdr is the shared ISO PostalAddress24 representation.
+type FedNowPstlAdr = payment.PostalAddress
@@ -3,26 +3,21 @@ package fednow
 import (
 	"bytes"
 	"encoding/xml"
-	"errors"
+	"fmt"
 	"io"
-	"strings"
 
-	admi002 "github.com/mbanq/iso20022-go/ISO20022/admi_002_001_01"
-	admi007 "github.com/mbanq/iso20022-go/ISO20022/admi_007_001_01"
-	camt029 "github.com/mbanq/iso20022-go/ISO20022/camt_029_001_09"
-	camt056 "github.com/mbanq/iso20022-go/ISO20022/camt_056_001_08"
 	head "github.com/mbanq/iso20022-go/ISO20022/head_001_001_02"
-	pacs002 "github.com/mbanq/iso20022-go/ISO20022/pacs_002_001_10"
-	pacs008 "github.com/mbanq/iso20022-go/ISO20022/pacs_008_001_08"
-	pain013 "github.com/mbanq/iso20022-go/ISO20022/pain_013_001_07"
-	"github.com/mbanq/iso20022-go/pkg/fednow/admi"
-	"github.com/mbanq/iso20022-go/pkg/fednow/camt"
-	"github.com/mbanq/iso20022-go/pkg/fednow/pacs"
-	"github.com/mbanq/iso20022-go/pkg/fednow/pain"
+	"github.com/mbanq/iso20022-go/pkg/payment"
 )
 
-// Parse an incoming pacs.008 XML file and return a JSON representation.
-func Parse(xmlData []byte) (FedNowMessage, error) {
+// Parse decodes an inbound FedNow message with the parser registered for the
+// MsgDefIdr of its AppHdr. The PssblDplct and CpyDplct of the AppHdr are
+// returned in the identifier of the message. With WithDuplicateStore, a
+// message already received is returned together with a *DuplicateError, so
+// that the caller can skip processing it but still answer it. With
+// WithXSDValidation, a message violating the ISO schemas is rejected with a
+// *ValidationError before it is decoded.
+func Parse(xmlData []byte, opts ...Option) (FedNowMessage, error) {
 	decoder := xml.NewDecoder(bytes.NewReader(xmlData))
 	var appHdr head.BusinessApplicationHeaderV02
 	foundAppHdr := false
@@ -34,12 +29,12 @@ func Parse(xmlData []byte) (FedNowMessage, error) {
 			if err == io.EOF {
 				break
 			}
-			return nil, err
+			return nil, decodeError(decoder, "", "", err)
 		}
 
 		if se, ok := token.(xml.StartElement); ok && se.Name.Local == "AppHdr" {
 			if err := decoder.DecodeElement(&appHdr, &se); err != nil {
-				return nil, err
+				return nil, decodeError(decoder, "", "AppHdr", err)
 			}
 			foundAppHdr = true
 			break // Stop after finding AppHdr
@@ -47,66 +42,73 @@ func Parse(xmlData []byte) (FedNowMessage, error) {
 	}
 
 	if !foundAppHdr {
-		return nil, errors.New("failed to find AppHdr in XML")
+		return nil, &EnvelopeError{Element: "AppHdr"}
 	}
 
-	// Now, decode the Document based on the message type from AppHdr
-	var fednowMsg FedNowMessage
-	var err error
-
+	// Now, decode the Document with the parser registered for the message
+	// type from AppHdr
 	msgType := string(appHdr.MsgDefIdr)
+	registered, ok := lookupInbound(msgType)
+	if !ok {
+		return nil, &UnsupportedMessageError{MessageType: msgType}
+	}
 
-	switch {
-	case strings.Contains(msgType, "pacs.008.001.08"):
-		var doc pacs008.Document
-		if err = decoder.Decode(&doc); err != nil {
-			return nil, err
-		}
-		fednowMsg, err = pacs.ParsePacs008(appHdr, doc)
-	case strings.Contains(msgType, "pacs.002.001.10"):
-		var doc pacs002.Document
-		if err = decoder.Decode(&doc); err != nil {
-			return nil, err
-		}
-		fednowMsg, err = pacs.ParsePacs002(appHdr, doc)
-	case strings.Contains(msgType, "admi.002.001.01"):
-		var doc admi002.Document
-		if err = decoder.Decode(&doc); err != nil {
-			return nil, err
-		}
-		fednowMsg, err = admi.ParseAdmi002Struct(&doc, appHdr)
-	case strings.Contains(msgType, "admi.007.001.01"):
-		var doc admi007.Document
-		if err = decoder.Decode(&doc); err != nil {
-			return nil, err
+	o := newOptions(opts)
+	if err := o.validateXSD(msgType, xmlData); err != nil {
+		return nil, err
+	}
+	var documentErr error
+	decode := func(document any) error {
+		start := decoder.InputOffset()
+		documentErr = decodeDocument(decoder, msgType, document)
+		if documentErr == nil && o.rawDocument != nil {
+			*o.rawDocument = bytes.TrimSpace(xmlData[start:decoder.InputOffset()])
 		}
-		fednowMsg, err = admi.ParseAdmi007Struct(&doc, appHdr)
-	case strings.Contains(msgType, "pain.013.001.07"):
-		var doc pain013.Document
-		if err = decoder.Decode(&doc); err != nil {
-			return nil, err
+		return documentErr
+	}
+	fednowMsg, err := registered.Parse(appHdr, decode)
+	if err != nil {
+		if documentErr != nil {
+			return nil, documentErr
 		}
-		fednowMsg, err = pain.ParsePain013(appHdr, doc)
-	case strings.Contains(msgType, "camt.056.001.08"):
-		var doc camt056.Document
-		if err = decoder.Decode(&doc); err != nil {
-			return nil, err
+		return nil, decodeError(decoder, msgType, "Document", err)
+	}
+
+	if store := o.duplicates; store != nil {
+		from := payment.MemberIDFromHead(appHdr.Fr)
+		seen, err := store.Seen(from, string(appHdr.BizMsgIdr))
+		if err != nil {
+			return nil, fmt.Errorf("duplicate store: %w", err)
 		}
-		fednowMsg, err = camt.ParseCamt056(appHdr, doc)
-	case strings.Contains(msgType, "camt.029.001.09"):
-		var doc camt029.Document
-		if err = decoder.Decode(&doc); err != nil {
-			return nil, err
+		if seen {
+			related := payment.RelatedTo(appHdr)
+			return fednowMsg, &DuplicateError{
+				MessageType:       msgType,
+				From:              from,
+				BusinessMessageID: related.BusinessMessageID,
+				PossibleDuplicate: related.PossibleDuplicate,
+				CopyDuplicate:     related.CopyDuplicate,
+			}
 		}
-		fednowMsg, err = camt.ParseCamt029(appHdr, doc)
-	default:
-		return nil, errors.New("unsupported message type: " + msgType)
 	}
 
+	return fednowMsg, nil
+}
+
+// decodeDocument decodes the Document following the AppHdr into doc.
+func decodeDocument(decoder *xml.Decoder, msgType string, doc any) error {
+	err := decoder.Decode(doc)
+	if err == io.EOF {
+		return &EnvelopeError{MessageType: msgType, Element: "Document"}
+	}
 	if err != nil {
-		return nil, err
+		return decodeError(decoder, msgType, "Document", err)
 	}
+	return nil
+}
 
-	// Marshal the struct to JSON
-	return fednowMsg, nil
+// decodeError wraps err in a *DecodeError at the current decoder position.
+func decodeError(decoder *xml.Decoder, msgType, element string, err error) error {
+	line, column := decoder.InputPos()
+	return &DecodeError{Format: "xml", MessageType: msgType, Element: element, Line: line, Column: column, Err: err}
 }
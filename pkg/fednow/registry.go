@@ -0,0 +1,221 @@
+package fednow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	admi002 "github.com/mbanq/iso20022-go/ISO20022/admi_002_001_01"
+	admi007 "github.com/mbanq/iso20022-go/ISO20022/admi_007_001_01"
+	head "github.com/mbanq/iso20022-go/ISO20022/head_001_001_02"
+	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow/admi"
+	"github.com/mbanq/iso20022-go/pkg/fednow/camt"
+	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+	"github.com/mbanq/iso20022-go/pkg/fednow/pacs"
+	"github.com/mbanq/iso20022-go/pkg/fednow/pain"
+)
+
+// isoNamespace prefixes the message type in the namespace of an ISO 20022
+// Document.
+const isoNamespace = "urn:iso:std:iso:20022:tech:xsd:"
+
+// BuildFunc builds the AppHdr and the ISO Document of an outbound message.
+// The Document is marshalled as the <Document> element of the envelope. opts
+// are those passed to Generate.
+type BuildFunc func(messageType string, cfg *config.Config, message FedNowMessage, opts ...Option) (*head.BusinessApplicationHeaderV02, any, error)
+
+// ParseFunc converts an inbound message. decode decodes the Document that
+// follows appHdr into a pointer to the ISO Document type.
+type ParseFunc func(appHdr head.BusinessApplicationHeaderV02, decode func(document any) error) (FedNowMessage, error)
+
+// Message describes a message type supported by Generate, Parse and
+// Validate.
+type Message struct {
+	// MessageType is the MsgDefIdr, e.g. "pacs.008.001.08".
+	MessageType string
+	// Namespace of the Document. Defaults to the ISO 20022 namespace of
+	// MessageType.
+	Namespace string
+	// Payload is a zero value of the custom payload type, used to unmarshal
+	// and validate JSON payloads.
+	Payload FedNowMessage
+	// Wrapper optionally selects the FedNow envelope wrapper element when
+	// the XSD has several for MessageType. A payload implementing
+	// WrapperPreferrer takes precedence.
+	Wrapper string
+	// Build is used by Generate; nil for inbound-only messages.
+	Build BuildFunc
+	// Parse is used by Parse; nil for outbound-only messages.
+	Parse ParseFunc
+}
+
+// Unmarshal decodes a JSON payload into a new value of the Payload type.
+func (m Message) Unmarshal(data []byte) (FedNowMessage, error) {
+	if m.Payload == nil {
+		return nil, &UnsupportedMessageError{MessageType: m.MessageType}
+	}
+	ptr := reflect.New(reflect.TypeOf(m.Payload))
+	if err := json.Unmarshal(data, ptr.Interface()); err != nil {
+		return nil, common.NewJSONDecodeError(m.MessageType, data, err)
+	}
+	return ptr.Elem().Interface().(FedNowMessage), nil
+}
+
+var (
+	registry   = make(map[string]Message)
+	registryMu sync.RWMutex
+)
+
+// Register adds a message type to the registry, replacing any existing
+// registration of the same type, so that a bank-specific builder or parser
+// can override a built-in one.
+func Register(m Message) error {
+	if m.MessageType == "" {
+		return errors.New("register: missing message type")
+	}
+	if m.Build == nil && m.Parse == nil {
+		return fmt.Errorf("register %s: a Build or Parse function is required", m.MessageType)
+	}
+	if m.Build != nil && m.Payload == nil {
+		return fmt.Errorf("register %s: a Payload type is required with Build", m.MessageType)
+	}
+	if m.Namespace == "" {
+		m.Namespace = isoNamespace + m.MessageType
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[m.MessageType] = m
+	return nil
+}
+
+// Lookup returns the registration of messageType.
+func Lookup(messageType string) (Message, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	m, ok := registry[messageType]
+	return m, ok
+}
+
+// Registered returns every registered message, ordered by message type.
+func Registered() []Message {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	messages := make([]Message, 0, len(registry))
+	for _, m := range registry {
+		messages = append(messages, m)
+	}
+	sort.Slice(messages, func(i, j int) bool { return messages[i].MessageType < messages[j].MessageType })
+	return messages
+}
+
+// lookupInbound returns the parser registered for a MsgDefIdr, which may
+// carry a suffix such as a FedNow usage identifier.
+func lookupInbound(msgDefIdr string) (Message, bool) {
+	if m, ok := Lookup(msgDefIdr); ok && m.Parse != nil {
+		return m, true
+	}
+	for _, m := range Registered() {
+		if m.Parse != nil && strings.Contains(msgDefIdr, m.MessageType) {
+			return m, true
+		}
+	}
+	return Message{}, false
+}
+
+// BuildWith adapts a GenerateXxx style function to a BuildFunc.
+func BuildWith[M FedNowMessage, D any](generate func(string, *config.Config, M, ...Option) (*head.BusinessApplicationHeaderV02, *D, error)) BuildFunc {
+	return func(messageType string, cfg *config.Config, message FedNowMessage, opts ...Option) (*head.BusinessApplicationHeaderV02, any, error) {
+		msg, ok := message.(M)
+		if !ok {
+			return nil, nil, &UnsupportedMessageError{MessageType: messageType, Got: fmt.Sprintf("%T", message)}
+		}
+		appHdr, document, err := generate(messageType, cfg, msg, opts...)
+		if err != nil {
+			return nil, nil, err
+		}
+		return appHdr, document, nil
+	}
+}
+
+// ParseWith adapts a ParseXxx style function, taking the AppHdr and the
+// decoded ISO Document, to a ParseFunc.
+func ParseWith[D any, M FedNowMessage](parse func(head.BusinessApplicationHeaderV02, D) (M, error)) ParseFunc {
+	return func(appHdr head.BusinessApplicationHeaderV02, decode func(document any) error) (FedNowMessage, error) {
+		var document D
+		if err := decode(&document); err != nil {
+			return nil, err
+		}
+		msg, err := parse(appHdr, document)
+		if err != nil {
+			return nil, err
+		}
+		return msg, nil
+	}
+}
+
+func init() {
+	for _, m := range []Message{
+		{
+			MessageType: "admi.002.001.01",
+			Payload:     admi.FedNowMessageADM{},
+			Build:       BuildWith(GenerateAdmi002),
+			Parse: ParseWith(func(appHdr head.BusinessApplicationHeaderV02, doc admi002.Document) (admi.FedNowMessageADM, error) {
+				return admi.ParseAdmi002Struct(&doc, appHdr)
+			}),
+		},
+		{
+			MessageType: "admi.007.001.01",
+			Payload:     admi.FedNowMessageRctAck{},
+			Build:       BuildWith(GenerateAdmi007),
+			Parse: ParseWith(func(appHdr head.BusinessApplicationHeaderV02, doc admi007.Document) (admi.FedNowMessageRctAck, error) {
+				return admi.ParseAdmi007Struct(&doc, appHdr)
+			}),
+		},
+		{
+			MessageType: "camt.029.001.09",
+			Payload:     camt.FedNowMessageCxlRsp{},
+			Build:       BuildWith(GenerateCamt029),
+			Parse:       ParseWith(camt.ParseCamt029),
+		},
+		{
+			MessageType: "camt.056.001.08",
+			Payload:     camt.FedNowMessageCxlReq{},
+			Build:       BuildWith(GenerateCamt056),
+			Parse:       ParseWith(camt.ParseCamt056),
+		},
+		{
+			MessageType: "pacs.002.001.10",
+			Payload:     pacs.FedNowMessageACK{},
+			Build:       BuildWith(GeneratePacs002),
+			Parse:       ParseWith(pacs.ParsePacs002),
+		},
+		{
+			MessageType: "pacs.004.001.10",
+			Payload:     pacs.FedNowMessageRtn{},
+			Build:       BuildWith(GeneratePacs004),
+			Parse:       ParseWith(pacs.ParsePacs004),
+		},
+		{
+			MessageType: "pacs.008.001.08",
+			Payload:     pacs.FedNowMessageCCT{},
+			Build:       BuildWith(GeneratePacs008),
+			Parse:       ParseWith(pacs.ParsePacs008),
+		},
+		{
+			MessageType: "pain.013.001.07",
+			Payload:     pain.FedNowMessageRFP{},
+			Build:       BuildWith(GeneratePain013),
+			Parse:       ParseWith(pain.ParsePain013),
+		},
+	} {
+		if err := Register(m); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package fednow
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+
+	admi002 "github.com/mbanq/iso20022-go/ISO20022/admi_002_001_01"
+	pacs002 "github.com/mbanq/iso20022-go/ISO20022/pacs_002_001_10"
+	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow/admi"
+	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+)
+
+// UnknownReference is the admi.002 related reference used when the BizMsgIdr
+// of the rejected message cannot be read.
+const UnknownReference = "NOTAVAILABLE"
+
+// maxReasonText is the length of the Max350Text ErrLctn and RsnDesc elements.
+const maxReasonText = 350
+
+// NewRejection returns an admi.002 rejecting inbound, the raw message that
+// Parse (or a later validation step) failed on with cause. The rejection
+// references the BizMsgIdr of inbound, or UnknownReference when the AppHdr is
+// unreadable, and carries a reason code derived from cause:
+//
+//   - FF02 for XML that cannot be decoded, a missing AppHdr or Document, or an
+//     unsupported message type
+//   - AM02 for an amount above a configured limit
+//   - AM05 for a message already received, reported by a DuplicateStore
+//   - AM12, RC02 or FF08 for a field error on an amount, a routing number or
+//     the end-to-end ID
+//   - FF02 for any other validation failure, and NARR otherwise
+//
+// messageID identifies the admi.002 itself, and opts set its clock. The result
+// can be adjusted before it is passed to Generate, or use Reject to do both.
+func NewRejection(messageID string, inbound []byte, cause error, opts ...Option) admi.FedNowMessageADM {
+	now := newOptions(opts).creationDateTime(common.ISODateTime{})
+	code, location := rejectionReason(cause)
+
+	reason := admi.RejectionReason{
+		RejectionReason:   admi002.Max35Text(code),
+		RejectionDateTime: &now,
+	}
+	if location != "" {
+		errLctn := admi002.Max350Text(truncate(location, maxReasonText))
+		reason.ErrorLocation = &errLctn
+	}
+	if cause != nil {
+		rsnDesc := admi002.Max350Text(truncate(cause.Error(), maxReasonText))
+		reason.Description = &rsnDesc
+	}
+
+	reference := originalBizMsgIdr(inbound)
+	if reference == "" {
+		reference = UnknownReference
+	}
+
+	return admi.FedNowMessageADM{
+		FedNowMsg: admi.FedNowADM{
+			CreationDateTime: now,
+			Identifier: admi.FedNowIdentifier{
+				BusinessMessageID: messageID,
+				MessageID:         messageID,
+				MessageType:       "admi.002.001.01",
+			},
+			Reference: admi002.Max35Text(truncate(reference, 35)),
+			Reason:    reason,
+		},
+	}
+}
+
+// Reject returns a ready-to-send admi.002 envelope rejecting inbound. See
+// NewRejection for the reference and reason code.
+func Reject(xsdPath string, cfg *config.Config, messageID string, inbound []byte, cause error, opts ...Option) ([]byte, error) {
+	return Generate(xsdPath, "admi.002.001.01", cfg, NewRejection(messageID, inbound, cause, opts...), opts...)
+}
+
+// rejectionReason maps cause to an ISO status reason code and, when known,
+// the location of the error in the rejected message.
+func rejectionReason(cause error) (code pacs002.ExternalStatusReason1Code, location string) {
+	var (
+		decodeErr      *DecodeError
+		envelopeErr    *EnvelopeError
+		unsupportedErr *UnsupportedMessageError
+		duplicateErr   *DuplicateError
+		limitErr       *config.LimitError
+		fieldErr       *common.FieldError
+	)
+	switch {
+	case errors.As(cause, &decodeErr):
+		if decodeErr.Line > 0 {
+			location = fmt.Sprintf("line %d, column %d", decodeErr.Line, decodeErr.Column)
+		}
+		return pacs002.ExternalStatusReason1CodeFf02, location
+	case errors.As(cause, &envelopeErr):
+		return pacs002.ExternalStatusReason1CodeFf02, envelopeErr.Element
+	case errors.As(cause, &unsupportedErr):
+		return pacs002.ExternalStatusReason1CodeFf02, "AppHdr/MsgDefIdr"
+	case errors.As(cause, &duplicateErr):
+		return pacs002.ExternalStatusReason1CodeAm05, "AppHdr/BizMsgIdr"
+	case errors.As(cause, &limitErr):
+		return pacs002.ExternalStatusReason1CodeAm02, ""
+	case errors.As(cause, &fieldErr):
+		return fieldReason(fieldErr.Path), fieldErr.Path
+	case errors.Is(cause, ErrValidation):
+		return pacs002.ExternalStatusReason1CodeFf02, ""
+	}
+	return pacs002.ExternalStatusReason1CodeNarr, ""
+}
+
+// fieldReason maps the JSON path of a field error to a status reason code.
+func fieldReason(path string) pacs002.ExternalStatusReason1Code {
+	switch {
+	case strings.Contains(path, "ABANumber"), strings.Contains(path, "DepositoryInstitution"), path == "frbId", path == "ispId":
+		return pacs002.ExternalStatusReason1CodeRc02
+	case strings.Contains(strings.ToLower(path), "amount"):
+		return pacs002.ExternalStatusReason1CodeAm12
+	case strings.HasSuffix(path, "endToEndId"):
+		return pacs002.ExternalStatusReason1CodeFf08
+	}
+	return pacs002.ExternalStatusReason1CodeFf02
+}
+
+// originalBizMsgIdr reads the BizMsgIdr of the AppHdr of inbound, tolerating
+// malformed XML after it. It returns "" when none is found.
+func originalBizMsgIdr(inbound []byte) string {
+	decoder := xml.NewDecoder(bytes.NewReader(inbound))
+	decoder.Strict = false
+	inAppHdr := false
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return ""
+		}
+		switch se := token.(type) {
+		case xml.StartElement:
+			if se.Name.Local == "AppHdr" {
+				inAppHdr = true
+			} else if inAppHdr && se.Name.Local == "BizMsgIdr" {
+				var bizMsgIdr string
+				if err := decoder.DecodeElement(&bizMsgIdr, &se); err != nil {
+					return ""
+				}
+				return strings.TrimSpace(bizMsgIdr)
+			}
+		case xml.EndElement:
+			if se.Name.Local == "AppHdr" {
+				return ""
+			}
+		}
+	}
+}
+
+// truncate shortens s to at most max characters.
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
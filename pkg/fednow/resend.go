@@ -0,0 +1,122 @@
+package fednow
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"time"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+// Resend returns a previously generated message flagged as a possible
+// duplicate, for resending after a send timed out: the AppHdr gets
+// PssblDplct set to true and a CreDt from the clock (see WithClock), and
+// keeps its BizMsgIdr. Only those two AppHdr elements are edited; every other
+// byte of the message, including the Document, is copied unchanged.
+func Resend(originalXML []byte, opts ...Option) ([]byte, error) {
+	creDt := []byte(newOptions(opts).now().In(common.EstLocation).Format(time.RFC3339))
+	decoder := xml.NewDecoder(bytes.NewReader(originalXML))
+
+	var (
+		edits []edit
+		// level counts the open elements from the AppHdr down; 0 is outside.
+		level                int
+		child                xml.StartElement
+		childText            int64
+		childIndent          []byte
+		seenCreDt, seenDplct bool
+		// A missing PssblDplct is inserted after the last of the AppHdr
+		// elements that precede it, with the same indentation.
+		after  int64
+		indent []byte
+	)
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			return nil, &EnvelopeError{Element: "AppHdr"}
+		}
+		if err != nil {
+			return nil, decodeError(decoder, "", "", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if level == 0 {
+				if t.Name.Local == "AppHdr" {
+					level = 1
+				}
+				continue
+			}
+			level++
+			if level == 2 {
+				child, childText = t, decoder.InputOffset()
+				childIndent = precedingSpace(originalXML, offset)
+			}
+		case xml.EndElement:
+			if level == 0 {
+				continue
+			}
+			if level == 2 {
+				switch child.Name.Local {
+				case "CreDt":
+					seenCreDt = true
+					edits = append(edits, edit{childText, offset, creDt})
+				case "PssblDplct":
+					seenDplct = true
+					edits = append(edits, edit{childText, offset, []byte("true")})
+				}
+				switch child.Name.Local {
+				case "CreDt", "BizPrcgDt", "CpyDplct":
+					after, indent = decoder.InputOffset(), childIndent
+				}
+			}
+			level--
+			if level > 0 {
+				continue
+			}
+			if !seenCreDt {
+				return nil, &EnvelopeError{Element: "AppHdr CreDt"}
+			}
+			if !seenDplct {
+				name := "PssblDplct"
+				if child.Name.Space != "" {
+					name = child.Name.Space + ":" + name
+				}
+				element := append(append([]byte{}, indent...), "<"+name+">true</"+name+">"...)
+				edits = append(edits, edit{after, after, element})
+			}
+			return applyEdits(originalXML, edits), nil
+		}
+	}
+}
+
+// edit replaces the bytes from start to end with text.
+type edit struct {
+	start, end int64
+	text       []byte
+}
+
+// applyEdits applies edits, ordered by position, to data.
+func applyEdits(data []byte, edits []edit) []byte {
+	var buf bytes.Buffer
+	var copied int64
+	for _, e := range edits {
+		buf.Write(data[copied:e.start])
+		buf.Write(e.text)
+		copied = e.end
+	}
+	buf.Write(data[copied:])
+	return buf.Bytes()
+}
+
+// precedingSpace returns the whitespace between the element starting at
+// offset and the markup before it.
+func precedingSpace(data []byte, offset int64) []byte {
+	space := data[bytes.LastIndexByte(data[:offset], '>')+1 : offset]
+	if len(bytes.TrimSpace(space)) > 0 {
+		return nil
+	}
+	return space
+}
@@ -0,0 +1,146 @@
+// Package rules checks FedNow market-practice rules that the ISO schemas do
+// not express (USD only, amount limit, settlement through FDN, required
+// agents and UETR, ...) against the custom JSON payloads. Unlike the message
+// builders, which stop at the first problem, the engine runs every rule and
+// collects all violations with the JSON path of the offending field.
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+)
+
+// Violation is a single broken rule.
+type Violation struct {
+	RuleID  string `json:"ruleId"`
+	Path    string `json:"path"`
+	Value   string `json:"value,omitempty"`
+	Message string `json:"message"`
+}
+
+func (v Violation) String() string {
+	if v.Value == "" {
+		return fmt.Sprintf("%s: %s: %s", v.RuleID, v.Path, v.Message)
+	}
+	return fmt.Sprintf("%s: %s %q: %s", v.RuleID, v.Path, v.Value, v.Message)
+}
+
+// Violations is the result of a check. It implements error so that a
+// non-empty result can be returned as one.
+type Violations []Violation
+
+func (vs Violations) Error() string {
+	messages := make([]string, len(vs))
+	for i, v := range vs {
+		messages[i] = v.String()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Err returns vs as an error, or nil when there are no violations.
+func (vs Violations) Err() error {
+	if len(vs) == 0 {
+		return nil
+	}
+	return vs
+}
+
+// Report collects the violations found by one rule.
+type Report struct {
+	ruleID     string
+	violations []Violation
+}
+
+// Add records a violation at path.
+func (r *Report) Add(path, value, message string) {
+	r.violations = append(r.violations, Violation{RuleID: r.ruleID, Path: path, Value: value, Message: message})
+}
+
+// AddError records err, which is typically a *common.FieldError. Errors of
+// other types are recorded at path. A nil err is ignored.
+func (r *Report) AddError(path string, err error) {
+	if err == nil {
+		return
+	}
+	var fieldErr *common.FieldError
+	if errors.As(err, &fieldErr) {
+		r.Add(fieldErr.Path, fieldErr.Value, fieldErr.Reason)
+		return
+	}
+	r.Add(path, "", err.Error())
+}
+
+// Rule is a named check over one or more message types.
+type Rule struct {
+	ID          string
+	Description string
+	check       func(message any, cfg *config.Config, r *Report)
+}
+
+// New returns a rule that checks messages of type M, such as
+// pacs.FedNowMessageCCT; pointers to M are checked too. A rule over any
+// applies to every message.
+func New[M any](id, description string, check func(message M, cfg *config.Config, r *Report)) Rule {
+	return Rule{
+		ID:          id,
+		Description: description,
+		check: func(message any, cfg *config.Config, r *Report) {
+			switch m := message.(type) {
+			case M:
+				check(m, cfg, r)
+			case *M:
+				if m != nil {
+					check(*m, cfg, r)
+				}
+			}
+		},
+	}
+}
+
+// Engine runs a set of rules.
+type Engine struct {
+	rules []Rule
+}
+
+// NewEngine returns an engine running rules. Use DefaultRules for the FedNow
+// market-practice rules.
+func NewEngine(rules ...Rule) *Engine {
+	return &Engine{rules: rules}
+}
+
+// Default returns an engine running DefaultRules.
+func Default() *Engine {
+	return NewEngine(DefaultRules()...)
+}
+
+// Add appends rules to the engine.
+func (e *Engine) Add(rules ...Rule) {
+	e.rules = append(e.rules, rules...)
+}
+
+// Rules returns the rules of the engine in the order they run.
+func (e *Engine) Rules() []Rule {
+	return append([]Rule(nil), e.rules...)
+}
+
+// Check runs every rule that applies to message and returns all
+// violations, in rule order. cfg is the configuration the message would be
+// generated with.
+func (e *Engine) Check(message any, cfg *config.Config) Violations {
+	var violations Violations
+	for _, rule := range e.rules {
+		r := &Report{ruleID: rule.ID}
+		rule.check(message, cfg, r)
+		violations = append(violations, r.violations...)
+	}
+	return violations
+}
+
+// Check runs the default rules against message.
+func Check(message any, cfg *config.Config) Violations {
+	return Default().Check(message, cfg)
+}
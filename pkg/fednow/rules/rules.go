@@ -0,0 +1,252 @@
+package rules
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	iso "github.com/mbanq/iso20022-go/ISO20022/pacs_008_001_08"
+	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow/camt"
+	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+	"github.com/mbanq/iso20022-go/pkg/fednow/pacs"
+	"github.com/mbanq/iso20022-go/pkg/fednow/pain"
+	"github.com/mbanq/iso20022-go/pkg/payment"
+)
+
+// IDs of the default rules.
+const (
+	RuleMessageID         = "message-id"
+	RuleCreationDateTime  = "creation-date-time"
+	RuleAgentsRequired    = "agents-required"
+	RuleUETRRequired      = "uetr-required"
+	RuleAmount            = "amount"
+	RuleCurrencyUSD       = "currency-usd"
+	RuleAmountLimit       = "amount-limit"
+	RuleSettlementMethod  = "settlement-method"
+	RuleClearingSystem    = "clearing-system"
+	RuleChargeBearer      = "charge-bearer"
+	RuleCategoryPurpose   = "category-purpose"
+	RulePostalAddress     = "postal-address"
+	RuleRequestForPayDate = "rfp-dates"
+)
+
+// DefaultRules returns the FedNow market-practice rules.
+func DefaultRules() []Rule {
+	return []Rule{
+		New(RuleMessageID, "Message identifiers are present and at most 35 characters", func(message any, cfg *config.Config, r *Report) {
+			e, ok := envelopeOf(message)
+			if !ok {
+				return
+			}
+			r.AddError("", common.PrefixError("fedNowMessage.identifier.messageId", iso.Max35Text(e.identifier.MessageID).Validate()))
+			if e.identifier.BusinessMessageID != "" {
+				r.AddError("", common.PrefixError("fedNowMessage.identifier.businessMessageId", iso.Max35Text(e.identifier.BusinessMessageID).Validate()))
+			}
+		}),
+		New(RuleCreationDateTime, "The creation date and time is set", func(message any, cfg *config.Config, r *Report) {
+			e, ok := envelopeOf(message)
+			if ok && time.Time(e.creationDateTime).IsZero() {
+				r.Add("fedNowMessage.creationDateTime", "", "is required")
+			}
+		}),
+		New(RuleAgentsRequired, "Sender and receiver are identified by valid routing numbers", func(message any, cfg *config.Config, r *Report) {
+			e, ok := envelopeOf(message)
+			if !ok {
+				return
+			}
+			r.AddError("", common.ValidateRoutingNumber("fedNowMessage.senderDepositoryInstitution.senderABANumber", e.sender.SenderABANumber))
+			r.AddError("", common.ValidateRoutingNumber("fedNowMessage.receiverDepositoryInstitution.receiverABANumber", e.receiver.ReceiverABANumber))
+		}),
+		New(RuleUETRRequired, "Payments and the messages referring to them carry a UUID v4 UETR", func(message any, cfg *config.Config, r *Report) {
+			path, uetr, ok := uetrOf(message)
+			if !ok {
+				return
+			}
+			if uetr == nil || *uetr == "" {
+				r.Add(path, "", "is required")
+				return
+			}
+			r.AddError("", common.PrefixError(path, iso.UUIDv4Identifier(*uetr).Validate()))
+		}),
+		New(RuleAmount, "Amounts are positive and within the currency's minor units", func(message any, cfg *config.Config, r *Report) {
+			for _, a := range amountsOf(message) {
+				r.AddError(a.path, a.amount.Validate(a.path))
+			}
+		}),
+		New(RuleCurrencyUSD, "Amounts are in US dollars", func(message any, cfg *config.Config, r *Report) {
+			for _, a := range amountsOf(message) {
+				r.AddError("", common.ValidateFedNowCurrency(a.path+".currency", a.amount.Ccy))
+			}
+		}),
+		New(RuleAmountLimit, "Credit transfers and requests for payment do not exceed the configured limits", func(message any, cfg *config.Config, r *Report) {
+			var limits config.Limits
+			if cfg != nil {
+				limits = cfg.Limits
+			}
+			switch m := deref(message).(type) {
+			case pacs.FedNowMessageCCT:
+				amount := m.FedNowMsg.Amount.Text
+				if err := limits.CheckCreditTransfer(amount, m.FedNowMsg.CustomerType); err != nil {
+					r.Add("fedNowMessage.amount.amount", amount.String(), err.Error())
+				}
+			case pain.FedNowMessageRFP:
+				amount := m.FedNowMsg.Amount.Text
+				if err := limits.CheckRequestForPayment(amount, m.FedNowMsg.CustomerType); err != nil {
+					r.Add("fedNowMessage.amount.amount", amount.String(), err.Error())
+				}
+			}
+		}),
+		New(RuleSettlementMethod, "Settlement method is CLRG", func(message any, cfg *config.Config, r *Report) {
+			if settles(message) && cfg != nil && cfg.SettlementMethod != iso.SettlementMethod1CodeClrg {
+				r.Add("config.settlementMethod", string(cfg.SettlementMethod), "must be CLRG")
+			}
+		}),
+		New(RuleClearingSystem, "Clearing system is FDN", func(message any, cfg *config.Config, r *Report) {
+			if settles(message) && cfg != nil && string(cfg.ClearingSystem) != string(iso.ExternalCashClearingSystem1CodeFdn) {
+				r.Add("config.clearingSystem", string(cfg.ClearingSystem), "must be FDN")
+			}
+		}),
+		New(RuleChargeBearer, "Charges are borne according to the service level (SLEV)", func(message any, cfg *config.Config, r *Report) {
+			if settles(message) && cfg != nil && cfg.ChargeBearer != iso.ChargeBearerType1CodeSlev {
+				r.Add("config.chargeBearer", string(cfg.ChargeBearer), "must be SLEV")
+			}
+		}),
+		New(RuleCategoryPurpose, "Credit transfers carry a category purpose", func(m pacs.FedNowMessageCCT, cfg *config.Config, r *Report) {
+			if cp := m.FedNowMsg.PaymentType.CategoryPurpose; cp == nil || *cp == "" {
+				r.Add("fedNowMessage.paymentType.categoryPurpose", "", "is required")
+			}
+		}),
+		New(RulePostalAddress, "Party addresses follow the configured address policy", func(message any, cfg *config.Config, r *Report) {
+			policy := common.DefaultAddressPolicy
+			if cfg != nil {
+				policy = cfg.AddressPolicy
+			}
+			for _, p := range partiesOf(message) {
+				path := p.path + ".personal.postalAddress"
+				if err := p.party.Personal.Address.Validate(policy); err != nil {
+					r.Add(path, "", err.Error())
+				}
+			}
+		}),
+		New(RuleRequestForPayDate, "A request for payment expires after its execution date", func(m pain.FedNowMessageRFP, cfg *config.Config, r *Report) {
+			info := m.FedNowMsg.ExecutionInfo
+			execution, expiry := time.Time(info.ExecutionDate), time.Time(info.ExpiryDate)
+			if execution.IsZero() {
+				r.Add("fedNowMessage.executionInfo.executionDate", "", "is required")
+			}
+			if expiry.IsZero() {
+				r.Add("fedNowMessage.executionInfo.expiryDate", "", "is required")
+			}
+			if !execution.IsZero() && !expiry.IsZero() && expiry.Before(execution) {
+				r.Add("fedNowMessage.executionInfo.expiryDate", expiry.Format(time.RFC3339), "is before the execution date")
+			}
+		}),
+	}
+}
+
+// deref returns the value a non-nil pointer points to.
+func deref(message any) any {
+	if v := reflect.ValueOf(message); v.Kind() == reflect.Pointer && !v.IsNil() {
+		return v.Elem().Interface()
+	}
+	return message
+}
+
+// envelope holds the fields every payment message shares.
+type envelope struct {
+	creationDateTime common.ISODateTime
+	identifier       payment.Identifier
+	sender           payment.DepositoryInstitution
+	receiver         payment.DepositoryInstitution
+}
+
+func envelopeOf(message any) (envelope, bool) {
+	switch m := deref(message).(type) {
+	case pacs.FedNowMessageCCT:
+		return envelope{m.FedNowMsg.CreationDateTime, m.FedNowMsg.Identifier, m.FedNowMsg.SenderDI, m.FedNowMsg.ReceiverDI}, true
+	case pacs.FedNowMessageACK:
+		return envelope{m.FedNowMsg.CreationDateTime, m.FedNowMsg.Identifier, m.FedNowMsg.SenderDI, m.FedNowMsg.ReceiverDI}, true
+	case pacs.FedNowMessageRtn:
+		return envelope{m.FedNowMsg.CreationDateTime, m.FedNowMsg.Identifier, m.FedNowMsg.SenderDI, m.FedNowMsg.ReceiverDI}, true
+	case pain.FedNowMessageRFP:
+		return envelope{m.FedNowMsg.CreationDateTime, m.FedNowMsg.Identifier, m.FedNowMsg.SenderDI, m.FedNowMsg.ReceiverDI}, true
+	case camt.FedNowMessageCxlReq:
+		return envelope{m.FedNowMsg.CreationDateTime, m.FedNowMsg.Identifier, m.FedNowMsg.SenderDI, m.FedNowMsg.ReceiverDI}, true
+	case camt.FedNowMessageCxlRsp:
+		return envelope{m.FedNowMsg.CreationDateTime, m.FedNowMsg.Identifier, m.FedNowMsg.SenderDI, m.FedNowMsg.ReceiverDI}, true
+	}
+	return envelope{}, false
+}
+
+// uetrOf returns the UETR a message must carry: its own for payments and
+// requests for payment, the original one for returns and cancellations.
+func uetrOf(message any) (string, *string, bool) {
+	switch m := deref(message).(type) {
+	case pacs.FedNowMessageCCT:
+		return "fedNowMessage.identifier.uetr", m.FedNowMsg.Identifier.UETR, true
+	case pain.FedNowMessageRFP:
+		return "fedNowMessage.identifier.uetr", m.FedNowMsg.Identifier.UETR, true
+	case pacs.FedNowMessageRtn:
+		return "fedNowMessage.originalIdentifier.uetr", m.FedNowMsg.OriginalIdentifier.UETR, true
+	case camt.FedNowMessageCxlReq:
+		return "fedNowMessage.originalIdentifier.uetr", m.FedNowMsg.OriginalIdentifier.UETR, true
+	}
+	return "", nil, false
+}
+
+type pathAmount struct {
+	path   string
+	amount payment.Amount
+}
+
+func amountsOf(message any) []pathAmount {
+	switch m := deref(message).(type) {
+	case pacs.FedNowMessageCCT:
+		return []pathAmount{{"fedNowMessage.amount", m.FedNowMsg.Amount}}
+	case pain.FedNowMessageRFP:
+		return []pathAmount{{"fedNowMessage.amount", m.FedNowMsg.Amount}}
+	case pacs.FedNowMessageRtn:
+		return []pathAmount{
+			{"fedNowMessage.amount", m.FedNowMsg.Amount},
+			{"fedNowMessage.paymentReturn.returnedAmount", m.FedNowMsg.PaymentReturn.ReturnedAmount},
+		}
+	case camt.FedNowMessageCxlRsp:
+		var amounts []pathAmount
+		for i, d := range m.FedNowMsg.CancellationDetails {
+			if d.ResolutionRelatedInfo != nil && d.ResolutionRelatedInfo.InterbankSettlementAmount != nil {
+				path := fmt.Sprintf("fedNowMessage.cancellationDetails[%d].resolutionRelatedInformation.interbankSettlementAmount", i)
+				amounts = append(amounts, pathAmount{path, *d.ResolutionRelatedInfo.InterbankSettlementAmount})
+			}
+		}
+		return amounts
+	}
+	return nil
+}
+
+type pathParty struct {
+	path  string
+	party payment.Party
+}
+
+func partiesOf(message any) []pathParty {
+	switch m := deref(message).(type) {
+	case pacs.FedNowMessageCCT:
+		return []pathParty{{"fedNowMessage.originator", m.FedNowMsg.Originator}, {"fedNowMessage.beneficiary", m.FedNowMsg.Beneficiary}}
+	case pain.FedNowMessageRFP:
+		return []pathParty{{"fedNowMessage.originator", m.FedNowMsg.Originator}, {"fedNowMessage.beneficiary", m.FedNowMsg.Beneficiary}}
+	case pacs.FedNowMessageRtn:
+		return []pathParty{{"fedNowMessage.originator", m.FedNowMsg.Originator}, {"fedNowMessage.beneficiary", m.FedNowMsg.Beneficiary}}
+	}
+	return nil
+}
+
+// settles reports whether the message settles funds and so carries the
+// settlement information taken from the configuration.
+func settles(message any) bool {
+	switch deref(message).(type) {
+	case pacs.FedNowMessageCCT, pacs.FedNowMessageRtn:
+		return true
+	}
+	return false
+}
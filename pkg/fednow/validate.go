@@ -0,0 +1,64 @@
+package fednow
+
+import (
+	"errors"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow/jsonschema"
+	"github.com/mbanq/iso20022-go/pkg/xsd"
+)
+
+// Validate checks a JSON payload against the schema of messageType in
+// schemas/ before it is unmarshalled, catching what json.Unmarshal lets
+// through: unknown fields, missing required fields (which it would leave
+// zero), wrong types and invalid formats, codes or lengths. All problems are
+// returned in a *ValidationError whose Fields hold the JSON path of each.
+// A message registered without a committed schema is checked against one
+// derived from its Payload type.
+// Malformed JSON is returned as a *DecodeError, and a message type without a
+// schema as an *UnsupportedMessageError.
+func Validate(messageType string, payload []byte) error {
+	schema, err := jsonschema.Load(messageType)
+	if err != nil {
+		// Messages registered by the caller have no committed schema; derive
+		// one without the XSD facets.
+		registered, ok := Lookup(messageType)
+		if !ok || registered.Payload == nil {
+			return &UnsupportedMessageError{MessageType: messageType}
+		}
+		schema = jsonschema.NewGenerator(nil).Generate(messageType, registered.Payload)
+	}
+	err = schema.Validate(payload)
+	if err == nil {
+		return nil
+	}
+	var fieldErr *common.FieldError
+	if !errors.As(err, &fieldErr) {
+		return common.NewJSONDecodeError(messageType, payload, err)
+	}
+	return common.NewValidationError(messageType, err)
+}
+
+// validateXSD checks the AppHdr and Document in data against the ISO schemas
+// when WithXSDValidation is set.
+func (o options) validateXSD(messageType string, data []byte) error {
+	if !o.xsdValidation {
+		return nil
+	}
+	set := o.xsds
+	if set == nil {
+		var err error
+		if set, err = xsd.ISO(); err != nil {
+			return err
+		}
+	}
+	violations, err := set.Validate(data)
+	if err != nil {
+		return &DecodeError{Format: "xml", MessageType: messageType, Err: err}
+	}
+	errs := make([]error, len(violations))
+	for i, v := range violations {
+		errs[i] = &common.FieldError{Path: v.Path, Reason: v.Message}
+	}
+	return common.NewValidationError(messageType, errors.Join(errs...))
+}
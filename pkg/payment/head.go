@@ -0,0 +1,130 @@
+package payment
+
+import (
+	head "github.com/mbanq/iso20022-go/ISO20022/head_001_001_02"
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+// HeadParty builds a Business Application Header party identified by its
+// clearing system member ID.
+func HeadParty(memberID string) head.Party44Choice {
+	return head.Party44Choice{
+		FIId: &head.BranchAndFinancialInstitutionIdentification6{
+			FinInstnId: head.FinancialInstitutionIdentification18{
+				ClrSysMmbId: &head.ClearingSystemMemberIdentification2{
+					MmbId: head.Max35Text(memberID),
+				},
+			},
+		},
+	}
+}
+
+// MemberIDFromHead returns the clearing system member ID of a Business
+// Application Header party, or "" when absent.
+func MemberIDFromHead(party head.Party44Choice) string {
+	if party.FIId == nil || party.FIId.FinInstnId.ClrSysMmbId == nil {
+		return ""
+	}
+	return string(party.FIId.FinInstnId.ClrSysMmbId.MmbId)
+}
+
+// RelatedHeader identifies the Business Application Header of a related
+// message, such as the request answered by a response. It is sent as an
+// AppHdr Rltd element.
+type RelatedHeader struct {
+	From                string             `json:"from"`
+	To                  string             `json:"to"`
+	BusinessMessageID   string             `json:"businessMessageId"`
+	MessageDefinitionID string             `json:"messageDefinitionId"`
+	BusinessService     string             `json:"businessService,omitempty"`
+	CreationDateTime    common.ISODateTime `json:"creationDateTime"`
+	CopyDuplicate       string             `json:"copyDuplicate,omitempty"`
+	PossibleDuplicate   bool               `json:"possibleDuplicate,omitempty"`
+	Priority            string             `json:"priority,omitempty"`
+}
+
+// RelatedTo returns the RelatedHeader of a received AppHdr, to chain a
+// response to it.
+func RelatedTo(appHdr head.BusinessApplicationHeaderV02) RelatedHeader {
+	return RelatedHeader{
+		From:                MemberIDFromHead(appHdr.Fr),
+		To:                  MemberIDFromHead(appHdr.To),
+		BusinessMessageID:   string(appHdr.BizMsgIdr),
+		MessageDefinitionID: string(appHdr.MsgDefIdr),
+		BusinessService:     derefString(appHdr.BizSvc),
+		CreationDateTime:    appHdr.CreDt,
+		CopyDuplicate:       derefString(appHdr.CpyDplct),
+		PossibleDuplicate:   appHdr.PssblDplct != nil && bool(*appHdr.PssblDplct),
+		Priority:            derefString(appHdr.Prty),
+	}
+}
+
+// Head builds the AppHdr Rltd element of r.
+func (r RelatedHeader) Head() head.BusinessApplicationHeader5 {
+	related := head.BusinessApplicationHeader5{
+		Fr:        HeadParty(r.From),
+		To:        HeadParty(r.To),
+		BizMsgIdr: head.Max35Text(r.BusinessMessageID),
+		MsgDefIdr: head.Max35Text(r.MessageDefinitionID),
+		CreDt:     r.CreationDateTime,
+	}
+	if r.BusinessService != "" {
+		bizSvc := head.Max35Text(r.BusinessService)
+		related.BizSvc = &bizSvc
+	}
+	if r.CopyDuplicate != "" {
+		cpyDplct := head.CopyDuplicate1Code(r.CopyDuplicate)
+		related.CpyDplct = &cpyDplct
+	}
+	if r.PossibleDuplicate {
+		pssblDplct := head.YesNoIndicator(true)
+		related.PssblDplct = &pssblDplct
+	}
+	if r.Priority != "" {
+		prty := head.BusinessMessagePriorityCode(r.Priority)
+		related.Prty = &prty
+	}
+	return related
+}
+
+// RelatedHeaderFromHead converts an AppHdr Rltd element.
+func RelatedHeaderFromHead(related head.BusinessApplicationHeader5) RelatedHeader {
+	return RelatedHeader{
+		From:                MemberIDFromHead(related.Fr),
+		To:                  MemberIDFromHead(related.To),
+		BusinessMessageID:   string(related.BizMsgIdr),
+		MessageDefinitionID: string(related.MsgDefIdr),
+		BusinessService:     derefString(related.BizSvc),
+		CreationDateTime:    related.CreDt,
+		CopyDuplicate:       derefString(related.CpyDplct),
+		PossibleDuplicate:   related.PssblDplct != nil && bool(*related.PssblDplct),
+		Priority:            derefString(related.Prty),
+	}
+}
+
+// WithHeader returns id with the sender, receiver and optional AppHdr
+// elements of appHdr: the character set, business service, business
+// processing date, copy and duplicate indicators, priority and related
+// headers.
+func (id Identifier) WithHeader(appHdr head.BusinessApplicationHeaderV02) Identifier {
+	id.From = MemberIDFromHead(appHdr.Fr)
+	id.To = MemberIDFromHead(appHdr.To)
+	id.CharSet = derefString(appHdr.CharSet)
+	id.BusinessService = derefString(appHdr.BizSvc)
+	id.BusinessProcessingDate = appHdr.BizPrcgDt
+	id.CopyDuplicate = derefString(appHdr.CpyDplct)
+	id.PossibleDuplicate = appHdr.PssblDplct != nil && bool(*appHdr.PssblDplct)
+	id.Priority = derefString(appHdr.Prty)
+	id.Related = nil
+	for _, related := range appHdr.Rltd {
+		id.Related = append(id.Related, RelatedHeaderFromHead(related))
+	}
+	return id
+}
+
+func derefString[T ~string](s *T) string {
+	if s == nil {
+		return ""
+	}
+	return string(*s)
+}
@@ -0,0 +1,131 @@
+// Package payment holds the rail-neutral domain model shared by the FedNow
+// message packages, together with conversions to and from each generated
+// ISO 20022 model. Values are plain Go types so that one struct can feed any
+// message version without copying fields between near-identical types.
+package payment
+
+import (
+	"fmt"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+// Identifier carries the business, message and transaction references of a
+// message (or of the original message it refers to). BusinessService,
+// CopyDuplicate, PossibleDuplicate, Priority and Related map to the optional
+// AppHdr elements of the message itself.
+type Identifier struct {
+	BusinessMessageID      string              `json:"businessMessageId,omitempty"`
+	MessageID              string              `json:"messageId"`
+	MessageType            string              `json:"messageType,omitempty"`
+	InstructionID          *string             `json:"instructionId,omitempty"`
+	EndToEndID             string              `json:"endToEndId,omitempty"`
+	TransactionID          *string             `json:"transactionId,omitempty"`
+	UETR                   *string             `json:"uetr,omitempty"`
+	CreationDateTime       common.ISODateTime  `json:"creationDateTime,omitempty"`
+	From                   string              `json:"from,omitempty"`
+	To                     string              `json:"to,omitempty"`
+	CharSet                string              `json:"charSet,omitempty"`
+	BusinessService        string              `json:"businessService,omitempty"`
+	BusinessProcessingDate *common.ISODateTime `json:"businessProcessingDate,omitempty"`
+	CopyDuplicate          string              `json:"copyDuplicate,omitempty"`
+	PossibleDuplicate      bool                `json:"possibleDuplicate,omitempty"`
+	Priority               string              `json:"priority,omitempty"`
+	Related                []RelatedHeader     `json:"related,omitempty"`
+}
+
+// Amount is a currency amount. Text holds the exact decimal value.
+type Amount struct {
+	Text common.Amount `json:"amount"`
+	Ccy  string        `json:"currency"`
+}
+
+// Formatted returns the amount rendered with the currency's minor units, as
+// placed in the ISO Text of an amount element. Unknown currencies use two
+// decimals.
+func (a Amount) Formatted() string {
+	units, ok := common.CurrencyMinorUnits(a.Ccy)
+	if !ok {
+		units = 2
+	}
+	return a.Text.Format(units)
+}
+
+// Validate checks that the currency is an active ISO 4217 code and that the
+// amount is positive and not more precise than the currency allows. path is
+// the JSON path of the amount and prefixes the field named in the error.
+func (a Amount) Validate(path string) error {
+	return a.validate(path, common.ValidateCurrency)
+}
+
+// ValidateFedNow is Validate for an amount settled by FedNow, which also
+// requires the currency to be USD.
+func (a Amount) ValidateFedNow(path string) error {
+	return a.validate(path, common.ValidateFedNowCurrency)
+}
+
+func (a Amount) validate(path string, validateCurrency func(path, code string) error) error {
+	if err := validateCurrency(path+".currency", a.Ccy); err != nil {
+		return err
+	}
+	if err := a.Text.Validate(); err != nil {
+		return &common.FieldError{Path: path + ".amount", Value: a.Text.String(), Reason: "must be greater than zero"}
+	}
+	units, _ := common.CurrencyMinorUnits(a.Ccy)
+	if digits := a.Text.FractionDigits(); digits > units {
+		return &common.FieldError{Path: path + ".amount", Value: a.Text.String(), Reason: fmt.Sprintf("has %d decimals, %s allows %d", digits, a.Ccy, units)}
+	}
+	return nil
+}
+
+// DepositoryInstitution identifies a participant by its routing number.
+// Depending on the side it describes, either SenderABANumber or
+// ReceiverABANumber is populated.
+type DepositoryInstitution struct {
+	SenderABANumber   string  `json:"senderABANumber,omitempty"`
+	ReceiverABANumber string  `json:"receiverABANumber,omitempty"`
+	Name              *string `json:"senderShortName,omitempty"`
+}
+
+// MemberID returns whichever routing number is populated, preferring the
+// sender's.
+func (d DepositoryInstitution) MemberID() string {
+	if d.SenderABANumber != "" {
+		return d.SenderABANumber
+	}
+	return d.ReceiverABANumber
+}
+
+// Validate checks whichever routing numbers are populated and requires at
+// least one. path is the JSON path of the institution.
+func (d DepositoryInstitution) Validate(path string) error {
+	if d.SenderABANumber == "" && d.ReceiverABANumber == "" {
+		return &common.FieldError{Path: path, Reason: "routing number is required"}
+	}
+	if d.SenderABANumber != "" {
+		if err := common.ValidateRoutingNumber(path+".senderABANumber", d.SenderABANumber); err != nil {
+			return err
+		}
+	}
+	if d.ReceiverABANumber != "" {
+		if err := common.ValidateRoutingNumber(path+".receiverABANumber", d.ReceiverABANumber); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Party is a debtor or creditor of a payment.
+type Party struct {
+	Personal Personal `json:"personal"`
+}
+
+// Personal holds the name, address and account identifier of a party.
+type Personal struct {
+	Name       *string       `json:"name"`
+	Address    PostalAddress `json:"postalAddress"`
+	Identifier string        `json:"identifier"`
+}
+
+// PostalAddress is the shared ISO PostalAddress24 representation.
+type PostalAddress = common.PostalAddress
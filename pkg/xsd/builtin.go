@@ -0,0 +1,70 @@
+package xsd
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const timezonePattern = `(Z|[+-]\d{2}:\d{2})?`
+
+var builtinPatterns = map[string]*regexp.Regexp{
+	"xs:decimal":    regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)$`),
+	"xs:boolean":    regexp.MustCompile(`^(true|false|1|0)$`),
+	"xs:date":       regexp.MustCompile(`^\d{4}-\d{2}-\d{2}` + timezonePattern + `$`),
+	"xs:dateTime":   regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?` + timezonePattern + `$`),
+	"xs:time":       regexp.MustCompile(`^\d{2}:\d{2}:\d{2}(\.\d+)?` + timezonePattern + `$`),
+	"xs:gYearMonth": regexp.MustCompile(`^\d{4}-\d{2}` + timezonePattern + `$`),
+}
+
+// builtinLayouts are used to reject lexically valid but impossible values
+// such as 2024-02-30.
+var builtinLayouts = map[string]string{
+	"xs:date":       "2006-01-02",
+	"xs:dateTime":   "2006-01-02T15:04:05",
+	"xs:time":       "15:04:05",
+	"xs:gYearMonth": "2006-01",
+}
+
+func isBuiltin(name string) bool {
+	switch name {
+	case "xs:string", "xs:base64Binary":
+		return true
+	}
+	_, ok := builtinPatterns[name]
+	return ok
+}
+
+// normalize applies the whiteSpace facet of the built-in type: strings are
+// preserved, every other supported type collapses.
+func normalize(base, value string) string {
+	if base == "xs:string" {
+		return value
+	}
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// checkBuiltin validates the lexical form of value against a built-in type.
+func checkBuiltin(base, value string) error {
+	switch base {
+	case "xs:string":
+		return nil
+	case "xs:base64Binary":
+		if _, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(value), "")); err != nil {
+			return fmt.Errorf("%q is not valid %s", value, base)
+		}
+		return nil
+	}
+
+	if !builtinPatterns[base].MatchString(value) {
+		return fmt.Errorf("%q is not a valid %s", value, base)
+	}
+	if layout, ok := builtinLayouts[base]; ok {
+		if _, err := time.Parse(layout, value[:len(layout)]); err != nil {
+			return fmt.Errorf("%q is not a valid %s", value, base)
+		}
+	}
+	return nil
+}
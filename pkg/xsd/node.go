@@ -0,0 +1,73 @@
+package xsd
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// node is a minimal element tree used both for schemas and for the instance
+// documents being validated.
+type node struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	children []*node
+	text     string
+	line     int
+}
+
+func (n *node) attr(local string) string {
+	for _, a := range n.attrs {
+		if a.Name.Space == "" && a.Name.Local == local {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// readDocument reads the root element of the stream.
+func readDocument(dec *xml.Decoder) (*node, error) {
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("no root element")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			line, _ := dec.InputPos()
+			return readElement(dec, start, line)
+		}
+	}
+}
+
+// readElement reads the subtree of start, which has just been consumed.
+func readElement(dec *xml.Decoder, start xml.StartElement, line int) (*node, error) {
+	n := &node{name: start.Name, attrs: start.Attr, line: line}
+	var text strings.Builder
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			if err == io.EOF {
+				return nil, fmt.Errorf("unexpected end of document inside %s", start.Name.Local)
+			}
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			childLine, _ := dec.InputPos()
+			child, err := readElement(dec, t, childLine)
+			if err != nil {
+				return nil, err
+			}
+			n.children = append(n.children, child)
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			n.text = text.String()
+			return n, nil
+		}
+	}
+}
@@ -0,0 +1,442 @@
+// Package xsd is a small, dependency-free XML Schema validator for the ISO
+// 20022 message schemas shipped in Internal/XSD/iso.
+//
+// It supports the subset of XML Schema those files use: global elements,
+// named complex types with a sequence, a choice or simple content, xs:any
+// wildcards, attributes, and named simple types restricting a built-in type
+// with enumeration, pattern, length, digit and range facets.
+package xsd
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/fs"
+	"math/big"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	isoxsd "github.com/mbanq/iso20022-go/Internal/XSD/iso"
+)
+
+const xsdNamespace = "http://www.w3.org/2001/XMLSchema"
+
+// unbounded is the maxOccurs value of an unbounded particle.
+const unbounded = -1
+
+// Schema is a parsed XSD for a single target namespace.
+type Schema struct {
+	TargetNamespace string
+
+	elements     map[string]string
+	complexTypes map[string]*complexType
+	simpleTypes  map[string]*simpleType
+}
+
+type contentKind int
+
+const (
+	sequenceContent contentKind = iota
+	choiceContent
+	simpleContent
+)
+
+type complexType struct {
+	name       string
+	kind       contentKind
+	particles  []particle
+	base       string
+	attributes []attribute
+}
+
+// particle is an element declaration or wildcard inside a sequence or choice.
+type particle struct {
+	name      string
+	typeName  string
+	minOccurs int
+	maxOccurs int
+
+	wildcard        bool
+	namespace       string
+	processContents string
+}
+
+type attribute struct {
+	name     string
+	typeName string
+	required bool
+}
+
+type simpleType struct {
+	name         string
+	base         string
+	enumeration  []string
+	patterns     []*regexp.Regexp
+	patternText  []string
+	length       int
+	minLength    int
+	maxLength    int
+	totalDigits  int
+	fractionDigs int
+	minInclusive *big.Rat
+	maxInclusive *big.Rat
+	minExclusive *big.Rat
+	maxExclusive *big.Rat
+}
+
+// Parse reads an XSD document.
+func Parse(r io.Reader) (*Schema, error) {
+	root, err := readDocument(xml.NewDecoder(r))
+	if err != nil {
+		return nil, err
+	}
+	if root.name.Space != xsdNamespace || root.name.Local != "schema" {
+		return nil, fmt.Errorf("root element %s is not an xs:schema", root.name.Local)
+	}
+
+	schema := &Schema{
+		TargetNamespace: root.attr("targetNamespace"),
+		elements:        make(map[string]string),
+		complexTypes:    make(map[string]*complexType),
+		simpleTypes:     make(map[string]*simpleType),
+	}
+
+	for _, child := range root.children {
+		if child.name.Space != xsdNamespace {
+			continue
+		}
+		switch child.name.Local {
+		case "element":
+			schema.elements[child.attr("name")] = child.attr("type")
+		case "complexType":
+			ct, err := parseComplexType(child)
+			if err != nil {
+				return nil, err
+			}
+			schema.complexTypes[ct.name] = ct
+		case "simpleType":
+			st, err := parseSimpleType(child)
+			if err != nil {
+				return nil, err
+			}
+			schema.simpleTypes[st.name] = st
+		}
+	}
+
+	if err := schema.resolve(); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// ParseFile reads the XSD at path.
+func ParseFile(path string) (*Schema, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	schema, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return schema, nil
+}
+
+// Elements returns the names of the global elements declared by the schema.
+func (s *Schema) Elements() []string {
+	names := make([]string, 0, len(s.elements))
+	for name := range s.elements {
+		names = append(names, name)
+	}
+	return names
+}
+
+func parseComplexType(n *node) (*complexType, error) {
+	ct := &complexType{name: n.attr("name")}
+	for _, child := range n.children {
+		switch child.name.Local {
+		case "sequence", "choice":
+			if child.name.Local == "choice" {
+				ct.kind = choiceContent
+			}
+			for _, p := range child.children {
+				particle, err := parseParticle(p)
+				if err != nil {
+					return nil, fmt.Errorf("complexType %s: %w", ct.name, err)
+				}
+				ct.particles = append(ct.particles, particle)
+			}
+		case "simpleContent":
+			ct.kind = simpleContent
+			for _, ext := range child.children {
+				if ext.name.Local != "extension" {
+					return nil, fmt.Errorf("complexType %s: unsupported simpleContent %s", ct.name, ext.name.Local)
+				}
+				ct.base = ext.attr("base")
+				for _, a := range ext.children {
+					if a.name.Local != "attribute" {
+						continue
+					}
+					ct.attributes = append(ct.attributes, attribute{
+						name:     a.attr("name"),
+						typeName: a.attr("type"),
+						required: a.attr("use") == "required",
+					})
+				}
+			}
+		default:
+			return nil, fmt.Errorf("complexType %s: unsupported content %s", ct.name, child.name.Local)
+		}
+	}
+	return ct, nil
+}
+
+func parseParticle(n *node) (particle, error) {
+	p := particle{minOccurs: 1, maxOccurs: 1}
+	switch n.name.Local {
+	case "element":
+		p.name = n.attr("name")
+		p.typeName = n.attr("type")
+	case "any":
+		p.wildcard = true
+		p.namespace = n.attr("namespace")
+		p.processContents = n.attr("processContents")
+	default:
+		return p, fmt.Errorf("unsupported particle %s", n.name.Local)
+	}
+
+	if v := n.attr("minOccurs"); v != "" {
+		min, err := strconv.Atoi(v)
+		if err != nil {
+			return p, fmt.Errorf("invalid minOccurs %q", v)
+		}
+		p.minOccurs = min
+	}
+	if v := n.attr("maxOccurs"); v != "" {
+		if v == "unbounded" {
+			p.maxOccurs = unbounded
+		} else {
+			max, err := strconv.Atoi(v)
+			if err != nil {
+				return p, fmt.Errorf("invalid maxOccurs %q", v)
+			}
+			p.maxOccurs = max
+		}
+	}
+	return p, nil
+}
+
+func parseSimpleType(n *node) (*simpleType, error) {
+	st := &simpleType{
+		name:         n.attr("name"),
+		length:       -1,
+		minLength:    -1,
+		maxLength:    -1,
+		totalDigits:  -1,
+		fractionDigs: -1,
+	}
+	for _, restriction := range n.children {
+		if restriction.name.Local != "restriction" {
+			return nil, fmt.Errorf("simpleType %s: unsupported derivation %s", st.name, restriction.name.Local)
+		}
+		st.base = restriction.attr("base")
+		for _, facet := range restriction.children {
+			value := facet.attr("value")
+			var err error
+			switch facet.name.Local {
+			case "enumeration":
+				st.enumeration = append(st.enumeration, value)
+			case "pattern":
+				var re *regexp.Regexp
+				// XSD patterns are implicitly anchored.
+				re, err = regexp.Compile("^(?:" + value + ")$")
+				st.patterns = append(st.patterns, re)
+				st.patternText = append(st.patternText, value)
+			case "length":
+				st.length, err = strconv.Atoi(value)
+			case "minLength":
+				st.minLength, err = strconv.Atoi(value)
+			case "maxLength":
+				st.maxLength, err = strconv.Atoi(value)
+			case "totalDigits":
+				st.totalDigits, err = strconv.Atoi(value)
+			case "fractionDigits":
+				st.fractionDigs, err = strconv.Atoi(value)
+			case "minInclusive":
+				st.minInclusive, err = parseRat(value)
+			case "maxInclusive":
+				st.maxInclusive, err = parseRat(value)
+			case "minExclusive":
+				st.minExclusive, err = parseRat(value)
+			case "maxExclusive":
+				st.maxExclusive, err = parseRat(value)
+			default:
+				err = fmt.Errorf("unsupported facet")
+			}
+			if err != nil {
+				return nil, fmt.Errorf("simpleType %s: facet %s %q: %w", st.name, facet.name.Local, value, err)
+			}
+		}
+	}
+	return st, nil
+}
+
+func parseRat(value string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return nil, fmt.Errorf("not a decimal")
+	}
+	return r, nil
+}
+
+// resolve checks that every referenced type is declared or built in.
+func (s *Schema) resolve() error {
+	for name, typeName := range s.elements {
+		if !s.knownType(typeName) {
+			return fmt.Errorf("element %s: unknown type %s", name, typeName)
+		}
+	}
+	for _, ct := range s.complexTypes {
+		for _, p := range ct.particles {
+			if !p.wildcard && !s.knownType(p.typeName) {
+				return fmt.Errorf("complexType %s: element %s has unknown type %s", ct.name, p.name, p.typeName)
+			}
+		}
+		if ct.kind == simpleContent && !s.knownSimpleType(ct.base) {
+			return fmt.Errorf("complexType %s: unknown base type %s", ct.name, ct.base)
+		}
+		for _, a := range ct.attributes {
+			if !s.knownSimpleType(a.typeName) {
+				return fmt.Errorf("complexType %s: attribute %s has unknown type %s", ct.name, a.name, a.typeName)
+			}
+		}
+	}
+	for _, st := range s.simpleTypes {
+		if !isBuiltin(st.base) {
+			return fmt.Errorf("simpleType %s: unsupported base type %s", st.name, st.base)
+		}
+	}
+	return nil
+}
+
+func (s *Schema) knownType(name string) bool {
+	_, ok := s.complexTypes[name]
+	return ok || s.knownSimpleType(name)
+}
+
+func (s *Schema) knownSimpleType(name string) bool {
+	_, ok := s.simpleTypes[name]
+	return ok || isBuiltin(name)
+}
+
+// Facets are the constraining facets of a simple type. Integer facets are -1
+// when absent.
+type Facets struct {
+	// Base is the built-in type restricted, e.g. "xs:string".
+	Base        string
+	Enumeration []string
+	// Patterns are the XSD patterns, which are implicitly anchored.
+	Patterns       []string
+	Length         int
+	MinLength      int
+	MaxLength      int
+	TotalDigits    int
+	FractionDigits int
+}
+
+// SimpleType returns the facets of the named simple type.
+func (s *Schema) SimpleType(name string) (Facets, bool) {
+	st, ok := s.simpleTypes[name]
+	if !ok {
+		return Facets{}, false
+	}
+	return Facets{
+		Base:           st.base,
+		Enumeration:    append([]string(nil), st.enumeration...),
+		Patterns:       append([]string(nil), st.patternText...),
+		Length:         st.length,
+		MinLength:      st.minLength,
+		MaxLength:      st.maxLength,
+		TotalDigits:    st.totalDigits,
+		FractionDigits: st.fractionDigs,
+	}, true
+}
+
+// Set is a collection of schemas keyed by target namespace.
+type Set struct {
+	schemas map[string]*Schema
+}
+
+// NewSet returns a Set holding the given schemas.
+func NewSet(schemas ...*Schema) *Set {
+	set := &Set{schemas: make(map[string]*Schema)}
+	for _, schema := range schemas {
+		set.Add(schema)
+	}
+	return set
+}
+
+// Add registers schema, replacing any schema with the same target namespace.
+func (s *Set) Add(schema *Schema) {
+	s.schemas[schema.TargetNamespace] = schema
+}
+
+// Schema returns the schema for the target namespace.
+func (s *Set) Schema(namespace string) (*Schema, bool) {
+	schema, ok := s.schemas[namespace]
+	return schema, ok
+}
+
+// LoadDir loads every .xsd file in dir.
+func LoadDir(dir string) (*Set, error) {
+	return LoadFS(os.DirFS(dir), ".")
+}
+
+var (
+	isoSet  *Set
+	isoErr  error
+	isoOnce sync.Once
+)
+
+// ISO returns the set of the ISO 20022 schemas embedded from
+// Internal/XSD/iso, loaded on first use.
+func ISO() (*Set, error) {
+	isoOnce.Do(func() { isoSet, isoErr = LoadFS(isoxsd.FS, ".") })
+	return isoSet, isoErr
+}
+
+// LoadFS loads every .xsd file in dir of fsys.
+func LoadFS(fsys fs.FS, dir string) (*Set, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	set := NewSet()
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".xsd") {
+			continue
+		}
+		name := path.Join(dir, entry.Name())
+		f, err := fsys.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		schema, err := Parse(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		set.Add(schema)
+	}
+	if len(set.schemas) == 0 {
+		return nil, fmt.Errorf("no .xsd files found in %s", dir)
+	}
+	return set, nil
+}
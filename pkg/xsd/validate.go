@@ -0,0 +1,396 @@
+package xsd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+)
+
+// Violation is a single schema violation in an instance document.
+type Violation struct {
+	// Path is the XPath of the offending element or attribute, e.g.
+	// /Envelope/Document/FIToFICstmrCdtTrf/CdtTrfTxInf/PmtId/EndToEndId.
+	Path string
+	// Line is the line of the element in the validated stream.
+	Line    int
+	Message string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s", v.Path, v.Message)
+}
+
+// Validate validates data. Every element whose namespace and name match a
+// global element of a schema in the set (typically AppHdr and Document) is
+// validated; enclosing elements such as a transport envelope are skipped.
+// An error is returned when the stream is not well-formed or contains no
+// element known to the set.
+func (s *Set) Validate(data []byte) ([]Violation, error) {
+	return s.ValidateReader(bytes.NewReader(data))
+}
+
+// ValidateReader is like Validate but reads from r.
+func (s *Set) ValidateReader(r io.Reader) ([]Violation, error) {
+	dec := xml.NewDecoder(r)
+	v := &validator{set: s}
+	var stack []string
+	found := false
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			line, _ := dec.InputPos()
+			if schema, ok := s.schemas[t.Name.Space]; ok {
+				if typeName, global := schema.elements[t.Name.Local]; global {
+					n, err := readElement(dec, t, line)
+					if err != nil {
+						return nil, err
+					}
+					found = true
+					v.validateElement(schema, n, typeName, "/"+strings.Join(append(stack, t.Name.Local), "/"))
+					continue
+				}
+			}
+			stack = append(stack, t.Name.Local)
+		case xml.EndElement:
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("no element matches a loaded schema")
+	}
+	return v.violations, nil
+}
+
+type validator struct {
+	set        *Set
+	violations []Violation
+}
+
+func (v *validator) report(path string, line int, format string, args ...any) {
+	v.violations = append(v.violations, Violation{Path: path, Line: line, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) validateElement(schema *Schema, n *node, typeName, path string) {
+	if st, ok := schema.simpleTypes[typeName]; ok || isBuiltin(typeName) {
+		v.checkAttributes(n, nil, path)
+		if len(n.children) > 0 {
+			v.report(path, n.line, "element %s must not have child elements", n.name.Local)
+			return
+		}
+		v.checkSimpleValue(st, typeName, n.text, path, n.line)
+		return
+	}
+
+	ct := schema.complexTypes[typeName]
+	v.checkAttributes(n, ct.attributes, path)
+
+	if ct.kind == simpleContent {
+		if len(n.children) > 0 {
+			v.report(path, n.line, "element %s must not have child elements", n.name.Local)
+			return
+		}
+		v.checkSimpleValue(schema.simpleTypes[ct.base], ct.base, n.text, path, n.line)
+		for _, a := range ct.attributes {
+			value, present := attrValue(n, a.name)
+			if !present {
+				if a.required {
+					v.report(path+"/@"+a.name, n.line, "missing required attribute %s", a.name)
+				}
+				continue
+			}
+			v.checkSimpleValue(schema.simpleTypes[a.typeName], a.typeName, value, path+"/@"+a.name, n.line)
+		}
+		return
+	}
+
+	if strings.TrimSpace(n.text) != "" {
+		v.report(path, n.line, "element %s must not contain text", n.name.Local)
+	}
+
+	paths := childPaths(n, path)
+	if ct.kind == choiceContent {
+		v.matchChoice(schema, n, ct, paths, path)
+		return
+	}
+	v.matchSequence(schema, n, ct, paths, path)
+}
+
+func (v *validator) matchSequence(schema *Schema, n *node, ct *complexType, paths []string, path string) {
+	i := 0
+	for _, p := range ct.particles {
+		count := 0
+		for i < len(n.children) && (p.maxOccurs == unbounded || count < p.maxOccurs) && p.matches(schema, n.children[i]) {
+			v.validateParticle(schema, p, n.children[i], paths[i])
+			i++
+			count++
+		}
+		if count < p.minOccurs {
+			v.report(path, n.line, "missing required element %s", p.label())
+		}
+	}
+	v.reportUnexpected(schema, n, ct, paths, i)
+}
+
+func (v *validator) matchChoice(schema *Schema, n *node, ct *complexType, paths []string, path string) {
+	if len(n.children) == 0 {
+		labels := make([]string, len(ct.particles))
+		for i, p := range ct.particles {
+			labels[i] = p.label()
+		}
+		v.report(path, n.line, "expected one of %s", strings.Join(labels, ", "))
+		return
+	}
+
+	i := 0
+	for _, p := range ct.particles {
+		if !p.matches(schema, n.children[0]) {
+			continue
+		}
+		for i < len(n.children) && (p.maxOccurs == unbounded || i < p.maxOccurs) && p.matches(schema, n.children[i]) {
+			v.validateParticle(schema, p, n.children[i], paths[i])
+			i++
+		}
+		if i < p.minOccurs {
+			v.report(path, n.line, "missing required element %s", p.label())
+		}
+		break
+	}
+	v.reportUnexpected(schema, n, ct, paths, i)
+}
+
+// reportUnexpected reports the children from index i on, which no particle
+// accepted.
+func (v *validator) reportUnexpected(schema *Schema, n *node, ct *complexType, paths []string, i int) {
+	for ; i < len(n.children); i++ {
+		child := n.children[i]
+		declared := false
+		for _, p := range ct.particles {
+			if !p.wildcard && p.matches(schema, child) {
+				declared = true
+				if ct.kind == choiceContent {
+					v.report(paths[i], child.line, "element %s conflicts with another choice", child.name.Local)
+				} else if p.maxOccurs != unbounded && countNamed(n, child.name) > p.maxOccurs {
+					v.report(paths[i], child.line, "element %s occurs more than %d times", child.name.Local, p.maxOccurs)
+				} else {
+					v.report(paths[i], child.line, "element %s is out of order", child.name.Local)
+				}
+				break
+			}
+		}
+		if !declared {
+			v.report(paths[i], child.line, "unexpected element %s", qualifiedName(child.name))
+		}
+	}
+}
+
+func (v *validator) validateParticle(schema *Schema, p particle, child *node, path string) {
+	if !p.wildcard {
+		v.validateElement(schema, child, p.typeName, path)
+		return
+	}
+	if p.processContents == "skip" {
+		return
+	}
+	// Lax wildcards are validated only when a schema for the content is known.
+	if other, ok := v.set.schemas[child.name.Space]; ok {
+		if typeName, global := other.elements[child.name.Local]; global {
+			v.validateElement(other, child, typeName, path)
+		}
+	}
+}
+
+// checkAttributes reports attributes that are neither declared nor namespace
+// declarations or XML Schema instance attributes.
+func (v *validator) checkAttributes(n *node, declared []attribute, path string) {
+	for _, a := range n.attrs {
+		if a.Name.Space == "xmlns" || a.Name.Local == "xmlns" && a.Name.Space == "" {
+			continue
+		}
+		if a.Name.Space == "http://www.w3.org/2001/XMLSchema-instance" {
+			continue
+		}
+		known := false
+		for _, d := range declared {
+			if a.Name.Space == "" && a.Name.Local == d.name {
+				known = true
+				break
+			}
+		}
+		if !known {
+			v.report(path+"/@"+a.Name.Local, n.line, "unexpected attribute %s", a.Name.Local)
+		}
+	}
+}
+
+// checkSimpleValue validates value against st, or against the built-in type
+// base when st is nil.
+func (v *validator) checkSimpleValue(st *simpleType, base, value, path string, line int) {
+	if st != nil {
+		base = st.base
+	}
+	value = normalize(base, value)
+	if err := checkBuiltin(base, value); err != nil {
+		v.report(path, line, "%v", err)
+		return
+	}
+	if st == nil {
+		return
+	}
+
+	if len(st.enumeration) > 0 {
+		allowed := false
+		for _, e := range st.enumeration {
+			if value == e {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			v.report(path, line, "value %q is not allowed by %s", value, st.name)
+		}
+	}
+
+	if len(st.patterns) > 0 {
+		matched := false
+		for _, re := range st.patterns {
+			if re.MatchString(value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			v.report(path, line, "value %q does not match the pattern of %s", value, st.name)
+		}
+	}
+
+	length := utf8.RuneCountInString(value)
+	if base == "xs:base64Binary" {
+		decoded, _ := base64.StdEncoding.DecodeString(strings.ReplaceAll(value, " ", ""))
+		length = len(decoded)
+	}
+	if st.length >= 0 && length != st.length {
+		v.report(path, line, "length %d of %s must be %d", length, st.name, st.length)
+	}
+	if st.minLength >= 0 && length < st.minLength {
+		v.report(path, line, "length %d of %s is shorter than %d", length, st.name, st.minLength)
+	}
+	if st.maxLength >= 0 && length > st.maxLength {
+		v.report(path, line, "length %d of %s is longer than %d", length, st.name, st.maxLength)
+	}
+
+	if base == "xs:decimal" {
+		v.checkDecimal(st, value, path, line)
+	}
+}
+
+func (v *validator) checkDecimal(st *simpleType, value, path string, line int) {
+	digits := strings.TrimLeft(value, "+-")
+	intPart, fracPart, _ := strings.Cut(digits, ".")
+	intPart = strings.TrimLeft(intPart, "0")
+	fracPart = strings.TrimRight(fracPart, "0")
+
+	if st.fractionDigs >= 0 && len(fracPart) > st.fractionDigs {
+		v.report(path, line, "value %s has more than %d fraction digits", value, st.fractionDigs)
+	}
+	if st.totalDigits >= 0 && len(intPart)+len(fracPart) > st.totalDigits {
+		v.report(path, line, "value %s has more than %d digits", value, st.totalDigits)
+	}
+
+	r, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return
+	}
+	if st.minInclusive != nil && r.Cmp(st.minInclusive) < 0 {
+		v.report(path, line, "value %s is less than %s", value, st.minInclusive.FloatString(0))
+	}
+	if st.maxInclusive != nil && r.Cmp(st.maxInclusive) > 0 {
+		v.report(path, line, "value %s is greater than %s", value, st.maxInclusive.FloatString(0))
+	}
+	if st.minExclusive != nil && r.Cmp(st.minExclusive) <= 0 {
+		v.report(path, line, "value %s must be greater than %s", value, st.minExclusive.FloatString(0))
+	}
+	if st.maxExclusive != nil && r.Cmp(st.maxExclusive) >= 0 {
+		v.report(path, line, "value %s must be less than %s", value, st.maxExclusive.FloatString(0))
+	}
+}
+
+func (p particle) matches(schema *Schema, n *node) bool {
+	if p.wildcard {
+		switch p.namespace {
+		case "", "##any":
+			return true
+		case "##other":
+			return n.name.Space != schema.TargetNamespace
+		}
+		return n.name.Space == p.namespace
+	}
+	return n.name.Space == schema.TargetNamespace && n.name.Local == p.name
+}
+
+func (p particle) label() string {
+	if p.wildcard {
+		return "(any)"
+	}
+	return p.name
+}
+
+// childPaths returns the XPath of each child, adding a position predicate to
+// names that occur more than once.
+func childPaths(n *node, path string) []string {
+	counts := make(map[xml.Name]int)
+	for _, child := range n.children {
+		counts[child.name]++
+	}
+	seen := make(map[xml.Name]int)
+	paths := make([]string, len(n.children))
+	for i, child := range n.children {
+		seen[child.name]++
+		paths[i] = path + "/" + child.name.Local
+		if counts[child.name] > 1 {
+			paths[i] += fmt.Sprintf("[%d]", seen[child.name])
+		}
+	}
+	return paths
+}
+
+func countNamed(n *node, name xml.Name) int {
+	count := 0
+	for _, child := range n.children {
+		if child.name == name {
+			count++
+		}
+	}
+	return count
+}
+
+func attrValue(n *node, name string) (string, bool) {
+	for _, a := range n.attrs {
+		if a.Name.Space == "" && a.Name.Local == name {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+func qualifiedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return "{" + name.Space + "}" + name.Local
+}
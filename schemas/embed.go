@@ -0,0 +1,10 @@
+// Package schemas embeds the JSON Schemas of the FedNow payloads, generated
+// by cmd/jsonschema.
+package schemas
+
+import "embed"
+
+// FS holds one <messageType>.schema.json file per message type.
+//
+//go:embed *.schema.json
+var FS embed.FS
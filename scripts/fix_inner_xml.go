@@ -4,23 +4,28 @@
 package main
 
 import (
-	"bytes"
 	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 )
 
-func main() {
-	// Define the replacements
-	replacements := []struct {
-		old string
-		new string
-	}{
-		{"innerXml string", "InnerXml string"},
-	}
+// The generator gives every complex type an unexported innerXml field. Types
+// whose content is a wildcard (xs:any), such as SignatureEnvelope, have no
+// other field to hold it, so their field is exported to capture the raw XML.
+// Every other type drops the field: capturing each element's raw subtree
+// would keep a second copy of the whole document in memory. Raw XML of the
+// Document is available from fednow.WithRawDocument instead.
+var (
+	structPattern    = regexp.MustCompile("(?s)type \\w+ struct \\{\n(.*?)\n\\}")
+	innerXmlPattern  = regexp.MustCompile("\n\n?\t[iI]nnerXml +string +`xml:\",innerxml\"`\n")
+	wildcardPattern  = regexp.MustCompile("^\\s*(XMLName xml\\.Name|[iI]nnerXml +string +`xml:\",innerxml\"`)?\\s*$")
+	innerXmlReplacer = strings.NewReplacer("innerXml string", "InnerXml string")
+)
 
+func main() {
 	// Walk through the directory
 	err := filepath.WalkDir("ISO20022", func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
@@ -28,7 +33,7 @@ func main() {
 		}
 		// Only process regular files with .go extension
 		if !d.IsDir() && strings.HasSuffix(strings.ToLower(path), ".go") {
-			if err := replaceInFile(path, replacements); err != nil {
+			if err := fixFile(path); err != nil {
 				fmt.Printf("Error processing %s: %v\n", path, err)
 			}
 		}
@@ -39,16 +44,30 @@ func main() {
 	}
 }
 
-func replaceInFile(filePath string, replacements []struct{ old, new string }) error {
+func fixFile(filePath string) error {
 	content, err := os.ReadFile(filePath)
 	if err != nil {
 		return err
 	}
 
-	for _, r := range replacements {
-		content = bytes.Replace(content, []byte(r.old), []byte(r.new), -1)
-	}
+	fixed := structPattern.ReplaceAllStringFunc(string(content), func(decl string) string {
+		if isWildcard(structPattern.FindStringSubmatch(decl)[1]) {
+			return innerXmlReplacer.Replace(decl)
+		}
+		return innerXmlPattern.ReplaceAllString(decl, "\n")
+	})
 
 	// Write the modified content back to the file
-	return os.WriteFile(filePath, content, 0644)
+	return os.WriteFile(filePath, []byte(fixed), 0644)
+}
+
+// isWildcard reports whether a struct body has no field besides XMLName and
+// innerXml.
+func isWildcard(body string) bool {
+	for _, line := range strings.Split(body, "\n") {
+		if !wildcardPattern.MatchString(line) {
+			return false
+		}
+	}
+	return true
 }
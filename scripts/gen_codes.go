@@ -0,0 +1,180 @@
+//go:build ignore
+// +build ignore
+
+// gen_codes emits ISO20022/<package>/codes.go. It adds an IsValid method to
+// every code type that xsd2go generated enumeration constants for, and adds
+// constants and IsValid for the external code lists in
+// scripts/external_codes.json. External code lists are not part of the
+// schemas; the curated sets hold the codes FedNow uses. The codes with a
+// description are also emitted, per set, as the catalogue of
+// pkg/fednow/codes.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type externalCodeList struct {
+	Type  string `json:"type"`
+	Codes []struct {
+		Code        string `json:"code"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	} `json:"codes"`
+}
+
+type enumeration struct {
+	typeName  string
+	constants []string
+}
+
+func main() {
+	data, err := os.ReadFile("scripts/external_codes.json")
+	if err != nil {
+		fail(err)
+	}
+	var external []externalCodeList
+	if err := json.Unmarshal(data, &external); err != nil {
+		fail(fmt.Errorf("scripts/external_codes.json: %w", err))
+	}
+
+	dirs, err := filepath.Glob("ISO20022/*/models.go")
+	if err != nil {
+		fail(err)
+	}
+	for _, models := range dirs {
+		dir := filepath.Dir(models)
+		if err := generate(dir, external); err != nil {
+			fail(fmt.Errorf("%s: %w", dir, err))
+		}
+		fmt.Printf("Generated %s\n", filepath.Join(dir, "codes.go"))
+	}
+
+	if err := generateCatalogue(catalogueFile, external); err != nil {
+		fail(fmt.Errorf("%s: %w", catalogueFile, err))
+	}
+	fmt.Printf("Generated %s\n", catalogueFile)
+}
+
+// catalogueFile holds the code descriptions used by pkg/fednow/codes.
+const catalogueFile = "pkg/fednow/codes/catalogue.go"
+
+func fail(err error) {
+	fmt.Printf("Error: %v\n", err)
+	os.Exit(1)
+}
+
+// constantName converts a code to the constant name used by xsd2go, e.g.
+// ChargeBearerType1Code and "SLEV" give ChargeBearerType1CodeSlev.
+func constantName(typeName, code string) string {
+	code = strings.ToLower(code)
+	return typeName + strings.ToUpper(code[:1]) + code[1:]
+}
+
+func generate(dir string, external []externalCodeList) error {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filepath.Join(dir, "models.go"), nil, 0)
+	if err != nil {
+		return err
+	}
+
+	declared := make(map[string]bool)
+	var enums []*enumeration
+	byType := make(map[string]*enumeration)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			switch s := spec.(type) {
+			case *ast.TypeSpec:
+				declared[s.Name.Name] = true
+			case *ast.ValueSpec:
+				ident, ok := s.Type.(*ast.Ident)
+				if gen.Tok != token.CONST || !ok {
+					continue
+				}
+				e := byType[ident.Name]
+				if e == nil {
+					e = &enumeration{typeName: ident.Name}
+					byType[ident.Name] = e
+					enums = append(enums, e)
+				}
+				for _, name := range s.Names {
+					e.constants = append(e.constants, name.Name)
+				}
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by scripts/gen_codes.go; DO NOT EDIT.\n\npackage %s\n\n", file.Name.Name)
+
+	for _, list := range external {
+		if !declared[list.Type] || byType[list.Type] != nil {
+			continue
+		}
+		fmt.Fprintf(&buf, "// Codes of the %s external code list used by FedNow.\nconst (\n", list.Type)
+		e := &enumeration{typeName: list.Type}
+		for _, c := range list.Codes {
+			name := constantName(list.Type, c.Code)
+			fmt.Fprintf(&buf, "%s %s = %q // %s\n", name, list.Type, c.Code, c.Name)
+			e.constants = append(e.constants, name)
+		}
+		buf.WriteString(")\n\n")
+		writeIsValid(&buf, e, "one of the "+list.Type+" codes used by FedNow")
+	}
+
+	for _, e := range enums {
+		writeIsValid(&buf, e, "one of the "+e.typeName+" codes")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting generated code: %w", err)
+	}
+	return os.WriteFile(filepath.Join(dir, "codes.go"), src, 0644)
+}
+
+func writeIsValid(buf *bytes.Buffer, e *enumeration, what string) {
+	fmt.Fprintf(buf, "// IsValid reports whether c is %s.\n", what)
+	fmt.Fprintf(buf, "func (c %s) IsValid() bool {\nswitch c {\ncase %s:\nreturn true\n}\nreturn false\n}\n\n", e.typeName, strings.Join(e.constants, ",\n"))
+}
+
+// generateCatalogue writes the catalogue of pkg/fednow/codes: every list of
+// external whose codes carry a description, keyed by its type.
+func generateCatalogue(path string, external []externalCodeList) error {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by scripts/gen_codes.go; DO NOT EDIT.\n\npackage codes\n\n")
+	buf.WriteString("// catalogue holds the codes of each set that FedNow uses, from\n// scripts/external_codes.json.\nvar catalogue = map[Set][]entry{\n")
+	for _, list := range external {
+		if len(list.Codes) == 0 || list.Codes[0].Description == "" {
+			continue
+		}
+		fmt.Fprintf(&buf, "%q: {\n", list.Type)
+		for _, c := range list.Codes {
+			if c.Description == "" {
+				return fmt.Errorf("%s %s has no description", list.Type, c.Code)
+			}
+			fmt.Fprintf(&buf, "{%q, %q, %q},\n", c.Code, c.Name, c.Description)
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting generated code: %w", err)
+	}
+	return os.WriteFile(path, src, 0644)
+}
@@ -0,0 +1,97 @@
+//go:build ignore
+// +build ignore
+
+// gen_payment emits pkg/payment/<message>.go, the conversions between the
+// payment model and the generated ISO 20022 package of each message, from
+// scripts/payment.go.tmpl. The amount helpers are emitted for the amount
+// types the package declares.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// packages are the generated models the payment model converts to and from.
+var packages = []string{
+	"camt_029_001_09",
+	"camt_056_001_08",
+	"pacs_002_001_10",
+	"pacs_004_001_10",
+	"pacs_008_001_08",
+	"pain_013_001_07",
+}
+
+type conversion struct {
+	Package  string // e.g. pacs_008_001_08
+	Name     string // e.g. Pacs008
+	Active   bool   // the package declares ActiveCurrencyAndAmount
+	Historic bool   // the package declares ActiveOrHistoricCurrencyAndAmount
+}
+
+func main() {
+	tmpl, err := template.ParseFiles("scripts/payment.go.tmpl")
+	if err != nil {
+		fail(err)
+	}
+	for _, pkg := range packages {
+		c, err := newConversion(pkg)
+		if err != nil {
+			fail(fmt.Errorf("%s: %w", pkg, err))
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, c); err != nil {
+			fail(fmt.Errorf("%s: %w", pkg, err))
+		}
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			fail(fmt.Errorf("%s: formatting generated code: %w", pkg, err))
+		}
+		out := filepath.Join("pkg/payment", strings.ToLower(c.Name)+".go")
+		if err := os.WriteFile(out, src, 0644); err != nil {
+			fail(err)
+		}
+		fmt.Printf("Generated %s\n", out)
+	}
+}
+
+func fail(err error) {
+	fmt.Printf("Error: %v\n", err)
+	os.Exit(1)
+}
+
+// newConversion reads the declared types of ISO20022/<pkg>/models.go.
+func newConversion(pkg string) (conversion, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filepath.Join("ISO20022", pkg, "models.go"), nil, 0)
+	if err != nil {
+		return conversion{}, err
+	}
+	declared := make(map[string]bool)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			declared[spec.(*ast.TypeSpec).Name.Name] = true
+		}
+	}
+
+	// pacs_008_001_08 gives Pacs008.
+	parts := strings.Split(pkg, "_")
+	return conversion{
+		Package:  pkg,
+		Name:     strings.ToUpper(parts[0][:1]) + parts[0][1:] + parts[1],
+		Active:   declared["ActiveCurrencyAndAmount"],
+		Historic: declared["ActiveOrHistoricCurrencyAndAmount"],
+	}, nil
+}
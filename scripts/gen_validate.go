@@ -0,0 +1,522 @@
+//go:build ignore
+// +build ignore
+
+// gen_validate emits ISO20022/<package>/validate.go with a Validate() error
+// method for every type in the generated models. Simple types check their
+// XSD facets (enumeration, pattern, length, digits, minInclusive); complex
+// types check required and repeated elements and choice exclusivity, and
+// recurse into their children. Errors are common.FieldError values whose
+// path is the chain of element names, e.g. "CdtTrfTxInf[0].PmtId.EndToEndId".
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type xsdSchema struct {
+	TargetNamespace string           `xml:"targetNamespace,attr"`
+	Elements        []xsdElement     `xml:"element"`
+	ComplexTypes    []xsdComplexType `xml:"complexType"`
+	SimpleTypes     []xsdSimpleType  `xml:"simpleType"`
+}
+
+type xsdElement struct {
+	Name      string `xml:"name,attr"`
+	Type      string `xml:"type,attr"`
+	MinOccurs string `xml:"minOccurs,attr"`
+	MaxOccurs string `xml:"maxOccurs,attr"`
+}
+
+type xsdGroup struct {
+	Elements []xsdElement `xml:"element"`
+}
+
+type xsdAttribute struct {
+	Name string `xml:"name,attr"`
+	Type string `xml:"type,attr"`
+	Use  string `xml:"use,attr"`
+}
+
+type xsdComplexType struct {
+	Name          string    `xml:"name,attr"`
+	Sequence      *xsdGroup `xml:"sequence"`
+	Choice        *xsdGroup `xml:"choice"`
+	SimpleContent *struct {
+		Extension struct {
+			Base       string         `xml:"base,attr"`
+			Attributes []xsdAttribute `xml:"attribute"`
+		} `xml:"extension"`
+	} `xml:"simpleContent"`
+}
+
+type xsdFacet struct {
+	Value string `xml:"value,attr"`
+}
+
+type xsdSimpleType struct {
+	Name        string `xml:"name,attr"`
+	Restriction struct {
+		Base           string     `xml:"base,attr"`
+		Enumeration    []xsdFacet `xml:"enumeration"`
+		Pattern        []xsdFacet `xml:"pattern"`
+		Length         *xsdFacet  `xml:"length"`
+		MinLength      *xsdFacet  `xml:"minLength"`
+		MaxLength      *xsdFacet  `xml:"maxLength"`
+		TotalDigits    *xsdFacet  `xml:"totalDigits"`
+		FractionDigits *xsdFacet  `xml:"fractionDigits"`
+		MinInclusive   *xsdFacet  `xml:"minInclusive"`
+	} `xml:"restriction"`
+}
+
+const commonImport = "github.com/mbanq/iso20022-go/pkg/common"
+
+var modelsNamespace = regexp.MustCompile(`(?m)^// Models for (\S+)$`)
+
+func main() {
+	packages, err := modelPackages("ISO20022")
+	if err != nil {
+		fail(err)
+	}
+
+	err = filepath.WalkDir("Internal/XSD", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".xsd") {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var schema xsdSchema
+		if err := xml.Unmarshal(data, &schema); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		dir, ok := packages[schema.TargetNamespace]
+		if !ok {
+			return nil
+		}
+		if err := generate(dir, &schema); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		fmt.Printf("Generated %s\n", filepath.Join(dir, "validate.go"))
+		return nil
+	})
+	if err != nil {
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Printf("Error: %v\n", err)
+	os.Exit(1)
+}
+
+// modelPackages maps target namespaces to the generated package directories.
+func modelPackages(root string) (map[string]string, error) {
+	packages := make(map[string]string)
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(root, entry.Name())
+		data, err := os.ReadFile(filepath.Join(dir, "models.go"))
+		if err != nil {
+			continue
+		}
+		if m := modelsNamespace.FindSubmatch(data); m != nil {
+			packages[string(m[1])] = dir
+		}
+	}
+	return packages, nil
+}
+
+// goName converts an XSD name to the identifier used by xsd2go.
+func goName(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '.' || r == '-' })
+	for i, p := range parts {
+		parts[i] = strings.ToUpper(p[:1]) + p[1:]
+	}
+	return strings.Join(parts, "")
+}
+
+type field struct {
+	name string
+	tag  string
+	typ  ast.Expr
+}
+
+type generator struct {
+	schema   *xsdSchema
+	pkg      string
+	complex  map[string]*xsdComplexType
+	simple   map[string]*xsdSimpleType
+	byXSD    map[string]*xsdSimpleType
+	elements map[string]string
+
+	buf      bytes.Buffer
+	patterns []string
+	imports  map[string]bool
+}
+
+func generate(dir string, schema *xsdSchema) error {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filepath.Join(dir, "models.go"), nil, 0)
+	if err != nil {
+		return err
+	}
+
+	g := &generator{
+		schema:   schema,
+		pkg:      file.Name.Name,
+		complex:  make(map[string]*xsdComplexType),
+		simple:   make(map[string]*xsdSimpleType),
+		byXSD:    make(map[string]*xsdSimpleType),
+		elements: make(map[string]string),
+		imports:  make(map[string]bool),
+	}
+	for i := range schema.ComplexTypes {
+		ct := &schema.ComplexTypes[i]
+		g.complex[goName(ct.Name)] = ct
+	}
+	for i := range schema.SimpleTypes {
+		st := &schema.SimpleTypes[i]
+		g.simple[goName(st.Name)] = st
+		g.byXSD[st.Name] = st
+	}
+	for _, e := range schema.Elements {
+		g.elements[goName(e.Name)] = e.Type
+	}
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			switch t := ts.Type.(type) {
+			case *ast.StructType:
+				g.complexType(ts.Name.Name, structFields(t))
+			default:
+				g.simpleType(ts.Name.Name, t)
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by scripts/gen_validate.go; DO NOT EDIT.\n\npackage %s\n\nimport (\n", g.pkg)
+	imports := make([]string, 0, len(g.imports))
+	for imp := range g.imports {
+		if imp != commonImport {
+			imports = append(imports, imp)
+		}
+	}
+	sort.Strings(imports)
+	for _, imp := range imports {
+		fmt.Fprintf(&out, "\t%q\n", imp)
+	}
+	fmt.Fprintf(&out, "\n\t%q\n)\n\n", commonImport)
+	if len(g.patterns) > 0 {
+		out.WriteString("var (\n")
+		for _, p := range g.patterns {
+			out.WriteString(p)
+		}
+		out.WriteString(")\n\n")
+	}
+	out.Write(g.buf.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting generated code: %w", err)
+	}
+	return os.WriteFile(filepath.Join(dir, "validate.go"), src, 0644)
+}
+
+func structFields(t *ast.StructType) []field {
+	var fields []field
+	for _, f := range t.Fields.List {
+		tag := ""
+		if f.Tag != nil {
+			raw, _ := strconv.Unquote(f.Tag.Value)
+			tag = reflectTag(raw, "xml")
+		}
+		for _, name := range f.Names {
+			fields = append(fields, field{name: name.Name, tag: tag, typ: f.Type})
+		}
+	}
+	return fields
+}
+
+func reflectTag(tag, key string) string {
+	for _, part := range strings.Fields(tag) {
+		if strings.HasPrefix(part, key+":") {
+			v, _ := strconv.Unquote(strings.TrimPrefix(part, key+":"))
+			return v
+		}
+	}
+	return ""
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) simpleType(name string, underlying ast.Expr) {
+	g.printf("// Validate checks the facets of %s.\n", name)
+	g.printf("func (v %s) Validate() error {\n", name)
+
+	st := g.simple[name]
+	var expr string
+	switch exprString(underlying) {
+	case "string":
+		expr = "string(v)"
+	case "float64":
+		g.imports["strconv"] = true
+		expr = "strconv.FormatFloat(float64(v), 'f', -1, 64)"
+	}
+	if st != nil && expr != "" {
+		for _, check := range g.facetChecks(name, st, expr) {
+			g.printf("if err := %s; err != nil {\nreturn err\n}\n", check)
+		}
+	}
+	g.printf("return nil\n}\n\n")
+}
+
+// facetChecks returns a check expression for each facet of st applied to
+// expr, in the order they are evaluated.
+func (g *generator) facetChecks(name string, st *xsdSimpleType, expr string) []string {
+	var checks []string
+	r := st.Restriction
+	if len(r.Enumeration) > 0 {
+		values := make([]string, len(r.Enumeration))
+		for i, e := range r.Enumeration {
+			values[i] = strconv.Quote(e.Value)
+		}
+		checks = append(checks, fmt.Sprintf("common.CheckEnumeration(%s, %s)", expr, strings.Join(values, ", ")))
+	}
+	if len(r.Pattern) > 0 {
+		g.imports["regexp"] = true
+		alternatives := make([]string, len(r.Pattern))
+		for i, p := range r.Pattern {
+			alternatives[i] = p.Value
+		}
+		variable := "pattern" + name
+		// XSD patterns are implicitly anchored.
+		g.patterns = append(g.patterns, fmt.Sprintf("%s = regexp.MustCompile(%s)\n", variable, "`^(?:"+strings.Join(alternatives, "|")+")$`"))
+		checks = append(checks, fmt.Sprintf("common.CheckPattern(%s, %s)", expr, variable))
+	}
+	if r.Base == "xs:base64Binary" {
+		// Lengths of binary types count decoded octets, which the generated
+		// string type does not hold.
+		return checks
+	}
+	minLength, maxLength := facetInt(r.MinLength), facetInt(r.MaxLength)
+	if r.Length != nil {
+		minLength, maxLength = facetInt(r.Length), facetInt(r.Length)
+	}
+	if minLength >= 0 || maxLength >= 0 {
+		checks = append(checks, fmt.Sprintf("common.CheckLength(%s, %d, %d)", expr, minLength, maxLength))
+	}
+	if r.Base == "xs:decimal" {
+		minInclusive := ""
+		if r.MinInclusive != nil {
+			minInclusive = r.MinInclusive.Value
+		}
+		checks = append(checks, fmt.Sprintf("common.CheckDecimal(%s, %d, %d, %q)", expr, facetInt(r.TotalDigits), facetInt(r.FractionDigits), minInclusive))
+	}
+	return checks
+}
+
+func facetInt(f *xsdFacet) int {
+	if f == nil {
+		return -1
+	}
+	n, err := strconv.Atoi(f.Value)
+	if err != nil {
+		return -1
+	}
+	return n
+}
+
+func (g *generator) complexType(name string, fields []field) {
+	ct := g.complex[name]
+	if ct == nil {
+		// Element declarations are emitted as structs named after the
+		// element; their content is that of the element's type.
+		if typeName, ok := g.elements[name]; ok {
+			ct = g.complex[goName(typeName)]
+		}
+	}
+
+	var particles []xsdElement
+	choice := false
+	if ct != nil {
+		switch {
+		case ct.Sequence != nil:
+			particles = ct.Sequence.Elements
+		case ct.Choice != nil:
+			particles = ct.Choice.Elements
+			choice = true
+		}
+	}
+
+	g.printf("// Validate checks %s and its children against the schema.\n", name)
+	g.printf("func (v %s) Validate() error {\n", name)
+	start := g.buf.Len()
+	g.printf("var errs []error\n")
+	body := g.buf.Len()
+
+	var choiceNames, choicePresent []string
+	for _, f := range fields {
+		if f.name == "XMLName" || f.name == "InnerXml" {
+			continue
+		}
+		tagName, option, _ := strings.Cut(f.tag, ",")
+		switch {
+		case option == "chardata":
+			if ct != nil && ct.SimpleContent != nil {
+				if st := g.byXSD[ct.SimpleContent.Extension.Base]; st != nil {
+					// Only the first failing facet is reported, as for
+					// simple types.
+					for i, check := range g.facetChecks(goName(st.Name), st, "v."+f.name) {
+						if i > 0 {
+							g.printf(" else ")
+						}
+						g.printf("if err := %s; err != nil {\nerrs = append(errs, err)\n}", check)
+					}
+					g.printf("\n")
+				}
+			}
+			continue
+		case option == "attr":
+			g.child("@"+tagName, f, 1, 1)
+			continue
+		}
+
+		element := tagName
+		var particle *xsdElement
+		for i := range particles {
+			if particles[i].Name == tagName || tagName == "" && goName(particles[i].Name) == f.name {
+				particle = &particles[i]
+				element = particles[i].Name
+				break
+			}
+		}
+		if element == "" {
+			element = f.name
+		}
+
+		minOccurs, maxOccurs := 1, 1
+		if particle != nil {
+			minOccurs, maxOccurs = occurs(particle.MinOccurs, 1), occurs(particle.MaxOccurs, 1)
+		} else if _, ok := f.typ.(*ast.StarExpr); ok {
+			minOccurs = 0
+		}
+		if choice {
+			minOccurs = 0
+			choiceNames = append(choiceNames, strconv.Quote(element))
+			if _, ok := f.typ.(*ast.ArrayType); ok {
+				choicePresent = append(choicePresent, fmt.Sprintf("len(v.%s) > 0", f.name))
+			} else {
+				choicePresent = append(choicePresent, fmt.Sprintf("v.%s != nil", f.name))
+			}
+		}
+		g.child(element, f, minOccurs, maxOccurs)
+	}
+
+	if choice && len(choiceNames) > 0 {
+		g.printf("errs = append(errs, common.CheckChoice([]string{%s}, []bool{%s}))\n", strings.Join(choiceNames, ", "), strings.Join(choicePresent, ", "))
+	}
+	if g.buf.Len() == body {
+		// Nothing to check, e.g. a type holding only a wildcard.
+		g.buf.Truncate(start)
+		g.printf("return nil\n}\n\n")
+		return
+	}
+	g.printf("return errors.Join(errs...)\n}\n\n")
+	g.imports["errors"] = true
+}
+
+// child writes the validation of one field holding element.
+func (g *generator) child(element string, f field, minOccurs, maxOccurs int) {
+	switch t := f.typ.(type) {
+	case *ast.StarExpr:
+		if !validatable(t.X) {
+			return
+		}
+		if minOccurs > 0 {
+			g.printf("if v.%s == nil {\nerrs = append(errs, common.PrefixError(%q, common.Required()))\n} else {\n", f.name, element)
+			g.printf("errs = append(errs, common.PrefixError(%q, v.%s.Validate()))\n}\n", element, f.name)
+			return
+		}
+		g.printf("if v.%s != nil {\nerrs = append(errs, common.PrefixError(%q, v.%s.Validate()))\n}\n", f.name, element, f.name)
+	case *ast.ArrayType:
+		g.printf("errs = append(errs, common.PrefixError(%q, common.CheckOccurs(len(v.%s), %d, %d)))\n", element, f.name, minOccurs, maxOccurs)
+		if !validatable(t.Elt) {
+			return
+		}
+		g.imports["strconv"] = true
+		g.printf("for i := range v.%s {\nerrs = append(errs, common.PrefixError(%q+\"[\"+strconv.Itoa(i)+\"]\", v.%s[i].Validate()))\n}\n", f.name, element, f.name)
+	default:
+		if !validatable(t) {
+			return
+		}
+		g.printf("errs = append(errs, common.PrefixError(%q, v.%s.Validate()))\n", element, f.name)
+	}
+}
+
+// validatable reports whether values of the type have a Validate method:
+// generated named types and the common date types do, builtins do not.
+func validatable(t ast.Expr) bool {
+	switch t := t.(type) {
+	case *ast.Ident:
+		return ast.IsExported(t.Name)
+	case *ast.SelectorExpr:
+		return exprString(t) == "common.ISODate" || exprString(t) == "common.ISODateTime"
+	}
+	return false
+}
+
+func occurs(value string, def int) int {
+	switch value {
+	case "":
+		return def
+	case "unbounded":
+		return -1
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+func exprString(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return exprString(e.X) + "." + e.Sel.Name
+	case *ast.StarExpr:
+		return "*" + exprString(e.X)
+	case *ast.ArrayType:
+		return "[]" + exprString(e.Elt)
+	}
+	return ""
+}
@@ -11,14 +11,27 @@ do
     moovio_xsd2go convert "$file" github.com/mbanq/iso20022-go ISO20022
 done
 
-# Use our custom Time types
+# Use our custom Time types, and keep raw XML only for wildcard types
 go run ./scripts/fix_imports.go
 go run ./scripts/fix_inner_xml.go
 
+# Emit Validate methods from the schema facets, and IsValid, the curated
+# external code constants and the pkg/fednow/codes catalogue from
+# scripts/external_codes.json
+go run ./scripts/gen_validate.go
+go run ./scripts/gen_codes.go
+
+# Emit the pkg/payment conversions for each message from
+# scripts/payment.go.tmpl
+go run ./scripts/gen_payment.go
+
 # run go fmt and goimports for every generated file
 files=($(find ./ISO20022 -name '*.go'))
 for file in "${files[@]}"
 do
     gofmt -w $file
     goimports -w $file
-done
\ No newline at end of file
+done
+
+# Refresh the JSON Schemas, which carry the facets of the ISO types
+go generate ./pkg/fednow/jsonschema
@@ -0,0 +1,90 @@
+package tests
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+func TestPostalAddress_ValidatePolicies(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	structured := common.PostalAddress{
+		StreetName:         str("Dream Road"),
+		BuildingNumber:     str("450"),
+		Floor:              str("2"),
+		TownName:           str("Lisle"),
+		CountrySubdivision: str("IL"),
+		PostalCode:         str("60532"),
+		Country:            str("US"),
+	}
+	unstructured := common.PostalAddress{
+		AddressLine: []string{"450 Dream Road", "Lisle IL 60532"},
+		Country:     str("US"),
+	}
+	structuredWithLines := structured
+	structuredWithLines.AddressLine = []string{"450 Dream Road"}
+	hybrid := common.PostalAddress{
+		TownName:    str("Lisle"),
+		Country:     str("US"),
+		AddressLine: []string{"450 Dream Road"},
+	}
+
+	tests := []struct {
+		name    string
+		address common.PostalAddress
+		policy  common.AddressPolicy
+		wantErr string
+	}{
+		{"structured default", structured, common.AddressPolicy{}, ""},
+		{"structured rejects lines", structuredWithLines, common.AddressPolicy{Mode: common.AddressStructured}, "AddressLine is not allowed in a structured address"},
+		{"structured requires fields", hybrid, common.AddressPolicy{Mode: common.AddressStructured}, "missing required address fields: StreetName, CountrySubdivision, PostalCode"},
+		{"unstructured", unstructured, common.AddressPolicy{Mode: common.AddressUnstructured}, ""},
+		{"unstructured rejects discrete fields", structured, common.AddressPolicy{Mode: common.AddressUnstructured}, "StreetName is not allowed"},
+		{"hybrid", hybrid, common.AddressPolicy{Mode: common.AddressHybrid}, ""},
+		{"hybrid requires town", unstructured, common.AddressPolicy{Mode: common.AddressHybrid}, "missing required address fields: TownName"},
+		{"custom required fields", hybrid, common.AddressPolicy{Mode: common.AddressHybrid, RequiredFields: []string{"Country"}}, ""},
+		{"invalid address type", common.PostalAddress{TownName: str("Lisle"), Country: str("US"), AddressType: &common.AddressType{Code: str("XXXX")}}, common.AddressPolicy{Mode: common.AddressHybrid}, "not a valid AddressType2Code"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.address.Validate(tt.policy)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPostalAddress_UnmarshalPostalBox(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{"PostBox", `{"PostBox":"PO 1"}`, "PO 1"},
+		{"pain.013 PostalBox", `{"PostalBox":"PO 2"}`, "PO 2"},
+		{"both", `{"PostBox":"PO 1","PostalBox":"PO 2"}`, "PO 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var address common.PostalAddress
+			if err := json.Unmarshal([]byte(tt.json), &address); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if address.PostBox == nil || *address.PostBox != tt.want || address.PostalBox != nil {
+				t.Fatalf("PostBox = %v, PostalBox = %v, want %q", address.PostBox, address.PostalBox, tt.want)
+			}
+		})
+	}
+}
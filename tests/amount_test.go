@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/payment"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr string
+	}{
+		{"1000.00", "1000.00", ""},
+		{"0.1", "0.1", ""},
+		{"12.345670000", "12.34567", ""},
+		{"1234567890123.12345", "1234567890123.12345", ""},
+		{"123456789012345678", "123456789012345678", ""},
+		{"1.123456", "", "fraction digits"},
+		{"1234567890123456789", "", "digits"},
+		{"1e3", "", "invalid amount"},
+		{"1,000.00", "", "invalid amount"},
+		{"", "", "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := common.ParseAmount(tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAmountArithmetic(t *testing.T) {
+	original := common.MustParseAmount("100.10")
+	returned := common.MustParseAmount("40.005")
+
+	rest, err := original.Sub(returned)
+	if err != nil {
+		t.Fatalf("Sub: %v", err)
+	}
+	if rest.String() != "60.095" {
+		t.Errorf("Sub = %s, want 60.095", rest)
+	}
+
+	total, err := common.SumAmounts(rest, returned)
+	if err != nil {
+		t.Fatalf("SumAmounts: %v", err)
+	}
+	if total.Cmp(original) != 0 {
+		t.Errorf("SumAmounts = %s, want %s", total, original)
+	}
+
+	if _, err := common.MustParseAmount("999999999999999999").Add(common.MustParseAmount("1")); err == nil {
+		t.Error("expected overflow error")
+	}
+}
+
+func TestPaymentAmount_Validate(t *testing.T) {
+	tests := []struct {
+		payload string
+		wantErr string
+	}{
+		{`{"amount": 1000.00, "currency": "USD"}`, ""},
+		{`{"amount": "25.5", "currency": "USD"}`, ""},
+		{`{"amount": 0, "currency": "USD"}`, "greater than zero"},
+		{`{"amount": -5.00, "currency": "USD"}`, "greater than zero"},
+		{`{"amount": 10.001, "currency": "USD"}`, "amount.amount \"10.001\": has 3 decimals"},
+		{`{"amount": 10.001, "currency": "KWD"}`, ""},
+		{`{"amount": 10.5, "currency": "JPY"}`, "JPY allows 0"},
+		{`{"amount": 10.00, "currency": "XYZ"}`, "amount.currency \"XYZ\": not an active ISO 4217 currency code"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.payload, func(t *testing.T) {
+			var amount payment.Amount
+			if err := json.Unmarshal([]byte(tt.payload), &amount); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			err := amount.Validate("amount")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	amount := payment.Amount{Text: common.MustParseAmount("25.5"), Ccy: "USD"}
+	if got := amount.Formatted(); got != "25.50" {
+		t.Errorf("Formatted = %s, want 25.50", got)
+	}
+}
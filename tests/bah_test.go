@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	head "github.com/mbanq/iso20022-go/ISO20022/head_001_001_02"
+	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow"
+	"github.com/mbanq/iso20022-go/pkg/fednow/bah"
+	"github.com/mbanq/iso20022-go/pkg/fednow/pacs"
+	"github.com/mbanq/iso20022-go/pkg/payment"
+)
+
+func TestGenerate_HeaderOptions(t *testing.T) {
+	cfg, cct, _ := loadCCTSample(t)
+	cfg.MarketPractice = "bank.practice.01"
+
+	request, _, err := fednow.GeneratePacs008("pacs.008.001.08", cfg, cct)
+	if err != nil {
+		t.Fatalf("GeneratePacs008() error = %v", err)
+	}
+	if request.MktPrctc == nil || request.MktPrctc.Id != "bank.practice.01" {
+		t.Fatalf("MktPrctc = %+v, want the config market practice", request.MktPrctc)
+	}
+
+	data, err := os.ReadFile("../schema_Ack_ex.json")
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		adjust  func(id *payment.Identifier)
+		opts    []fednow.Option
+		want    func(appHdr *head.BusinessApplicationHeaderV02, id payment.Identifier) bool
+		wantErr bool
+	}{
+		{
+			name: "payload identifier",
+			adjust: func(id *payment.Identifier) {
+				id.BusinessService, id.CopyDuplicate, id.Priority = "FDNW", "COPY", "NORM"
+			},
+			want: func(_ *head.BusinessApplicationHeaderV02, id payment.Identifier) bool {
+				return id.BusinessService == "FDNW" && id.CopyDuplicate == "COPY" && id.Priority == "NORM" && !id.PossibleDuplicate
+			},
+		},
+		{
+			name: "related and possible duplicate",
+			opts: []fednow.Option{fednow.WithRelated(*request), fednow.WithPossibleDuplicate()},
+			want: func(_ *head.BusinessApplicationHeaderV02, id payment.Identifier) bool {
+				return id.PossibleDuplicate && len(id.Related) == 1 &&
+					id.Related[0].BusinessMessageID == string(request.BizMsgIdr) &&
+					id.Related[0].MessageDefinitionID == "pacs.008.001.08" &&
+					id.Related[0].From == string(cfg.IspId)
+			},
+		},
+		{
+			name: "sender, receiver, charset and processing date",
+			adjust: func(id *payment.Identifier) {
+				bizPrcgDt := common.ISODateTime(time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+				id.From, id.To, id.CharSet, id.BusinessProcessingDate = "011000015", "021150706", "Latin", &bizPrcgDt
+			},
+			want: func(appHdr *head.BusinessApplicationHeaderV02, id payment.Identifier) bool {
+				return payment.MemberIDFromHead(appHdr.Fr) == "011000015" && id.From == "011000015" && id.To == "021150706" &&
+					id.CharSet == "Latin" && id.BusinessProcessingDate != nil &&
+					time.Time(*id.BusinessProcessingDate).Equal(time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+			},
+		},
+		{
+			name: "sender override",
+			opts: []fednow.Option{fednow.WithHeader(func(h *bah.Options) { h.From = "011000015" })},
+			want: func(appHdr *head.BusinessApplicationHeaderV02, id payment.Identifier) bool {
+				return payment.MemberIDFromHead(appHdr.Fr) == "011000015" && id.Related == nil
+			},
+		},
+		{
+			name:    "invalid copy duplicate",
+			adjust:  func(id *payment.Identifier) { id.CopyDuplicate = "ORIG" },
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ack pacs.FedNowMessageACK
+			if err := json.Unmarshal(data, &ack); err != nil {
+				t.Fatalf("failed to unmarshal sample: %v", err)
+			}
+			if tt.adjust != nil {
+				tt.adjust(&ack.FedNowMsg.Identifier)
+			}
+
+			appHdr, document, err := fednow.GeneratePacs002("pacs.002.001.10", cfg, ack, tt.opts...)
+			if tt.wantErr {
+				if !errors.Is(err, fednow.ErrValidation) {
+					t.Fatalf("GeneratePacs002() error = %v, want a validation error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GeneratePacs002() error = %v", err)
+			}
+
+			parsed, err := fednow.Parse(envelope(t, "pacs.002.001.10", appHdr, document))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			got := parsed.(*pacs.FedNowMessageACK).FedNowMsg.Identifier
+			if !tt.want(appHdr, got) {
+				t.Fatalf("AppHdr = %+v, parsed identifier = %+v", appHdr, got)
+			}
+		})
+	}
+}
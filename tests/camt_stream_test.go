@@ -0,0 +1,153 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	camt054 "github.com/mbanq/iso20022-go/ISO20022/camt_054_001_08"
+	"github.com/mbanq/iso20022-go/pkg/fednow"
+	"github.com/mbanq/iso20022-go/pkg/fednow/camt"
+)
+
+// camtReport writes a bank-to-customer message with one report element per
+// entry count, wrapped like an inbound FedNow message.
+func camtReport(w io.Writer, messageType, root, report string, entries ...int) {
+	fmt.Fprintf(w, `<Envelope><AppHdr xmlns="urn:iso:std:iso:20022:tech:xsd:head.001.001.02"><MsgDefIdr>%s</MsgDefIdr></AppHdr>`, messageType)
+	fmt.Fprintf(w, `<Document xmlns="urn:iso:std:iso:20022:tech:xsd:%s"><%s>`, messageType, root)
+	fmt.Fprint(w, `<GrpHdr><MsgId>20250102021150706RPT0001</MsgId><CreDtTm>2025-01-02T10:00:00-05:00</CreDtTm></GrpHdr>`)
+	for r, n := range entries {
+		fmt.Fprintf(w, `<%s><Id>R%d</Id><Acct><Id><Othr><Id>ACCT%d</Id></Othr></Id></Acct>`, report, r, r)
+		fmt.Fprint(w, `<Bal><Amt Ccy="USD">1.00</Amt></Bal>`)
+		for i := 0; i < n; i++ {
+			fmt.Fprintf(w, `<Ntry><NtryRef>%d-%d</NtryRef><Amt Ccy="USD">%d.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts><Cd>BOOK</Cd></Sts></Ntry>`, r, i, i+1)
+		}
+		fmt.Fprintf(w, `</%s>`, report)
+	}
+	fmt.Fprintf(w, `</%s></Document></Envelope>`, root)
+}
+
+func TestStreamCamt_Entries(t *testing.T) {
+	errStop := errors.New("stop")
+
+	tests := []struct {
+		name    string
+		xml     func(w io.Writer)
+		stream  func(r io.Reader, fn func(report, account, ref string) error) error
+		stopAt  int
+		want    int
+		wantErr error
+	}{
+		{
+			name: "camt.052",
+			xml:  func(w io.Writer) { camtReport(w, "camt.052.001.08", "BkToCstmrAcctRpt", "Rpt", 2, 0, 3) },
+			stream: func(r io.Reader, fn func(report, account, ref string) error) error {
+				return camt.StreamCamt052(r, func(e camt.Camt052Entry) error {
+					return fn(e.ReportID, string(e.Account.Id.Othr.Id), string(*e.Entry.NtryRef))
+				})
+			},
+			want: 5,
+		},
+		{
+			name: "camt.054 large",
+			xml:  func(w io.Writer) { camtReport(w, "camt.054.001.08", "BkToCstmrDbtCdtNtfctn", "Ntfctn", 20000) },
+			stream: func(r io.Reader, fn func(report, account, ref string) error) error {
+				return camt.StreamCamt054(r, func(e camt.Camt054Entry) error {
+					if e.GroupHeader.MsgId != "20250102021150706RPT0001" || e.Entry.Amt.Ccy != "USD" {
+						return fmt.Errorf("unexpected entry %+v", e)
+					}
+					return fn(e.NotificationID, string(e.Account.Id.Othr.Id), string(*e.Entry.NtryRef))
+				})
+			},
+			want: 20000,
+		},
+		{
+			name: "stopped by callback",
+			xml:  func(w io.Writer) { camtReport(w, "camt.054.001.08", "BkToCstmrDbtCdtNtfctn", "Ntfctn", 10) },
+			stream: func(r io.Reader, fn func(report, account, ref string) error) error {
+				return camt.StreamCamt054(r, func(e camt.Camt054Entry) error {
+					return fn(e.NotificationID, string(e.Account.Id.Othr.Id), string(*e.Entry.NtryRef))
+				})
+			},
+			stopAt:  3,
+			want:    3,
+			wantErr: errStop,
+		},
+		{
+			name: "wrong message",
+			xml:  func(w io.Writer) { camtReport(w, "camt.054.001.08", "BkToCstmrDbtCdtNtfctn", "Ntfctn", 1) },
+			stream: func(r io.Reader, fn func(report, account, ref string) error) error {
+				return camt.StreamCamt052(r, func(camt.Camt052Entry) error { return nil })
+			},
+			wantErr: fednow.ErrDecode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w := io.Pipe()
+			go func() {
+				tt.xml(w)
+				w.Close()
+			}()
+			defer r.Close()
+
+			got := 0
+			report, index := "", 0
+			err := tt.stream(r, func(reportID, account, ref string) error {
+				if reportID != report {
+					report, index = reportID, 0
+				}
+				if want := fmt.Sprintf("%s-%d", reportID[1:], index); ref != want || account != "ACCT"+reportID[1:] {
+					return fmt.Errorf("entry %s of account %s, want %s of ACCT%s", ref, account, want, reportID[1:])
+				}
+				index++
+				got++
+				if got == tt.stopAt {
+					return errStop
+				}
+				return nil
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %d entries, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// BenchmarkCamt054 compares streaming the entries of a 5000-entry
+// notification with decoding its whole Document.
+func BenchmarkCamt054(b *testing.B) {
+	var buf bytes.Buffer
+	camtReport(&buf, "camt.054.001.08", "BkToCstmrDbtCdtNtfctn", "Ntfctn", 5000)
+	data := buf.Bytes()
+	document := data[bytes.Index(data, []byte("<Document")):bytes.LastIndex(data, []byte("</Envelope>"))]
+
+	b.Run("Stream", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := camt.StreamCamt054(bytes.NewReader(data), func(camt.Camt054Entry) error { return nil }); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var doc camt054.Document
+			if err := xml.Unmarshal(document, &doc); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
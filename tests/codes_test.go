@@ -0,0 +1,186 @@
+package tests
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	camt029 "github.com/mbanq/iso20022-go/ISO20022/camt_029_001_09"
+	camt056 "github.com/mbanq/iso20022-go/ISO20022/camt_056_001_08"
+	pacs002 "github.com/mbanq/iso20022-go/ISO20022/pacs_002_001_10"
+	pacs004 "github.com/mbanq/iso20022-go/ISO20022/pacs_004_001_10"
+	pacs008 "github.com/mbanq/iso20022-go/ISO20022/pacs_008_001_08"
+	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow"
+	"github.com/mbanq/iso20022-go/pkg/fednow/camt"
+	"github.com/mbanq/iso20022-go/pkg/fednow/codes"
+	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+	"github.com/mbanq/iso20022-go/pkg/fednow/pacs"
+)
+
+func TestCodes_IsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+		want  bool
+	}{
+		{"enumeration", pacs008.ChargeBearerType1CodeSlev.IsValid(), true},
+		{"enumeration unknown", pacs008.ChargeBearerType1Code("XXXX").IsValid(), false},
+		{"external", pacs002.ExternalPaymentTransactionStatus1Code("ACSC").IsValid(), true},
+		{"external numeric", pacs002.ExternalStatusReason1Code9910.IsValid(), true},
+		{"external unknown", pacs002.ExternalStatusReason1Code("ZZ99").IsValid(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.valid != tt.want {
+				t.Errorf("IsValid() = %v, want %v", tt.valid, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_RejectsUnknownCodes(t *testing.T) {
+	cfg, err := config.LoadConfig("../config.json")
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	cfg.ChargeBearer = "XXXX"
+
+	err = cfg.Validate()
+	if err == nil || !strings.HasPrefix(err.Error(), `chargeBearer "XXXX"`) {
+		t.Fatalf("expected chargeBearer error, got %v", err)
+	}
+}
+
+func TestFedNowCodes_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		messageType string
+		set         codes.Set
+		code        string
+		wantErr     string
+	}{
+		{"allowed", "pacs.002.001.10", codes.PaymentStatus, "RJCT", ""},
+		{"any status reason", "pacs.002.001.10", codes.StatusReason, "9910", ""},
+		{"unknown code", "pacs.004.001.10", codes.ReturnReason, "ZZ99", "is not a known ExternalReturnReason1Code"},
+		{"not allowed in message", "pacs.002.001.10", codes.PaymentStatus, "BLCK", "is not allowed in pacs.002.001.10"},
+		{"set not carried", "pacs.008.001.08", codes.ReturnReason, "DUPL", "is not allowed in pacs.008.001.08"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := codes.Validate("code", tt.messageType, tt.set, tt.code)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	if got := codes.Describe(codes.ReturnReason, "AC04"); !strings.Contains(got, "closed") {
+		t.Errorf("Describe(AC04) = %q", got)
+	}
+}
+
+// The catalogue and the generated constants both come from the curated code
+// lists and must not drift apart.
+func TestFedNowCodes_MatchGeneratedConstants(t *testing.T) {
+	isValid := map[codes.Set]func(string) bool{
+		codes.PaymentStatus:             func(c string) bool { return pacs002.ExternalPaymentTransactionStatus1Code(c).IsValid() },
+		codes.StatusReason:              func(c string) bool { return pacs002.ExternalStatusReason1Code(c).IsValid() },
+		codes.ReturnReason:              func(c string) bool { return pacs004.ExternalReturnReason1Code(c).IsValid() },
+		codes.CancellationReason:        func(c string) bool { return camt056.ExternalCancellationReason1Code(c).IsValid() },
+		codes.CancellationRejection:     func(c string) bool { return camt029.ExternalPaymentCancellationRejection1Code(c).IsValid() },
+		codes.InvestigationConfirmation: func(c string) bool { return camt029.ExternalInvestigationExecutionConfirmation1Code(c).IsValid() },
+	}
+	for _, set := range codes.Sets() {
+		for _, c := range codes.All(set) {
+			if !isValid[set](c.Code) {
+				t.Errorf("%s %s has no generated constant", set, c.Code)
+			}
+		}
+	}
+}
+
+func TestBuildPacs002_RejectsUnknownStatusReason(t *testing.T) {
+	cfg, err := config.LoadConfig("../config.json")
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	data, err := os.ReadFile("../schema_Ack_ex.json")
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+
+	if _, err := pacs.BuildPacs002(data, cfg); err != nil {
+		t.Fatalf("sample: unexpected error: %v", err)
+	}
+	data = []byte(strings.Replace(string(data), `"statusReason": "RC01"`, `"statusReason": "AC00000"`, 1))
+	_, err = pacs.BuildPacs002(data, cfg)
+	if err == nil || !strings.HasPrefix(err.Error(), `fedNowMessage.paymentStatus.statusReason "AC00000"`) {
+		t.Fatalf("expected statusReason error, got %v", err)
+	}
+}
+
+func TestCamt029_RejectionReason(t *testing.T) {
+	cfg, err := config.LoadConfig("../config.json")
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	rjcr := camt029.ExternalInvestigationExecutionConfirmation1Code("RJCR")
+
+	tests := []struct {
+		name    string
+		reason  string
+		wantErr string
+	}{
+		{"allowed", "NOOR", ""},
+		{"unknown code", "XXXX", `fedNowMessage.cancellationDetails[0].rejectionReason "XXXX": is not a known ExternalPaymentCancellationRejection1Code`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason := camt029.ExternalPaymentCancellationRejection1Code(tt.reason)
+			msg := camt.FedNowMessageCxlRsp{
+				FedNowMsg: camt.FedNowCxlRsp{
+					CreationDateTime:    common.ISODateTime(time.Date(2025, 1, 2, 10, 0, 0, 0, common.EstLocation)),
+					Identifier:          camt.FedNowIdentifierCxlRsp{BusinessMessageID: "20250102121182904Sc01Rsp", MessageID: "20250102121182904Sc01Rsp"},
+					InvestigationStatus: camt.FedNowInvestigationStatus{Confirmation: &rjcr},
+					CancellationDetails: []camt.FedNowCxlRspDetails{{
+						RejectionReason: &reason,
+						AdditionalInfo:  []camt029.Max105Text{"Original transaction not received"},
+					}},
+					SenderDI:   camt.FedNowDepositoryInstitution2{SenderABANumber: "121182904"},
+					ReceiverDI: camt.FedNowDepositoryInstitution2{ReceiverABANumber: "084106768"},
+				},
+			}
+			appHdr, document, err := fednow.GenerateCamt029("camt.029.001.09", cfg, msg)
+			if tt.wantErr != "" {
+				if !errors.Is(err, fednow.ErrValidation) || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("GenerateCamt029() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GenerateCamt029() error = %v", err)
+			}
+
+			parsed, err := fednow.Parse(envelope(t, "camt.029.001.09", appHdr, document))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			detail := parsed.(*camt.FedNowMessageCxlRsp).FedNowMsg.CancellationDetails[0]
+			if detail.RejectionReason == nil || *detail.RejectionReason != reason || len(detail.AdditionalInfo) != 1 {
+				t.Fatalf("parsed detail = %+v", detail)
+			}
+		})
+	}
+}
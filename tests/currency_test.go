@@ -0,0 +1,47 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/payment"
+)
+
+func TestValidateFedNowCurrency(t *testing.T) {
+	if err := common.ValidateFedNowCurrency("currency", "USD"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		code   string
+		reason string
+	}{
+		{"", "currency is required"},
+		{"usd", "not an active ISO 4217 currency code"},
+		{"EUR", "FedNow only supports USD"},
+	}
+	for _, tt := range tests {
+		err := common.ValidateFedNowCurrency("fedNowMessage.amount.currency", tt.code)
+		var fieldErr *common.FieldError
+		if !errors.As(err, &fieldErr) {
+			t.Fatalf("%q: expected *common.FieldError, got %v", tt.code, err)
+		}
+		if fieldErr.Path != "fedNowMessage.amount.currency" || fieldErr.Reason != tt.reason {
+			t.Errorf("%q: got %+v", tt.code, fieldErr)
+		}
+	}
+
+	amount := payment.Amount{Text: common.MustParseAmount("10.00"), Ccy: "EUR"}
+	if err := amount.Validate("fedNowMessage.amount"); err != nil {
+		t.Errorf("Validate(EUR) error = %v", err)
+	}
+	var fieldErr *common.FieldError
+	if err := amount.ValidateFedNow("fedNowMessage.amount"); !errors.As(err, &fieldErr) || fieldErr.Reason != "FedNow only supports USD" {
+		t.Errorf("ValidateFedNow(EUR) error = %v", err)
+	}
+
+	if units, ok := common.CurrencyMinorUnits("BHD"); !ok || units != 3 {
+		t.Errorf("CurrencyMinorUnits(BHD) = %d, %v", units, ok)
+	}
+}
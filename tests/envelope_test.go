@@ -0,0 +1,198 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mbanq/iso20022-go/pkg/fednow"
+	"github.com/mbanq/iso20022-go/pkg/fednow/pacs"
+)
+
+// envelopeXSD has the shape of the FedNow envelope schema: a root element
+// whose message element chooses between wrappers of an AppHdr and Document.
+const envelopeXSD = `<?xml version="1.0" encoding="UTF-8"?>
+<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"
+    xmlns:head="urn:iso:std:iso:20022:tech:xsd:head.001.001.02"
+    xmlns:pacs008="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08"
+    xmlns:camt029="urn:iso:std:iso:20022:tech:xsd:camt.029.001.09"
+    targetNamespace="urn:example:fednow" elementFormDefault="qualified">
+  <xs:element name="FedNowOutgoing">
+    <xs:complexType>
+      <xs:sequence>
+        <xs:element ref="FedNowOutgoingMessage"/>
+      </xs:sequence>
+    </xs:complexType>
+  </xs:element>
+  <xs:element name="FedNowOutgoingMessage">
+    <xs:complexType>
+      <xs:choice>
+        <xs:element ref="FedNowCustomerCreditTransfer"/>
+        <xs:element ref="FedNowReturnRequestResponse"/>
+        <xs:element ref="FedNowInformationRequestResponse"/>
+      </xs:choice>
+    </xs:complexType>
+  </xs:element>
+  <xs:element name="FedNowCustomerCreditTransfer">
+    <xs:complexType>
+      <xs:sequence>
+        <xs:element ref="head:AppHdr"/>
+        <xs:element ref="pacs008:Document"/>
+      </xs:sequence>
+    </xs:complexType>
+  </xs:element>
+  <xs:element name="FedNowReturnRequestResponse">
+    <xs:complexType>
+      <xs:sequence>
+        <xs:element ref="head:AppHdr"/>
+        <xs:element ref="camt029:Document"/>
+      </xs:sequence>
+    </xs:complexType>
+  </xs:element>
+  <xs:element name="FedNowInformationRequestResponse">
+    <xs:complexType>
+      <xs:sequence>
+        <xs:element ref="head:AppHdr"/>
+        <xs:element ref="camt029:Document"/>
+      </xs:sequence>
+    </xs:complexType>
+  </xs:element>
+</xs:schema>
+`
+
+func TestGenerateTo_Formats(t *testing.T) {
+	xsdPath := filepath.Join(t.TempDir(), "envelope.xsd")
+	if err := os.WriteFile(xsdPath, []byte(envelopeXSD), 0o644); err != nil {
+		t.Fatalf("failed to write XSD: %v", err)
+	}
+	cfg, msg, _ := loadCCTSample(t)
+	// Markup characters must be escaped rather than break the envelope.
+	name := "Smith & <Sons>"
+	msg.FedNowMsg.Originator.Personal.Name = &name
+
+	tests := []struct {
+		name      string
+		format    fednow.Format
+		multiline bool
+	}{
+		{"indented", fednow.Indented, true},
+		{"compact", fednow.Compact, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := fednow.GenerateTo(&buf, xsdPath, "pacs.008.001.08", cfg, msg, tt.format); err != nil {
+				t.Fatalf("GenerateTo() error = %v", err)
+			}
+			out := buf.String()
+
+			for _, want := range []string{
+				`<FedNowOutgoing xmlns="urn:example:fednow">`,
+				`<AppHdr xmlns="urn:iso:std:iso:20022:tech:xsd:head.001.001.02">`,
+				`<Document xmlns="urn:iso:std:iso:20022:tech:xsd:pacs.008.001.08">`,
+				`Smith &amp; &lt;Sons&gt;`,
+			} {
+				if !strings.Contains(out, want) {
+					t.Errorf("output does not contain %s:\n%s", want, out)
+				}
+			}
+			if strings.Contains(out, `xmlns=""`) {
+				t.Errorf("output undeclares the default namespace:\n%s", out)
+			}
+			if strings.Contains(out, "\n") != tt.multiline {
+				t.Errorf("multiline = %v, want %v", !tt.multiline, tt.multiline)
+			}
+
+			parsed, err := fednow.Parse(buf.Bytes())
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			got := parsed.(*pacs.FedNowMessageCCT)
+			if got.FedNowMsg.Originator.Personal.Name == nil || *got.FedNowMsg.Originator.Personal.Name != name {
+				t.Errorf("originator name = %v, want %q", got.FedNowMsg.Originator.Personal.Name, name)
+			}
+		})
+	}
+}
+
+func TestEnvelopeSchema_Wrapper(t *testing.T) {
+	schema, err := fednow.ReadEnvelopeSchema(strings.NewReader(envelopeXSD))
+	if err != nil {
+		t.Fatalf("ReadEnvelopeSchema() error = %v", err)
+	}
+	if schema.Namespace != "urn:example:fednow" || schema.Root != "FedNowOutgoing" || schema.Message != "FedNowOutgoingMessage" {
+		t.Fatalf("unexpected envelope elements: %+v", schema)
+	}
+
+	tests := []struct {
+		messageType string
+		preferred   string
+		want        string
+	}{
+		{"pacs.008.001.08", "", "FedNowCustomerCreditTransfer"},
+		{"camt.029.001.09", "", "FedNowReturnRequestResponse"},
+		{"camt.029.001.09", "FedNowInformationRequestResponse", "FedNowInformationRequestResponse"},
+		{"camt.029.001.09", "FedNowCustomerCreditTransfer", "FedNowReturnRequestResponse"},
+		{"pacs.002.001.10", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.messageType+"/"+tt.preferred, func(t *testing.T) {
+			got, err := schema.Wrapper(tt.messageType, tt.preferred)
+			if tt.want == "" {
+				if !errors.Is(err, fednow.ErrEnvelopeNotFound) {
+					t.Fatalf("Wrapper() error = %v, want ErrEnvelopeNotFound", err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Fatalf("Wrapper() = %q, %v, want %q", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncoder_Stream(t *testing.T) {
+	cfg, sample, _ := loadCCTSample(t)
+	schema, err := fednow.ReadEnvelopeSchema(strings.NewReader(envelopeXSD))
+	if err != nil {
+		t.Fatalf("ReadEnvelopeSchema() error = %v", err)
+	}
+
+	ids := []string{"20250102725160144Sc01Batch1", "20250102725160144Sc01Batch2", "20250102725160144Sc01Batch3"}
+	var buf bytes.Buffer
+	encoder := fednow.NewEncoder(&buf, schema, cfg, fednow.Compact)
+	for _, id := range ids {
+		msg := sample
+		msg.FedNowMsg.Identifier.MessageID, msg.FedNowMsg.Identifier.BusinessMessageID = id, id
+		if err := encoder.Encode("pacs.008.001.08", msg); err != nil {
+			t.Fatalf("Encode() error = %v", err)
+		}
+	}
+	if err := encoder.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != len(ids) {
+		t.Fatalf("got %d envelopes, want %d:\n%s", len(lines), len(ids), buf.String())
+	}
+	for i, line := range lines {
+		parsed, err := fednow.Parse([]byte(line))
+		if err != nil {
+			t.Fatalf("Parse() of envelope %d error = %v", i, err)
+		}
+		if got := parsed.(*pacs.FedNowMessageCCT).FedNowMsg.Identifier.MessageID; got != ids[i] {
+			t.Errorf("envelope %d messageId = %s, want %s", i, got, ids[i])
+		}
+	}
+
+	// Without the proprietary XSD there is no envelope to write.
+	if err := fednow.NewEncoder(&buf, nil, cfg, fednow.Compact).Encode("pacs.008.001.08", sample); !errors.Is(err, fednow.ErrEnvelopeNotFound) {
+		t.Errorf("Encode() without a schema error = %v, want ErrEnvelopeNotFound", err)
+	}
+}
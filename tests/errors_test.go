@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow"
+	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+	"github.com/mbanq/iso20022-go/pkg/fednow/pacs"
+)
+
+func TestParse_TypedErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		xml  string
+		want error
+	}{
+		{"no AppHdr", `<Envelope><Document/></Envelope>`, fednow.ErrEnvelopeNotFound},
+		{"unsupported", `<Envelope><AppHdr><MsgDefIdr>pacs.009.001.08</MsgDefIdr></AppHdr></Envelope>`, fednow.ErrUnsupportedMessage},
+		{"no Document", `<Envelope><AppHdr><MsgDefIdr>pacs.008.001.08</MsgDefIdr></AppHdr></Envelope>`, fednow.ErrEnvelopeNotFound},
+		{"malformed", "<Envelope>\n<AppHdr><MsgDefIdr>pacs.008.001.08</Msg></AppHdr></Envelope>", fednow.ErrDecode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := fednow.Parse([]byte(tt.xml))
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestParse_MissingTransaction(t *testing.T) {
+	tests := []struct {
+		messageType string
+		document    string
+		path        string
+	}{
+		{"pacs.008.001.08", `<FIToFICstmrCdtTrf><GrpHdr/></FIToFICstmrCdtTrf>`, "FIToFICstmrCdtTrf.CdtTrfTxInf"},
+		{"pacs.002.001.10", `<FIToFIPmtStsRpt><GrpHdr/></FIToFIPmtStsRpt>`, "FIToFIPmtStsRpt.TxInfAndSts"},
+		{"pacs.004.001.10", `<PmtRtr><GrpHdr/></PmtRtr>`, "PmtRtr.TxInf"},
+		{"pain.013.001.07", `<CdtrPmtActvtnReq><GrpHdr/></CdtrPmtActvtnReq>`, "CdtrPmtActvtnReq.PmtInf"},
+		{"pain.013.001.07", `<CdtrPmtActvtnReq><GrpHdr/><PmtInf/></CdtrPmtActvtnReq>`, "CdtrPmtActvtnReq.PmtInf.CdtTrfTx"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			xml := `<Envelope><AppHdr><MsgDefIdr>` + tt.messageType + `</MsgDefIdr></AppHdr><Document>` + tt.document + `</Document></Envelope>`
+			_, err := fednow.Parse([]byte(xml))
+			var fieldErr *common.FieldError
+			if !errors.Is(err, fednow.ErrDecode) || !errors.As(err, &fieldErr) || fieldErr.Path != tt.path {
+				t.Fatalf("expected a DecodeError with a FieldError at %s, got %v", tt.path, err)
+			}
+		})
+	}
+}
+
+func TestParse_DecodeErrorPosition(t *testing.T) {
+	_, err := fednow.Parse([]byte("<Envelope>\n<AppHdr><MsgDefIdr>pacs.008.001.08</Msg></AppHdr></Envelope>"))
+	var decodeErr *fednow.DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("expected *DecodeError, got %T: %v", err, err)
+	}
+	if decodeErr.Element != "AppHdr" || decodeErr.Line != 2 || decodeErr.Column == 0 {
+		t.Fatalf("unexpected position: %+v", decodeErr)
+	}
+}
+
+func TestGenerate_UnsupportedMessage(t *testing.T) {
+	_, err := fednow.Generate("missing.xsd", "pacs.009.001.08", &config.Config{}, pacs.FedNowMessageCCT{})
+	var unsupportedErr *fednow.UnsupportedMessageError
+	if !errors.As(err, &unsupportedErr) || unsupportedErr.MessageType != "pacs.009.001.08" {
+		t.Fatalf("expected *UnsupportedMessageError, got %v", err)
+	}
+
+	_, err = fednow.Generate("missing.xsd", "pacs.002.001.10", &config.Config{}, pacs.FedNowMessageCCT{})
+	if !errors.Is(err, fednow.ErrXSDMismatch) {
+		t.Fatalf("expected ErrXSDMismatch, got %v", err)
+	}
+}
+
+func TestBuild_ValidationError(t *testing.T) {
+	cfg, msg, _ := loadCCTSample(t)
+
+	cfg.FrbId = "021150707"
+	_, _, err := fednow.GeneratePacs008("pacs.008.001.08", cfg, msg)
+	var validationErr *fednow.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected *ValidationError, got %T: %v", err, err)
+	}
+	if validationErr.MessageType != "pacs.008.001.08" || len(validationErr.Fields) != 1 || validationErr.Fields[0].Path != "frbId" {
+		t.Fatalf("unexpected validation error: %+v", validationErr)
+	}
+	var fieldErr *common.FieldError
+	if !errors.As(err, &fieldErr) || !errors.Is(err, fednow.ErrValidation) {
+		t.Fatalf("expected a FieldError matching ErrValidation, got %v", err)
+	}
+
+	cfg.FrbId = "021150706"
+	msg.FedNowMsg.PaymentType.CategoryPurpose = nil
+	_, _, err = fednow.GeneratePacs008("pacs.008.001.08", cfg, msg)
+	if !errors.As(err, &fieldErr) || fieldErr.Path != "fedNowMessage.paymentType.categoryPurpose" {
+		t.Fatalf("expected a FieldError at fedNowMessage.paymentType.categoryPurpose, got %v", err)
+	}
+
+	_, err = pacs.BuildPacs008([]byte("{\n  \"fedNowMessage\": [}"), cfg)
+	var decodeErr *fednow.DecodeError
+	if !errors.As(err, &decodeErr) || decodeErr.Format != "json" || decodeErr.Line != 2 {
+		t.Fatalf("expected a JSON DecodeError on line 2, got %v", err)
+	}
+}
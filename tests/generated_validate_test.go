@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"encoding/xml"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	pacs008 "github.com/mbanq/iso20022-go/ISO20022/pacs_008_001_08"
+	"github.com/mbanq/iso20022-go/pkg/common"
+)
+
+func TestGeneratedValidate(t *testing.T) {
+	data, err := os.ReadFile("../sample_files/pacs.008.001.08_scenario1.xml")
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	load := func(t *testing.T) *pacs008.Document {
+		var doc pacs008.Document
+		if err := xml.Unmarshal(data, &doc); err != nil {
+			t.Fatalf("failed to unmarshal sample: %v", err)
+		}
+		return &doc
+	}
+
+	if err := load(t).Validate(); err != nil {
+		t.Fatalf("sample: unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		mutate   func(doc *pacs008.Document)
+		wantPath string
+		wantMsg  string
+	}{
+		{
+			"max length",
+			func(doc *pacs008.Document) {
+				doc.FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.EndToEndId = pacs008.Max35Text(strings.Repeat("X", 36))
+			},
+			"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.EndToEndId",
+			"longer than 35",
+		},
+		{
+			"pattern",
+			func(doc *pacs008.Document) {
+				uetr := pacs008.UUIDv4Identifier("not-a-uuid")
+				doc.FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.UETR = &uetr
+			},
+			"FIToFICstmrCdtTrf.CdtTrfTxInf[0].PmtId.UETR",
+			"does not match pattern",
+		},
+		{
+			"enumeration",
+			func(doc *pacs008.Document) {
+				doc.FIToFICstmrCdtTrf.CdtTrfTxInf[0].ChrgBr = "XXXX"
+			},
+			"FIToFICstmrCdtTrf.CdtTrfTxInf[0].ChrgBr",
+			"not an allowed code",
+		},
+		{
+			"choice exclusivity",
+			func(doc *pacs008.Document) {
+				iban := pacs008.IBAN2007Identifier("DE89370400440532013000")
+				doc.FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAcct.Id.IBAN = &iban
+			},
+			"FIToFICstmrCdtTrf.CdtTrfTxInf[0].DbtrAcct.Id",
+			"only one of IBAN, Othr",
+		},
+		{
+			"required element",
+			func(doc *pacs008.Document) {
+				doc.FIToFICstmrCdtTrf.CdtTrfTxInf = nil
+			},
+			"FIToFICstmrCdtTrf.CdtTrfTxInf",
+			"at least 1 required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := load(t)
+			tt.mutate(doc)
+			err := doc.Validate()
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			var fieldErr *common.FieldError
+			if !errors.As(err, &fieldErr) {
+				t.Fatalf("expected a FieldError, got %v", err)
+			}
+			if fieldErr.Path != tt.wantPath || !strings.Contains(fieldErr.Reason, tt.wantMsg) {
+				t.Errorf("got %v, want %s: ...%s...", err, tt.wantPath, tt.wantMsg)
+			}
+		})
+	}
+}
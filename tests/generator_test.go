@@ -1,8 +1,12 @@
 package tests
 
 import (
+	"bytes"
 	"encoding/json"
+	"io"
 	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 	"time"
 
@@ -27,33 +31,73 @@ func BenchmarkGenerate(b *testing.B) {
 	if err != nil {
 		b.Fatalf("failed to load config: %v", err)
 	}
+	message := benchmarkMessage()
 
-	strPtr := func(s string) *pacs_008_001_08.Max35Text {
-		t := pacs_008_001_08.Max35Text(s)
-		return &t
-	}
+	b.ResetTimer()
 
-	strPtr140 := func(s string) *pacs_008_001_08.Max140Text {
-		t := pacs_008_001_08.Max140Text(s)
-		return &t
+	for i := 0; i < b.N; i++ {
+		// Use a message ID known to be in the full XSD.
+		_, err := fednow.Generate(xsdPath, "pacs.008.001.08", cfg, message)
+		if err != nil {
+			b.Fatal(err)
+		}
 	}
+}
 
-	strPtr70 := func(s string) *pacs_008_001_08.Max70Text {
-		t := pacs_008_001_08.Max70Text(s)
-		return &t
+// BenchmarkGenerate_Envelope measures Generate, GenerateTo and the streaming
+// Encoder with the message of BenchmarkGenerate in the test envelope schema,
+// which needs no proprietary XSD.
+func BenchmarkGenerate_Envelope(b *testing.B) {
+	xsdPath := filepath.Join(b.TempDir(), "envelope.xsd")
+	if err := os.WriteFile(xsdPath, []byte(envelopeXSD), 0o644); err != nil {
+		b.Fatalf("failed to write XSD: %v", err)
 	}
-
-	strPtr16 := func(s string) *pacs_008_001_08.Max16Text {
-		t := pacs_008_001_08.Max16Text(s)
-		return &t
+	cfg, err := config.LoadConfig("../config.json")
+	if err != nil {
+		b.Fatalf("failed to load config: %v", err)
+	}
+	schema, err := fednow.LoadEnvelopeSchema(xsdPath)
+	if err != nil {
+		b.Fatalf("LoadEnvelopeSchema() error = %v", err)
 	}
+	message := benchmarkMessage()
 
-	countryCodePtr := func(s string) *pacs_008_001_08.CountryCode {
-		t := pacs_008_001_08.CountryCode(s)
-		return &t
+	b.Run("Generate", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := fednow.Generate(xsdPath, "pacs.008.001.08", cfg, message); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("GenerateTo", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := fednow.GenerateTo(io.Discard, xsdPath, "pacs.008.001.08", cfg, message, fednow.Compact); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Encoder", func(b *testing.B) {
+		b.ReportAllocs()
+		encoder := fednow.NewEncoder(io.Discard, schema, cfg, fednow.Compact)
+		for i := 0; i < b.N; i++ {
+			if err := encoder.Encode("pacs.008.001.08", message); err != nil {
+				b.Fatal(err)
+			}
+		}
+		if err := encoder.Flush(); err != nil {
+			b.Fatal(err)
+		}
+	})
+}
+
+func benchmarkMessage() pacs.FedNowMessageCCT {
+	strPtr := func(s string) *string {
+		return &s
 	}
 
-	message := pacs.FedNowMessageCCT{
+	return pacs.FedNowMessageCCT{
 		FedNowMsg: pacs.FedNowDetails{
 			CreationDateTime: common.ISODateTime(time.Now()),
 			Identifier: pacs.FedNowIdentifier{
@@ -67,7 +111,7 @@ func BenchmarkGenerate(b *testing.B) {
 				CategoryPurpose: (*pacs_008_001_08.ExternalCategoryPurpose1Code)(strPtr("CONS")),
 			},
 			Amount: pacs.FedNowAmount{
-				Text: json.Number("1000.00"),
+				Text: common.MustParseAmount("1000.00"),
 				Ccy:  "USD",
 			},
 			SenderDI: pacs.FedNowDepositoryInstitution{
@@ -78,38 +122,103 @@ func BenchmarkGenerate(b *testing.B) {
 			},
 			Originator: pacs.FedNowParty{
 				Personal: pacs.FedNowPersonal{
-					Name: strPtr140("JANE SMITH"),
+					Name: strPtr("JANE SMITH"),
 					Address: pacs.FedNowPstlAdr{
-						StreetName:         strPtr70("Dream Road"),
+						StreetName:         strPtr("Dream Road"),
 						TownName:           strPtr("Lisle"),
 						CountrySubdivision: strPtr("IL"),
-						PostalCode:         strPtr16("60532"),
-						Country:            countryCodePtr("US"),
+						PostalCode:         strPtr("60532"),
+						Country:            strPtr("US"),
 					},
 				},
 			},
 			Beneficiary: pacs.FedNowParty{
 				Personal: pacs.FedNowPersonal{
-					Name: strPtr140("JOHN DOE"),
+					Name: strPtr("JOHN DOE"),
 					Address: pacs.FedNowPstlAdr{
-						StreetName:         strPtr70("Dream Road"),
+						StreetName:         strPtr("Dream Road"),
 						TownName:           strPtr("Lisle"),
 						CountrySubdivision: strPtr("IL"),
-						PostalCode:         strPtr16("60532"),
-						Country:            countryCodePtr("US"),
+						PostalCode:         strPtr("60532"),
+						Country:            strPtr("US"),
 					},
 				},
 			},
 		},
 	}
+}
 
-	b.ResetTimer()
+func TestGenerate_CreationDateTime(t *testing.T) {
+	cfg, err := config.LoadConfig("../config.json")
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	clock := func() time.Time { return time.Date(2025, 1, 2, 15, 0, 0, 0, time.UTC) }
+	caller := time.Date(2025, 3, 4, 9, 30, 0, 0, common.EstLocation)
 
-	for i := 0; i < b.N; i++ {
-		// Use a message ID known to be in the full XSD.
-		_, err := fednow.Generate(xsdPath, "pacs.008.001.08", cfg, message)
+	tests := []struct {
+		name   string
+		caller time.Time
+		opts   []fednow.Option
+		want   string
+	}{
+		{"clock", caller, []fednow.Option{fednow.WithClock(clock)}, "2025-01-02T10:00:00-05:00"},
+		{"caller timestamp", caller, []fednow.Option{fednow.WithClock(clock), fednow.WithCallerTimestamps()}, "2025-03-04T09:30:00-05:00"},
+		{"caller timestamp unset", time.Time{}, []fednow.Option{fednow.WithClock(clock), fednow.WithCallerTimestamps()}, "2025-01-02T10:00:00-05:00"},
+	}
+	samples := []struct {
+		messageType string
+		file        string
+	}{
+		{"pacs.008.001.08", "../schema_Cct_ex.json"},
+		{"pacs.002.001.10", "../schema_Ack_ex.json"},
+		{"pacs.004.001.10", "../schema_Rtn_ex.json"},
+		{"pain.013.001.07", "../schema_rfp_ex.json"},
+	}
+	creDt := regexp.MustCompile(`<CreDt>([^<]*)</CreDt>`)
+	creDtTm := regexp.MustCompile(`<CreDtTm>([^<]*)</CreDtTm>`)
+
+	for _, sample := range samples {
+		registered, _ := fednow.Lookup(sample.messageType)
+		data, err := os.ReadFile(sample.file)
 		if err != nil {
-			b.Fatal(err)
+			t.Fatalf("failed to read sample: %v", err)
+		}
+		for _, tt := range tests {
+			t.Run(sample.messageType+"/"+tt.name, func(t *testing.T) {
+				// Set the creationDateTime of the payload to the caller time.
+				var payload map[string]map[string]any
+				if err := json.Unmarshal(data, &payload); err != nil {
+					t.Fatalf("failed to unmarshal sample: %v", err)
+				}
+				delete(payload["fedNowMessage"], "creationDateTime")
+				if !tt.caller.IsZero() {
+					payload["fedNowMessage"]["creationDateTime"] = tt.caller.Format(time.RFC3339)
+				}
+				data, _ := json.Marshal(payload)
+				message, err := registered.Unmarshal(data)
+				if err != nil {
+					t.Fatalf("Unmarshal() error = %v", err)
+				}
+
+				appHdr, document, err := registered.Build(sample.messageType, cfg, message, tt.opts...)
+				if err != nil {
+					t.Fatalf("Build() error = %v", err)
+				}
+				var buf bytes.Buffer
+				if err := fednow.WriteMessage(&buf, sample.messageType, appHdr, document, fednow.Compact); err != nil {
+					t.Fatalf("WriteMessage() error = %v", err)
+				}
+
+				gotCreDt := creDt.FindStringSubmatch(buf.String())
+				gotCreDtTm := creDtTm.FindStringSubmatch(buf.String())
+				if gotCreDt == nil || gotCreDtTm == nil {
+					t.Fatalf("missing CreDt or CreDtTm:\n%s", buf.String())
+				}
+				if gotCreDt[1] != tt.want || gotCreDtTm[1] != tt.want {
+					t.Fatalf("CreDt = %s, CreDtTm = %s, want %s", gotCreDt[1], gotCreDtTm[1], tt.want)
+				}
+			})
 		}
 	}
 }
@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mbanq/iso20022-go/pkg/fednow"
+	"github.com/mbanq/iso20022-go/pkg/fednow/jsonschema"
+	"github.com/mbanq/iso20022-go/pkg/xsd"
+)
+
+func TestJSONSchema_UpToDate(t *testing.T) {
+	xsds, err := xsd.LoadDir("../Internal/XSD/iso")
+	if err != nil {
+		t.Fatalf("failed to load XSDs: %v", err)
+	}
+	generator := jsonschema.NewGenerator(xsds)
+
+	for _, m := range builtInMessages() {
+		t.Run(m.MessageType, func(t *testing.T) {
+			want, err := jsonschema.Marshal(generator.Generate(m.MessageType, m.Payload))
+			if err != nil {
+				t.Fatalf("failed to marshal schema: %v", err)
+			}
+			got, err := os.ReadFile(filepath.Join("../schemas", jsonschema.FileName(m.MessageType)))
+			if err != nil {
+				t.Fatalf("failed to read committed schema: %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Fatalf("schemas/%s is stale; run go generate ./pkg/fednow/jsonschema", jsonschema.FileName(m.MessageType))
+			}
+		})
+	}
+}
+
+func TestJSONSchema_ExamplesMatchPayloadTypes(t *testing.T) {
+	payloads := make(map[string]any)
+	for _, m := range builtInMessages() {
+		payloads[m.MessageType] = m.Payload
+	}
+
+	tests := []struct {
+		file        string
+		messageType string
+	}{
+		{"../schema_Cct_ex.json", "pacs.008.001.08"},
+		{"../schema_Ack_ex.json", "pacs.002.001.10"},
+		{"../schema_Rtn_ex.json", "pacs.004.001.10"},
+		{"../schema_rfp_ex.json", "pain.013.001.07"},
+		{"../schema_Adm_ex.json", "admi.002.001.01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			data, err := os.ReadFile(tt.file)
+			if err != nil {
+				t.Fatalf("failed to read example: %v", err)
+			}
+			decoder := json.NewDecoder(bytes.NewReader(data))
+			decoder.DisallowUnknownFields()
+			payload := reflect.New(reflect.TypeOf(payloads[tt.messageType])).Interface()
+			if err := decoder.Decode(payload); err != nil {
+				t.Fatalf("example does not match the %s payload: %v", tt.messageType, err)
+			}
+		})
+	}
+}
+
+// builtInMessages returns the registered ISO messages, leaving out those
+// registered by other tests.
+func builtInMessages() []fednow.Message {
+	var messages []fednow.Message
+	for _, m := range fednow.Registered() {
+		if m.Payload != nil && strings.HasPrefix(m.Namespace, "urn:iso:std:iso:20022:tech:xsd:") {
+			messages = append(messages, m)
+		}
+	}
+	return messages
+}
@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+	"github.com/mbanq/iso20022-go/pkg/fednow/pacs"
+)
+
+func TestLimits_Check(t *testing.T) {
+	participant := common.MustParseAmount("25000")
+	rfp := common.MustParseAmount("5000")
+	limits := config.Limits{
+		ParticipantMax:       &participant,
+		RequestForPaymentMax: &rfp,
+		CustomerTypeMax:      map[string]common.Amount{"retail": common.MustParseAmount("10000")},
+	}
+
+	tests := []struct {
+		name         string
+		rfp          bool
+		amount       string
+		customerType string
+		wantLimit    string
+	}{
+		{"within limits", false, "25000.00", "", ""},
+		{"network", false, "1000000.01", "", config.LimitNetwork},
+		{"participant", false, "25000.01", "", config.LimitParticipant},
+		{"customer type", false, "10000.01", "retail", config.LimitCustomerType},
+		{"unknown customer type", false, "20000", "business", ""},
+		{"request for payment", true, "5000.01", "", config.LimitRequestForPayment},
+		{"request for payment ignores participant", true, "5000", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount := common.MustParseAmount(tt.amount)
+			check := limits.CheckCreditTransfer
+			if tt.rfp {
+				check = limits.CheckRequestForPayment
+			}
+			err := check(amount, tt.customerType)
+			if tt.wantLimit == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var limitErr *config.LimitError
+			if !errors.As(err, &limitErr) || limitErr.Limit != tt.wantLimit {
+				t.Fatalf("expected %s LimitError, got %v", tt.wantLimit, err)
+			}
+		})
+	}
+}
+
+func TestBuildPacs008_RejectsAmountAboveParticipantLimit(t *testing.T) {
+	cfg, _, data := loadCCTSample(t)
+	max := common.MustParseAmount("500")
+	cfg.Limits.ParticipantMax = &max
+
+	_, err := pacs.BuildPacs008(data, cfg)
+	var limitErr *config.LimitError
+	if !errors.As(err, &limitErr) || !strings.Contains(err.Error(), "exceeds the participant limit of 500") {
+		t.Fatalf("expected participant LimitError, got %v", err)
+	}
+}
+
+func TestConfig_RejectsParticipantLimitAboveNetwork(t *testing.T) {
+	cfg, err := config.LoadConfig("../config.json")
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	max := common.MustParseAmount("2000000")
+	cfg.Limits.ParticipantMax = &max
+
+	err = cfg.Validate()
+	if err == nil || !strings.HasPrefix(err.Error(), "limits.participantMax") {
+		t.Fatalf("expected limits.participantMax error, got %v", err)
+	}
+}
@@ -0,0 +1,181 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/mbanq/iso20022-go/pkg/fednow"
+	"github.com/mbanq/iso20022-go/pkg/fednow/msgid"
+	"github.com/mbanq/iso20022-go/pkg/fednow/pain"
+)
+
+func TestValidateMessageID(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		wantErr bool
+	}{
+		{"sample", "20250902725160144Sc01Step12", false},
+		{"generated", "20250102021150706000100000000000001", false},
+		{"empty", "", true},
+		{"too long", "20250902725160144Sc01Step12345678901", true},
+		{"no reference", "20250902725160144", true},
+		{"invalid date", "20251302725160144Sc01Step12", true},
+		{"invalid routing number", "20250902725160145Sc01Step12", true},
+		{"invalid characters", "20250902725160144Sc01-Step12", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := msgid.ValidateMessageID("messageId", tt.id); (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateMessageID(%q) error = %v, wantErr %v", tt.id, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerator_MessageIDAt(t *testing.T) {
+	g, err := msgid.NewGenerator("021150706", "Sc01", &msgid.Counter{})
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+	// 03:00 UTC on January 3 is still January 2 in US Eastern time.
+	day := time.Date(2025, 1, 3, 3, 0, 0, 0, time.UTC)
+
+	for i, want := range []string{
+		"20250102021150706Sc0100000000000001",
+		"20250102021150706Sc0100000000000002",
+	} {
+		got, err := g.MessageIDAt(day)
+		if err != nil || got != want {
+			t.Fatalf("MessageIDAt() #%d = %q, %v, want %q", i, got, err, want)
+		}
+		if err := msgid.ValidateMessageID("messageId", got); err != nil {
+			t.Fatalf("ValidateMessageID(%q) error = %v", got, err)
+		}
+	}
+	got, err := g.MessageIDAt(day.Add(24 * time.Hour))
+	if err != nil || got != "20250103021150706Sc0100000000000001" {
+		t.Fatalf("MessageIDAt() on the next day = %q, %v", got, err)
+	}
+
+	if _, err := msgid.NewGenerator("021150706", "Sc-1", &msgid.Counter{}); err == nil {
+		t.Fatal("NewGenerator() accepted an invalid source")
+	}
+	uetr, err := msgid.NewUETR()
+	if err != nil {
+		t.Fatalf("NewUETR() error = %v", err)
+	}
+	if err := msgid.ValidateUETR("uetr", uetr); err != nil {
+		t.Fatalf("ValidateUETR(%q) error = %v", uetr, err)
+	}
+}
+
+func TestGenerate_AssignsIDs(t *testing.T) {
+	cfg, sample, _ := loadCCTSample(t)
+	g, err := msgid.NewGenerator(string(cfg.IspId), "Sc01", &msgid.Counter{})
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+	clock := func() time.Time { return time.Date(2025, 1, 2, 15, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name              string
+		businessMessageID string
+		messageID         string
+		wantBizMsgIdr     string
+		wantMsgID         string
+	}{
+		{"blank", "", "", "20250102725160144Sc0100000000000001", "20250102725160144Sc0100000000000001"},
+		{"message ID only", "", "20250102725160144Sc01Own", "20250102725160144Sc01Own", "20250102725160144Sc01Own"},
+		{"both", "20250102725160144Sc01Biz", "20250102725160144Sc01Own", "20250102725160144Sc01Biz", "20250102725160144Sc01Own"},
+	}
+	bizMsgIdr := regexp.MustCompile(`<BizMsgIdr>([^<]*)</BizMsgIdr>`)
+	msgID := regexp.MustCompile(`<MsgId>([^<]*)</MsgId>`)
+	uetr := regexp.MustCompile(`<UETR>([^<]*)</UETR>`)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := sample
+			msg.FedNowMsg.Identifier.BusinessMessageID = tt.businessMessageID
+			msg.FedNowMsg.Identifier.MessageID = tt.messageID
+			msg.FedNowMsg.Identifier.UETR = nil
+
+			appHdr, document, err := fednow.GeneratePacs008("pacs.008.001.08", cfg, msg, fednow.WithClock(clock), fednow.WithIDGenerator(g))
+			if err != nil {
+				t.Fatalf("GeneratePacs008() error = %v", err)
+			}
+			var buf bytes.Buffer
+			if err := fednow.WriteMessage(&buf, "pacs.008.001.08", appHdr, document, fednow.Compact); err != nil {
+				t.Fatalf("WriteMessage() error = %v", err)
+			}
+			out := buf.String()
+
+			if got := bizMsgIdr.FindStringSubmatch(out); got == nil || got[1] != tt.wantBizMsgIdr {
+				t.Errorf("BizMsgIdr = %v, want %s", got, tt.wantBizMsgIdr)
+			}
+			if got := msgID.FindStringSubmatch(out); got == nil || got[1] != tt.wantMsgID {
+				t.Errorf("MsgId = %v, want %s", got, tt.wantMsgID)
+			}
+			got := uetr.FindStringSubmatch(out)
+			if got == nil {
+				t.Fatalf("missing UETR:\n%s", out)
+			}
+			if err := msgid.ValidateUETR("uetr", got[1]); err != nil {
+				t.Errorf("generated UETR: %v", err)
+			}
+		})
+	}
+}
+
+func TestGeneratePain013_UETR(t *testing.T) {
+	cfg, _, _ := loadCCTSample(t)
+	data, err := os.ReadFile("../schema_rfp_ex.json")
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	uetr := regexp.MustCompile(`<UETR>([^<]*)</UETR>`)
+
+	tests := []struct {
+		name string
+		uetr string
+	}{
+		{"blank", ""},
+		{"supplied", "8a562c67-ca16-48ba-b074-65581be6f011"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var msg pain.FedNowMessageRFP
+			if err := json.Unmarshal(data, &msg); err != nil {
+				t.Fatalf("failed to unmarshal sample: %v", err)
+			}
+			msg.FedNowMsg.Identifier.UETR = nil
+			if tt.uetr != "" {
+				msg.FedNowMsg.Identifier.UETR = &tt.uetr
+			}
+
+			appHdr, document, err := fednow.GeneratePain013("pain.013.001.07", cfg, msg)
+			if err != nil {
+				t.Fatalf("GeneratePain013() error = %v", err)
+			}
+			xmlData := envelope(t, "pain.013.001.07", appHdr, document)
+			got := uetr.FindSubmatch(xmlData)
+			if got == nil || tt.uetr != "" && string(got[1]) != tt.uetr {
+				t.Fatalf("UETR = %q, want %q", got, tt.uetr)
+			}
+
+			parsed, err := fednow.Parse(xmlData)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if id := parsed.(*pain.FedNowMessageRFP).FedNowMsg.Identifier; id.UETR == nil || *id.UETR != string(got[1]) {
+				t.Fatalf("parsed UETR = %v, want %s", id.UETR, got[1])
+			}
+		})
+	}
+}
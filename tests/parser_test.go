@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"testing"
+
+	pacs008 "github.com/mbanq/iso20022-go/ISO20022/pacs_008_001_08"
+	"github.com/mbanq/iso20022-go/pkg/fednow"
+	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+	"github.com/mbanq/iso20022-go/pkg/fednow/pacs"
+)
+
+// loadCCTSample returns the config of config.json and the pacs.008 payload of
+// schema_Cct_ex.json, decoded and as read.
+func loadCCTSample(tb testing.TB) (*config.Config, pacs.FedNowMessageCCT, []byte) {
+	tb.Helper()
+	cfg, err := config.LoadConfig("../config.json")
+	if err != nil {
+		tb.Fatalf("failed to load config: %v", err)
+	}
+	data, err := os.ReadFile("../schema_Cct_ex.json")
+	if err != nil {
+		tb.Fatalf("failed to read sample: %v", err)
+	}
+	var msg pacs.FedNowMessageCCT
+	if err := json.Unmarshal(data, &msg); err != nil {
+		tb.Fatalf("failed to unmarshal sample: %v", err)
+	}
+	return cfg, msg, data
+}
+
+// inboundPacs008 returns the pacs.008 of schema_Cct_ex.json as an inbound
+// message.
+func inboundPacs008(tb testing.TB) []byte {
+	tb.Helper()
+	cfg, msg, _ := loadCCTSample(tb)
+	appHdr, document, err := fednow.GeneratePacs008("pacs.008.001.08", cfg, msg)
+	if err != nil {
+		tb.Fatalf("GeneratePacs008() error = %v", err)
+	}
+	var buf bytes.Buffer
+	buf.WriteString("<Envelope>\n")
+	if err := fednow.WriteMessage(&buf, "pacs.008.001.08", appHdr, document, fednow.Indented); err != nil {
+		tb.Fatalf("WriteMessage() error = %v", err)
+	}
+	buf.WriteString("\n</Envelope>")
+	return buf.Bytes()
+}
+
+func TestParse_RawDocument(t *testing.T) {
+	xmlData := inboundPacs008(t)
+
+	tests := []struct {
+		name string
+		opts func(raw *[]byte) []fednow.Option
+		want bool
+	}{
+		{"default", func(*[]byte) []fednow.Option { return nil }, false},
+		{"opt-in", func(raw *[]byte) []fednow.Option { return []fednow.Option{fednow.WithRawDocument(raw)} }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var raw []byte
+			if _, err := fednow.Parse(xmlData, tt.opts(&raw)...); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !tt.want {
+				if raw != nil {
+					t.Fatalf("raw Document captured without WithRawDocument")
+				}
+				return
+			}
+			if !bytes.HasPrefix(raw, []byte("<Document")) || !bytes.HasSuffix(raw, []byte("</Document>")) {
+				t.Fatalf("raw Document = %s", raw)
+			}
+			var document pacs008.Document
+			if err := xml.Unmarshal(raw, &document); err != nil {
+				t.Fatalf("raw Document does not decode: %v", err)
+			}
+		})
+	}
+}
+
+// BenchmarkParse measures Parse on the pacs.008 of schema_Cct_ex.json.
+func BenchmarkParse(b *testing.B) {
+	xmlData := inboundPacs008(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := fednow.Parse(xmlData); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
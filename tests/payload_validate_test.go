@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/mbanq/iso20022-go/pkg/fednow"
+)
+
+func TestValidate_Examples(t *testing.T) {
+	tests := []struct {
+		file        string
+		messageType string
+	}{
+		{"../schema_Cct_ex.json", "pacs.008.001.08"},
+		{"../schema_Ack_ex.json", "pacs.002.001.10"},
+		{"../schema_Rtn_ex.json", "pacs.004.001.10"},
+		{"../schema_rfp_ex.json", "pain.013.001.07"},
+		{"../schema_Adm_ex.json", "admi.002.001.01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.messageType, func(t *testing.T) {
+			data, err := os.ReadFile(tt.file)
+			if err != nil {
+				t.Fatalf("failed to read example: %v", err)
+			}
+			if err := fednow.Validate(tt.messageType, data); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidate_ReportsAllErrors(t *testing.T) {
+	_, _, data := loadCCTSample(t)
+	payload := string(data)
+	payload = strings.Replace(payload, `"paymentType": {`, `"paymentTyp": {`, 1)
+	payload = strings.Replace(payload, `"creationDateTime": "2025-01-09T10:55:26-04:00"`, `"creationDateTime": "2025-01-09 10:55"`, 1)
+	payload = strings.Replace(payload, `"senderShortName": "Mbq Banq"`, `"senderShortName": 42`, 1)
+
+	err := fednow.Validate("pacs.008.001.08", []byte(payload))
+	var validationErr *fednow.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected *ValidationError, got %T: %v", err, err)
+	}
+
+	got := make(map[string]string)
+	for _, f := range validationErr.Fields {
+		got[f.Path] = f.Reason
+	}
+	want := map[string]string{
+		"fedNowMessage.paymentType":                                 "is required",
+		"fedNowMessage.paymentTyp":                                  "is not an allowed field",
+		"fedNowMessage.creationDateTime":                            "is not a valid date-time",
+		"fedNowMessage.senderDepositoryInstitution.senderShortName": "must be of type string",
+	}
+	for path, reason := range want {
+		if got[path] != reason {
+			t.Errorf("%s: got %q, want %q", path, got[path], reason)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("got %d errors, want %d: %v", len(got), len(want), err)
+	}
+}
+
+func TestValidate_CodesAndInput(t *testing.T) {
+	data, err := os.ReadFile("../schema_Ack_ex.json")
+	if err != nil {
+		t.Fatalf("failed to read example: %v", err)
+	}
+	payload := strings.Replace(string(data), `"statusReason": "RC01"`, `"statusReason": "XXXX"`, 1)
+	err = fednow.Validate("pacs.002.001.10", []byte(payload))
+	if err == nil || !strings.HasPrefix(err.Error(), `fedNowMessage.paymentStatus.statusReason "XXXX"`) {
+		t.Fatalf("expected statusReason error, got %v", err)
+	}
+
+	if err := fednow.Validate("pacs.008.001.08", []byte(`{"fedNowMessage": `)); !errors.Is(err, fednow.ErrDecode) {
+		t.Fatalf("expected ErrDecode, got %v", err)
+	}
+	if err := fednow.Validate("pacs.009.001.08", []byte(`{}`)); !errors.Is(err, fednow.ErrUnsupportedMessage) {
+		t.Fatalf("expected ErrUnsupportedMessage, got %v", err)
+	}
+}
+
+func TestValidate_FieldNames(t *testing.T) {
+	_, _, data := loadCCTSample(t)
+
+	tests := []struct {
+		name    string
+		old     string
+		new     string
+		wantErr string
+	}{
+		{"case folded", `"paymentType": {`, `"PaymentType": {`, ""},
+		{"required pointer missing", `"categoryPurpose": "CONS"`, `"purpose": "CONS"`, "fedNowMessage.paymentType.categoryPurpose: is required"},
+		{"required pointer null", `"categoryPurpose": "CONS"`, `"categoryPurpose": null`, "fedNowMessage.paymentType.categoryPurpose: must be of type string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := strings.Replace(string(data), tt.old, tt.new, 1)
+			err := fednow.Validate("pacs.008.001.08", []byte(payload))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"os"
+	"testing"
+
+	head "github.com/mbanq/iso20022-go/ISO20022/head_001_001_02"
+	"github.com/mbanq/iso20022-go/pkg/fednow"
+	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+	"github.com/mbanq/iso20022-go/pkg/fednow/pacs"
+)
+
+func TestRegistry_BuiltIns(t *testing.T) {
+	want := []string{
+		"admi.002.001.01", "admi.007.001.01", "camt.029.001.09", "camt.056.001.08",
+		"pacs.002.001.10", "pacs.004.001.10", "pacs.008.001.08", "pain.013.001.07",
+	}
+	registered := make(map[string]fednow.Message)
+	for _, m := range fednow.Registered() {
+		registered[m.MessageType] = m
+	}
+	for _, messageType := range want {
+		m, ok := registered[messageType]
+		if !ok {
+			t.Errorf("%s is not registered", messageType)
+			continue
+		}
+		// Both directions must be supported for every built-in message.
+		if m.Build == nil || m.Parse == nil || m.Payload == nil {
+			t.Errorf("%s: incomplete registration %+v", messageType, m)
+		}
+		if m.Namespace != "urn:iso:std:iso:20022:tech:xsd:"+messageType {
+			t.Errorf("%s: namespace = %q", messageType, m.Namespace)
+		}
+	}
+}
+
+func TestRegistry_Register(t *testing.T) {
+	parse := func(head.BusinessApplicationHeaderV02, func(any) error) (fednow.FedNowMessage, error) {
+		return nil, nil
+	}
+	build := func(string, *config.Config, fednow.FedNowMessage, ...fednow.Option) (*head.BusinessApplicationHeaderV02, any, error) {
+		return nil, nil, nil
+	}
+
+	tests := []struct {
+		name    string
+		message fednow.Message
+		wantErr bool
+	}{
+		{"missing type", fednow.Message{Parse: parse}, true},
+		{"no functions", fednow.Message{MessageType: "test.001.001.01"}, true},
+		{"build without payload", fednow.Message{MessageType: "test.001.001.01", Build: build}, true},
+		{"parse only", fednow.Message{MessageType: "test.001.001.01", Parse: parse}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fednow.Register(tt.message)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Register() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// bankNotice is a bank-specific message registered by TestRegistry_CustomMessage.
+type bankNotice struct {
+	Notice struct {
+		Reference string `json:"reference"`
+	} `json:"notice"`
+}
+
+func (bankNotice) IsFedNowMessage() {}
+
+type bankNoticeDocument struct {
+	XMLName xml.Name `xml:"Document"`
+	Ref     string   `xml:"Ntce>Ref"`
+}
+
+func TestRegistry_CustomMessage(t *testing.T) {
+	const messageType = "xbnk.001.001.01"
+	err := fednow.Register(fednow.Message{
+		MessageType: messageType,
+		Namespace:   "urn:example:xbnk.001.001.01",
+		Payload:     bankNotice{},
+		Build: func(messageType string, cfg *config.Config, message fednow.FedNowMessage, opts ...fednow.Option) (*head.BusinessApplicationHeaderV02, any, error) {
+			notice := message.(bankNotice)
+			return &head.BusinessApplicationHeaderV02{MsgDefIdr: head.Max35Text(messageType)}, &bankNoticeDocument{Ref: notice.Notice.Reference}, nil
+		},
+		Parse: fednow.ParseWith(func(appHdr head.BusinessApplicationHeaderV02, doc bankNoticeDocument) (bankNotice, error) {
+			var notice bankNotice
+			notice.Notice.Reference = doc.Ref
+			return notice, nil
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	registered, ok := fednow.Lookup(messageType)
+	if !ok {
+		t.Fatalf("%s not found after Register", messageType)
+	}
+	if err := fednow.Validate(messageType, []byte(`{"notice":{"reference":"REF1","extra":1}}`)); !errors.Is(err, fednow.ErrValidation) {
+		t.Fatalf("Validate() error = %v, want a validation error", err)
+	}
+	message, err := registered.Unmarshal([]byte(`{"notice":{"reference":"REF1"}}`))
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	appHdr, document, err := registered.Build(messageType, nil, message)
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	parsed, err := fednow.Parse(envelope(t, registered.MessageType, appHdr, document))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got, ok := parsed.(bankNotice); !ok || got.Notice.Reference != "REF1" {
+		t.Fatalf("Parse() = %#v, want reference REF1", parsed)
+	}
+}
+
+func TestRegistry_Pacs004RoundTrip(t *testing.T) {
+	cfg, err := config.LoadConfig("../config.json")
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	data, err := os.ReadFile("../schema_Rtn_ex.json")
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+
+	registered, ok := fednow.Lookup("pacs.004.001.10")
+	if !ok {
+		t.Fatal("pacs.004.001.10 is not registered")
+	}
+	message, err := registered.Unmarshal(data)
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	appHdr, document, err := registered.Build(registered.MessageType, cfg, message)
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	parsed, err := fednow.Parse(envelope(t, registered.MessageType, appHdr, document))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	got, ok := parsed.(*pacs.FedNowMessageRtn)
+	if !ok {
+		t.Fatalf("Parse() returned %T, want *pacs.FedNowMessageRtn", parsed)
+	}
+
+	want := message.(pacs.FedNowMessageRtn).FedNowMsg
+	if got.FedNowMsg.Identifier.MessageID != want.Identifier.MessageID {
+		t.Errorf("MessageID = %q, want %q", got.FedNowMsg.Identifier.MessageID, want.Identifier.MessageID)
+	}
+	if got.FedNowMsg.OriginalIdentifier.EndToEndID != want.OriginalIdentifier.EndToEndID {
+		t.Errorf("OriginalIdentifier.EndToEndID = %q, want %q", got.FedNowMsg.OriginalIdentifier.EndToEndID, want.OriginalIdentifier.EndToEndID)
+	}
+	if got.FedNowMsg.Amount.Text.Cmp(want.Amount.Text) != 0 || got.FedNowMsg.PaymentReturn.ReturnedAmount.Text.Cmp(want.PaymentReturn.ReturnedAmount.Text) != 0 {
+		t.Errorf("amounts = %s/%s, want %s/%s", got.FedNowMsg.Amount.Text, got.FedNowMsg.PaymentReturn.ReturnedAmount.Text, want.Amount.Text, want.PaymentReturn.ReturnedAmount.Text)
+	}
+	if *got.FedNowMsg.PaymentReturn.ReturnReason != *want.PaymentReturn.ReturnReason {
+		t.Errorf("ReturnReason = %s, want %s", *got.FedNowMsg.PaymentReturn.ReturnReason, *want.PaymentReturn.ReturnReason)
+	}
+	if got.FedNowMsg.SenderDI.SenderABANumber != want.SenderDI.SenderABANumber || got.FedNowMsg.Beneficiary.Personal.Identifier != want.Beneficiary.Personal.Identifier {
+		t.Errorf("parties = %+v, want %+v", got.FedNowMsg, want)
+	}
+}
+
+// envelope wraps an AppHdr and Document the way fednow.Parse expects them.
+func envelope(t *testing.T, messageType string, appHdr *head.BusinessApplicationHeaderV02, document any) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	buf.WriteString("<Envelope>")
+	if err := fednow.WriteMessage(&buf, messageType, appHdr, document, fednow.Compact); err != nil {
+		t.Fatalf("failed to write message: %v", err)
+	}
+	buf.WriteString("</Envelope>")
+	return buf.Bytes()
+}
@@ -0,0 +1,103 @@
+package tests
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/mbanq/iso20022-go/pkg/fednow"
+	"github.com/mbanq/iso20022-go/pkg/fednow/admi"
+	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+)
+
+func TestNewRejection(t *testing.T) {
+	tests := []struct {
+		name     string
+		inbound  string
+		cause    error
+		wantRef  string
+		wantCode string
+		wantLctn string
+	}{
+		{
+			name:     "malformed document",
+			inbound:  "<Envelope><AppHdr><BizMsgIdr>20250902725160144Sc01Step12</BizMsgIdr><MsgDefIdr>pacs.008.001.08</MsgDefIdr></AppHdr>\n<Document><FIToFICstmrCdtTrf></Document></Envelope>",
+			wantRef:  "20250902725160144Sc01Step12",
+			wantCode: "FF02",
+			wantLctn: "line 2",
+		},
+		{
+			name:     "no AppHdr",
+			inbound:  "<Envelope><Document/></Envelope>",
+			wantRef:  fednow.UnknownReference,
+			wantCode: "FF02",
+			wantLctn: "AppHdr",
+		},
+		{
+			name:     "unsupported",
+			inbound:  "<Envelope><AppHdr><BizMsgIdr>BIZ1</BizMsgIdr><MsgDefIdr>pacs.009.001.08</MsgDefIdr></AppHdr></Envelope>",
+			wantRef:  "BIZ1",
+			wantCode: "FF02",
+			wantLctn: "AppHdr/MsgDefIdr",
+		},
+		{
+			name:     "duplicate",
+			inbound:  "<Envelope><AppHdr><BizMsgIdr>BIZ1</BizMsgIdr><MsgDefIdr>pacs.008.001.08</MsgDefIdr></AppHdr></Envelope>",
+			cause:    &fednow.DuplicateError{MessageType: "pacs.008.001.08", From: "021150706", BusinessMessageID: "BIZ1"},
+			wantRef:  "BIZ1",
+			wantCode: "AM05",
+			wantLctn: "AppHdr/BizMsgIdr",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cause
+			if err == nil {
+				if _, err = fednow.Parse([]byte(tt.inbound)); err == nil {
+					t.Fatal("expected a parse error")
+				}
+			}
+			msg := fednow.NewRejection("20250902725160144REJ0001", []byte(tt.inbound), err).FedNowMsg
+			if string(msg.Reference) != tt.wantRef {
+				t.Errorf("reference = %q, want %q", msg.Reference, tt.wantRef)
+			}
+			if string(msg.Reason.RejectionReason) != tt.wantCode {
+				t.Errorf("reason = %q, want %q", msg.Reason.RejectionReason, tt.wantCode)
+			}
+			if msg.Reason.ErrorLocation == nil || !strings.Contains(string(*msg.Reason.ErrorLocation), tt.wantLctn) {
+				t.Errorf("error location = %v, want %q", msg.Reason.ErrorLocation, tt.wantLctn)
+			}
+			if msg.Reason.Description == nil || *msg.Reason.Description == "" {
+				t.Error("expected a description")
+			}
+		})
+	}
+}
+
+func TestNewRejection_BuildsAdmi002(t *testing.T) {
+	cfg, err := config.LoadConfig("../config.json")
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	inbound := []byte("<Envelope><AppHdr><BizMsgIdr>BIZ1</BizMsgIdr></AppHdr></Envelope>")
+	_, parseErr := fednow.Parse(inbound)
+
+	_, doc, err := fednow.GenerateAdmi002("admi.002.001.01", cfg, fednow.NewRejection("REJ1", inbound, parseErr))
+	if err != nil {
+		t.Fatalf("failed to build admi.002: %v", err)
+	}
+	out, err := xml.Marshal(doc)
+	if err != nil {
+		t.Fatalf("failed to marshal admi.002: %v", err)
+	}
+	for _, want := range []string{"<admi.002.001.01>", "<Ref>BIZ1</Ref>", "<RjctgPtyRsn>FF02</RjctgPtyRsn>", "<RsnDesc>"} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("admi.002 is missing %s:\n%s", want, out)
+		}
+	}
+
+	if _, err := admi.BuildAdmi002Struct(admi.FedNowMessageADM{}, cfg); !strings.Contains(err.Error(), "fedNowMessage.reference") {
+		t.Fatalf("expected missing reference error, got %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mbanq/iso20022-go/pkg/fednow"
+	"github.com/mbanq/iso20022-go/pkg/fednow/pacs"
+)
+
+func TestResend_PossibleDuplicate(t *testing.T) {
+	cfg, msg, _ := loadCCTSample(t)
+	schema, err := fednow.ReadEnvelopeSchema(strings.NewReader(envelopeXSD))
+	if err != nil {
+		t.Fatalf("ReadEnvelopeSchema() error = %v", err)
+	}
+	sent := func() time.Time { return time.Date(2025, 1, 2, 15, 0, 0, 0, time.UTC) }
+	resent := func() time.Time { return time.Date(2025, 1, 2, 15, 0, 30, 0, time.UTC) }
+
+	tests := []struct {
+		name   string
+		format fednow.Format
+		opts   []fednow.Option
+	}{
+		{"indented", fednow.Indented, nil},
+		{"compact", fednow.Compact, nil},
+		{"already flagged", fednow.Indented, []fednow.Option{fednow.WithPossibleDuplicate()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := schema.GenerateTo(&buf, "pacs.008.001.08", cfg, msg, tt.format, append(tt.opts, fednow.WithClock(sent))...); err != nil {
+				t.Fatalf("GenerateTo() error = %v", err)
+			}
+			original := buf.Bytes()
+
+			resend, err := fednow.Resend(original, fednow.WithClock(resent))
+			if err != nil {
+				t.Fatalf("Resend() error = %v", err)
+			}
+			out := string(resend)
+			for _, want := range []string{
+				"<PssblDplct>true</PssblDplct>",
+				"<CreDt>2025-01-02T10:00:30-05:00</CreDt>",
+				"<BizMsgIdr>20250902725160144Sc01Step12</BizMsgIdr>",
+			} {
+				if !strings.Contains(out, want) {
+					t.Errorf("resend does not contain %s:\n%s", want, out)
+				}
+			}
+			if n := strings.Count(out, "PssblDplct>"); n != 2 {
+				t.Errorf("resend has %d PssblDplct tags, want 2:\n%s", n, out)
+			}
+			document := original[bytes.Index(original, []byte("<Document")):]
+			if !bytes.HasSuffix(resend, document) {
+				t.Errorf("resend changed the Document:\n%s", out)
+			}
+			if strings.Contains(out, "\n") != strings.Contains(string(original), "\n") {
+				t.Errorf("resend changed the format:\n%s", out)
+			}
+
+			store := &fednow.MemoryDuplicateStore{}
+			if _, err := fednow.Parse(original, fednow.WithDuplicateStore(store)); err != nil {
+				t.Fatalf("Parse() of the original error = %v", err)
+			}
+			parsed, err := fednow.Parse(resend, fednow.WithDuplicateStore(store))
+			var dupErr *fednow.DuplicateError
+			if !errors.Is(err, fednow.ErrDuplicate) || !errors.As(err, &dupErr) {
+				t.Fatalf("Parse() of the resend error = %v, want a duplicate", err)
+			}
+			if !dupErr.PossibleDuplicate || dupErr.From != string(cfg.IspId) {
+				t.Errorf("DuplicateError = %+v", dupErr)
+			}
+			if parsed == nil || !parsed.(*pacs.FedNowMessageCCT).FedNowMsg.Identifier.PossibleDuplicate {
+				t.Errorf("parsed resend = %+v, want possibleDuplicate", parsed)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow/bah"
+	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+)
+
+func TestRoutingNumber_Validate(t *testing.T) {
+	tests := []struct {
+		rtn     string
+		wantErr string
+	}{
+		{"021150706", ""},
+		{"725160144", ""},
+		{"121182904", ""},
+		{"011000015", ""},
+		{"021150707", "checksum"},
+		{"12118290", "9 digits"},
+		{"12118290A", "9 digits"},
+		{"999999997", "prefix 99"},
+		{"111111111", "checksum"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rtn, func(t *testing.T) {
+			err := common.RoutingNumber(tt.rtn).Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestBuildBah_RejectsInvalidRoutingNumber(t *testing.T) {
+	cfg, err := config.LoadConfig("../config.json")
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	cfg.FrbId = "021150707"
+
+	_, err = bah.BuildBah("MSGID", cfg, "pacs.008.001.08")
+	if err == nil || !strings.HasPrefix(err.Error(), `frbId "021150707"`) {
+		t.Fatalf("expected frbId error, got %v", err)
+	}
+}
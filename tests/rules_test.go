@@ -0,0 +1,189 @@
+package tests
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mbanq/iso20022-go/pkg/common"
+	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+	"github.com/mbanq/iso20022-go/pkg/fednow/pacs"
+	"github.com/mbanq/iso20022-go/pkg/fednow/pain"
+	"github.com/mbanq/iso20022-go/pkg/fednow/rules"
+)
+
+func loadRFPSample(tb testing.TB) pain.FedNowMessageRFP {
+	tb.Helper()
+	data, err := os.ReadFile("../schema_rfp_ex.json")
+	if err != nil {
+		tb.Fatalf("failed to read sample: %v", err)
+	}
+	var msg pain.FedNowMessageRFP
+	if err := json.Unmarshal(data, &msg); err != nil {
+		tb.Fatalf("failed to unmarshal sample: %v", err)
+	}
+	return msg
+}
+
+func TestRules_Check(t *testing.T) {
+	cfg, msg, _ := loadCCTSample(t)
+
+	if violations := rules.Check(msg, cfg); len(violations) > 0 {
+		t.Fatalf("sample: unexpected violations: %v", violations)
+	}
+	if violations := rules.Check(loadRFPSample(t), cfg); len(violations) > 0 {
+		t.Fatalf("pain.013 sample: unexpected violations: %v", violations)
+	}
+
+	msg.FedNowMsg.Amount = pacs.FedNowAmount{Text: common.MustParseAmount("2000000.00"), Ccy: "EUR"}
+	msg.FedNowMsg.Identifier.UETR = nil
+	msg.FedNowMsg.SenderDI.SenderABANumber = "121182905"
+	cfg.SettlementMethod = "INDA"
+
+	want := []struct{ ruleID, path string }{
+		{rules.RuleAgentsRequired, "fedNowMessage.senderDepositoryInstitution.senderABANumber"},
+		{rules.RuleUETRRequired, "fedNowMessage.identifier.uetr"},
+		{rules.RuleCurrencyUSD, "fedNowMessage.amount.currency"},
+		{rules.RuleAmountLimit, "fedNowMessage.amount.amount"},
+		{rules.RuleSettlementMethod, "config.settlementMethod"},
+	}
+	violations := rules.Check(&msg, cfg)
+	if len(violations) != len(want) {
+		t.Fatalf("expected %d violations, got %v", len(want), violations)
+	}
+	for i, w := range want {
+		if violations[i].RuleID != w.ruleID || violations[i].Path != w.path {
+			t.Errorf("violation %d = %v, want %s at %s", i, violations[i], w.ruleID, w.path)
+		}
+	}
+}
+
+func TestRules_DefaultRules(t *testing.T) {
+	tests := []struct {
+		ruleID string
+		// message breaks the rule in the pacs.008 or pain.013 sample, or in
+		// cfg, and returns the message to check.
+		message func(cct *pacs.FedNowMessageCCT, rfp *pain.FedNowMessageRFP, cfg *config.Config) any
+		path    string
+	}{
+		{rules.RuleMessageID, func(cct *pacs.FedNowMessageCCT, _ *pain.FedNowMessageRFP, _ *config.Config) any {
+			cct.FedNowMsg.Identifier.MessageID = strings.Repeat("M", 36)
+			return cct
+		}, "fedNowMessage.identifier.messageId"},
+		{rules.RuleCreationDateTime, func(cct *pacs.FedNowMessageCCT, _ *pain.FedNowMessageRFP, _ *config.Config) any {
+			cct.FedNowMsg.CreationDateTime = common.ISODateTime{}
+			return cct
+		}, "fedNowMessage.creationDateTime"},
+		{rules.RuleAgentsRequired, func(cct *pacs.FedNowMessageCCT, _ *pain.FedNowMessageRFP, _ *config.Config) any {
+			cct.FedNowMsg.ReceiverDI.ReceiverABANumber = "121182905"
+			return cct
+		}, "fedNowMessage.receiverDepositoryInstitution.receiverABANumber"},
+		{rules.RuleUETRRequired, func(_ *pacs.FedNowMessageCCT, rfp *pain.FedNowMessageRFP, _ *config.Config) any {
+			uetr := "not-a-uuid"
+			rfp.FedNowMsg.Identifier.UETR = &uetr
+			return rfp
+		}, "fedNowMessage.identifier.uetr"},
+		{rules.RuleAmount, func(cct *pacs.FedNowMessageCCT, _ *pain.FedNowMessageRFP, _ *config.Config) any {
+			cct.FedNowMsg.Amount.Text = common.MustParseAmount("100.001")
+			return cct
+		}, "fedNowMessage.amount.amount"},
+		{rules.RuleCurrencyUSD, func(cct *pacs.FedNowMessageCCT, _ *pain.FedNowMessageRFP, _ *config.Config) any {
+			cct.FedNowMsg.Amount.Ccy = "EUR"
+			return cct
+		}, "fedNowMessage.amount.currency"},
+		{rules.RuleAmountLimit, func(_ *pacs.FedNowMessageCCT, rfp *pain.FedNowMessageRFP, _ *config.Config) any {
+			rfp.FedNowMsg.Amount.Text = common.MustParseAmount("2000000.00")
+			return rfp
+		}, "fedNowMessage.amount.amount"},
+		{rules.RuleSettlementMethod, func(cct *pacs.FedNowMessageCCT, _ *pain.FedNowMessageRFP, cfg *config.Config) any {
+			cfg.SettlementMethod = "INDA"
+			return cct
+		}, "config.settlementMethod"},
+		{rules.RuleClearingSystem, func(cct *pacs.FedNowMessageCCT, _ *pain.FedNowMessageRFP, cfg *config.Config) any {
+			cfg.ClearingSystem = "CHI"
+			return cct
+		}, "config.clearingSystem"},
+		{rules.RuleChargeBearer, func(cct *pacs.FedNowMessageCCT, _ *pain.FedNowMessageRFP, cfg *config.Config) any {
+			cfg.ChargeBearer = "DEBT"
+			return cct
+		}, "config.chargeBearer"},
+		{rules.RuleCategoryPurpose, func(cct *pacs.FedNowMessageCCT, _ *pain.FedNowMessageRFP, _ *config.Config) any {
+			cct.FedNowMsg.PaymentType.CategoryPurpose = nil
+			return cct
+		}, "fedNowMessage.paymentType.categoryPurpose"},
+		{rules.RulePostalAddress, func(cct *pacs.FedNowMessageCCT, _ *pain.FedNowMessageRFP, _ *config.Config) any {
+			cct.FedNowMsg.Beneficiary.Personal.Address.TownName = nil
+			return cct
+		}, "fedNowMessage.beneficiary.personal.postalAddress"},
+		{rules.RuleRequestForPayDate, func(_ *pacs.FedNowMessageCCT, rfp *pain.FedNowMessageRFP, _ *config.Config) any {
+			info := &rfp.FedNowMsg.ExecutionInfo
+			info.ExpiryDate = common.ISODateTime(time.Time(info.ExecutionDate).Add(-time.Hour))
+			return rfp
+		}, "fedNowMessage.executionInfo.expiryDate"},
+	}
+
+	covered := make(map[string]bool)
+	for _, tt := range tests {
+		covered[tt.ruleID] = true
+		t.Run(tt.ruleID, func(t *testing.T) {
+			cfg, cct, _ := loadCCTSample(t)
+			rfp := loadRFPSample(t)
+
+			violations := rules.Check(tt.message(&cct, &rfp, cfg), cfg)
+			if len(violations) != 1 {
+				t.Fatalf("expected 1 violation, got %v", violations)
+			}
+			if v := violations[0]; v.RuleID != tt.ruleID || v.Path != tt.path || v.Message == "" {
+				t.Errorf("violation = %v, want %s at %s", v, tt.ruleID, tt.path)
+			}
+		})
+	}
+	for _, rule := range rules.DefaultRules() {
+		if !covered[rule.ID] {
+			t.Errorf("default rule %s has no test case", rule.ID)
+		}
+	}
+}
+
+func TestRules_CustomRule(t *testing.T) {
+	cfg, msg, _ := loadCCTSample(t)
+
+	const ruleID = "end-to-end-id-prefix"
+	custom := rules.New(ruleID, "End-to-end IDs start with the sender's prefix", func(m pacs.FedNowMessageCCT, _ *config.Config, r *rules.Report) {
+		if id := m.FedNowMsg.Identifier.EndToEndID; !strings.HasPrefix(id, "ACME") {
+			r.Add("fedNowMessage.identifier.endToEndId", id, "must start with ACME")
+		}
+	})
+
+	engine := rules.Default()
+	engine.Add(custom)
+	if got := engine.Rules(); got[len(got)-1].ID != ruleID {
+		t.Fatalf("Rules() does not end with %s", ruleID)
+	}
+
+	msg.FedNowMsg.Identifier.EndToEndID = "OTHER-1"
+	cfg.ChargeBearer = "DEBT"
+	violations := engine.Check(msg, cfg)
+	want := []struct{ ruleID, path string }{
+		{rules.RuleChargeBearer, "config.chargeBearer"},
+		{ruleID, "fedNowMessage.identifier.endToEndId"},
+	}
+	if len(violations) != len(want) {
+		t.Fatalf("expected %d violations, got %v", len(want), violations)
+	}
+	for i, w := range want {
+		if violations[i].RuleID != w.ruleID || violations[i].Path != w.path {
+			t.Errorf("violation %d = %v, want %s at %s", i, violations[i], w.ruleID, w.path)
+		}
+	}
+	if err := violations.Err(); err == nil || !strings.Contains(err.Error(), `end-to-end-id-prefix: fedNowMessage.identifier.endToEndId "OTHER-1": must start with ACME`) {
+		t.Errorf("Err() = %v", err)
+	}
+
+	// A rule over pacs.008 does not apply to other messages.
+	if violations := rules.NewEngine(custom).Check(loadRFPSample(t), cfg); len(violations) > 0 {
+		t.Errorf("pain.013: unexpected violations: %v", violations)
+	}
+}
@@ -0,0 +1,185 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/mbanq/iso20022-go/pkg/fednow"
+	"github.com/mbanq/iso20022-go/pkg/fednow/config"
+	"github.com/mbanq/iso20022-go/pkg/xsd"
+)
+
+func TestXSDValidate_Samples(t *testing.T) {
+	set, err := xsd.LoadDir("../Internal/XSD/iso")
+	if err != nil {
+		t.Fatalf("failed to load schemas: %v", err)
+	}
+
+	for _, file := range []string{"../sample_files/pacs.008.001.08_scenario1.xml", "../sample_files/sample-pacs008.xml"} {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file, err)
+		}
+		violations, err := set.Validate(data)
+		if err != nil {
+			t.Fatalf("%s: %v", file, err)
+		}
+		if len(violations) > 0 {
+			t.Errorf("%s: unexpected violations: %v", file, violations)
+		}
+	}
+}
+
+func TestXSDValidate_Violations(t *testing.T) {
+	set, err := xsd.LoadDir("../Internal/XSD/iso")
+	if err != nil {
+		t.Fatalf("failed to load schemas: %v", err)
+	}
+	data, err := os.ReadFile("../sample_files/pacs.008.001.08_scenario1.xml")
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	sample := string(data)
+
+	tests := []struct {
+		name     string
+		old, new string
+		wantPath string
+		wantMsg  string
+	}{
+		{"pattern", "8a562c67-ca16-48ba-b074-65581be6f011", "not-a-uuid", "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf/PmtId/UETR", "does not match the pattern"},
+		{"enumeration", "<ChrgBr>SLEV</ChrgBr>", "<ChrgBr>XXXX</ChrgBr>", "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf/ChrgBr", "not allowed"},
+		{"fraction digits", `Ccy="USD">51.74<`, `Ccy="USD">51.123456<`, "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf/IntrBkSttlmAmt", "fraction digits"},
+		{"required attribute", `<IntrBkSttlmAmt Ccy="USD">`, `<IntrBkSttlmAmt>`, "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf/IntrBkSttlmAmt/@Ccy", "missing required attribute"},
+		{"required element", "<EndToEndId>Scenario01EtoEId001</EndToEndId>", "", "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf/PmtId", "missing required element EndToEndId"},
+		{"max length", "<EndToEndId>Scenario01EtoEId001</EndToEndId>", "<EndToEndId>" + strings.Repeat("X", 36) + "</EndToEndId>", "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf/PmtId/EndToEndId", "longer than 35"},
+		{"unexpected element", "<ChrgBr>SLEV</ChrgBr>", "<ChrgBr>SLEV</ChrgBr><Foo/>", "/Document/FIToFICstmrCdtTrf/CdtTrfTxInf/Foo", "unexpected element"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mutated := strings.Replace(sample, tt.old, tt.new, 1)
+			if mutated == sample {
+				t.Fatalf("sample does not contain %q", tt.old)
+			}
+			violations, err := set.Validate([]byte(mutated))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, v := range violations {
+				if v.Path == tt.wantPath && strings.Contains(v.Message, tt.wantMsg) {
+					return
+				}
+			}
+			t.Fatalf("expected violation at %s containing %q, got %v", tt.wantPath, tt.wantMsg, violations)
+		})
+	}
+}
+
+func TestXSDValidation_Generated(t *testing.T) {
+	cfg, err := config.LoadConfig("../config.json")
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	set, err := xsd.ISO()
+	if err != nil {
+		t.Fatalf("ISO() error = %v", err)
+	}
+
+	tests := []struct {
+		messageType string
+		sample      string
+	}{
+		{"pacs.008.001.08", "../schema_Cct_ex.json"},
+		{"pacs.004.001.10", "../schema_Rtn_ex.json"},
+		{"pacs.002.001.10", "../schema_Ack_ex.json"},
+		{"pain.013.001.07", "../schema_rfp_ex.json"},
+		{"admi.002.001.01", "../schema_Adm_ex.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.messageType, func(t *testing.T) {
+			data, err := os.ReadFile(tt.sample)
+			if err != nil {
+				t.Fatalf("failed to read sample: %v", err)
+			}
+			registered, _ := fednow.Lookup(tt.messageType)
+			msg, err := registered.Unmarshal(data)
+			if err != nil {
+				t.Fatalf("failed to unmarshal sample: %v", err)
+			}
+			appHdr, document, err := registered.Build(tt.messageType, cfg, msg)
+			if err != nil {
+				t.Fatalf("Build() error = %v", err)
+			}
+			xmlData := envelope(t, tt.messageType, appHdr, document)
+			violations, err := set.Validate(xmlData)
+			if err != nil || len(violations) > 0 {
+				t.Fatalf("Validate() = %v, %v\n%s", violations, err, xmlData)
+			}
+			if _, err := fednow.Parse(xmlData, fednow.WithXSDValidation(nil)); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestXSDValidation_Options(t *testing.T) {
+	cfg, msg, _ := loadCCTSample(t)
+	schema, err := fednow.ReadEnvelopeSchema(strings.NewReader(envelopeXSD))
+	if err != nil {
+		t.Fatalf("ReadEnvelopeSchema() error = %v", err)
+	}
+	generated, err := schema.Generate("pacs.008.001.08", cfg, msg, fednow.WithXSDValidation(nil))
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		old, new string
+		wantPath string
+	}{
+		{"valid", "", "", ""},
+		{"AppHdr", "<BizMsgIdr>", "<BizMsgIdr>" + strings.Repeat("X", 35), "/FedNowOutgoing/FedNowOutgoingMessage/FedNowCustomerCreditTransfer/AppHdr/BizMsgIdr"},
+		{"AppHdr required", "<MmbId>725160144</MmbId>", "", "/FedNowOutgoing/FedNowOutgoingMessage/FedNowCustomerCreditTransfer/AppHdr/Fr/FIId/FinInstnId/ClrSysMmbId"},
+		{"Document", "<ChrgBr>SLEV</ChrgBr>", "<ChrgBr>XXXX</ChrgBr>", "/FedNowOutgoing/FedNowOutgoingMessage/FedNowCustomerCreditTransfer/Document/FIToFICstmrCdtTrf/CdtTrfTxInf/ChrgBr"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			xmlData := strings.Replace(string(generated), tt.old, tt.new, 1)
+			_, err := fednow.Parse([]byte(xmlData), fednow.WithXSDValidation(nil))
+			if tt.wantPath == "" {
+				if err != nil {
+					t.Fatalf("Parse() error = %v", err)
+				}
+				return
+			}
+			if xmlData == string(generated) {
+				t.Fatalf("generated message does not contain %q", tt.old)
+			}
+			var validationErr *fednow.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Parse() error = %v, want a *ValidationError", err)
+			}
+			for _, f := range validationErr.Fields {
+				if f.Path == tt.wantPath {
+					return
+				}
+			}
+			t.Fatalf("Parse() error = %v, want a violation at %s", err, tt.wantPath)
+		})
+	}
+
+	// Generate checks the message it built before writing it.
+	msg.FedNowMsg.Identifier.EndToEndID = strings.Repeat("X", 36)
+	var buf bytes.Buffer
+	err = schema.GenerateTo(&buf, "pacs.008.001.08", cfg, msg, fednow.Compact, fednow.WithXSDValidation(nil))
+	if !errors.Is(err, fednow.ErrValidation) || buf.Len() > 0 {
+		t.Fatalf("GenerateTo() error = %v, wrote %d bytes", err, buf.Len())
+	}
+}